@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -8,16 +9,21 @@ import (
 	"product-wallet/internal/delivery/http"
 	api "product-wallet/internal/delivery/http/middleware"
 	"product-wallet/internal/delivery/http/route"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/gateway/externalapi"
+	"product-wallet/internal/model"
 	"product-wallet/internal/repository"
 	services "product-wallet/internal/services"
 	"product-wallet/migration"
 	"product-wallet/pkg/database"
 	"product-wallet/pkg/logger"
+	"product-wallet/pkg/money"
 	"product-wallet/pkg/server"
 	"product-wallet/pkg/signature"
 	"product-wallet/pkg/xvalidator"
 	"strconv"
 	"syscall"
+	"time"
 )
 
 var (
@@ -50,6 +56,7 @@ func main() {
 		LogPath: conf.AppEnvConfig.LogFilePath,
 		Debug:   conf.AppEnvConfig.AppDebug,
 	})
+	initMoney(conf)
 	initInfrastructure(conf)
 	ginServer := server.NewGinServer(&server.GinConfig{
 		HttpPort:     conf.AppEnvConfig.HttpPort,
@@ -59,31 +66,116 @@ func main() {
 	})
 	//external
 	signaturer := signature.NewSignature(conf.AuthConfig.JwtSecretAccessToken)
+	paymentProvider := externalapi.NewStubPaymentProvider(conf)
+	payoutProvider := externalapi.NewSimulatedPayoutProvider(conf)
 
 	// repository
 	userRepository := repository.NewUserSQLRepository()
 	productRepository := repository.NewProductSQLRepository()
 	walletRepository := repository.NewWalletSQLRepository()
 	transactionRepository := repository.NewTransactionSQLRepository()
+	ledgerAccountRepository := repository.NewLedgerAccountSQLRepository()
+	journalEntryRepository := repository.NewJournalEntrySQLRepository()
+	exchangeRateRepository := repository.NewExchangeRateSQLRepository()
+	idempotencyKeyRepository := repository.NewIdempotencyKeySQLRepository()
+	holdRepository := repository.NewHoldSQLRepository()
+	walletStatusChangeRepository := repository.NewWalletStatusChangeSQLRepository()
+	walletMemberRepository := repository.NewWalletMemberSQLRepository()
+	categoryRepository := repository.NewCategorySQLRepository()
+	budgetRepository := repository.NewBudgetSQLRepository()
+	categoryRuleRepository := repository.NewCategoryRuleSQLRepository()
+	standingOrderRepository := repository.NewStandingOrderSQLRepository()
+	standingOrderRunRepository := repository.NewStandingOrderRunSQLRepository()
+	spendingLimitRepository := repository.NewSpendingLimitSQLRepository()
+	statementRepository := repository.NewStatementSQLRepository()
+	reconciliationFindingRepository := repository.NewReconciliationFindingSQLRepository()
+	paymentRequestRepository := repository.NewPaymentRequestSQLRepository()
+	paymentRequestStatusChangeRepository := repository.NewPaymentRequestStatusChangeSQLRepository()
+	billSplitRepository := repository.NewBillSplitSQLRepository()
+	topUpRepository := repository.NewTopUpSQLRepository()
+	payoutDestinationRepository := repository.NewPayoutDestinationSQLRepository()
+	payoutRepository := repository.NewPayoutSQLRepository()
+	feeRuleRepository := repository.NewFeeRuleSQLRepository()
+	campaignRepository := repository.NewCampaignSQLRepository()
+	rewardRepository := repository.NewRewardSQLRepository()
 
 	// service
 	userService := services.NewUserService(sqlClient.GetDB(), userRepository, signaturer, validate)
 	productService := services.NewProductService(sqlClient.GetDB(), productRepository, validate)
-	walletService := services.NewWalletService(sqlClient.GetDB(), walletRepository, userRepository, transactionRepository, validate)
-	transactionService := services.NewTransactionService(sqlClient.GetDB(), transactionRepository, productRepository, walletRepository, validate)
+	ledgerService := services.NewLedgerService(sqlClient.GetDB(), ledgerAccountRepository, journalEntryRepository, walletRepository, validate)
+	exchangeRateService := services.NewExchangeRateService(sqlClient.GetDB(), exchangeRateRepository, validate)
+	idempotencyService := services.NewIdempotencyService(sqlClient.GetDB(), idempotencyKeyRepository, validate, conf.IdempotencyConfig.LockTimeout)
+	spendingLimitService := services.NewSpendingLimitService(sqlClient.GetDB(), spendingLimitRepository, walletRepository, walletMemberRepository, transactionRepository, exchangeRateService, validate, spendingLimitDefaults(conf))
+	feeService := services.NewFeeService(sqlClient.GetDB(), feeRuleRepository, walletRepository, walletMemberRepository, validate, conf.FeeConfig.HouseWalletId)
+	rewardService := services.NewRewardService(sqlClient.GetDB(), rewardRepository, campaignRepository, walletRepository, transactionRepository, categoryRepository, ledgerService, validate)
+	transactionService := services.NewTransactionService(sqlClient.GetDB(), transactionRepository, productRepository, walletRepository, holdRepository, ledgerService, exchangeRateService, spendingLimitService, feeService, rewardService, walletMemberRepository, categoryRepository, categoryRuleRepository, userRepository, paymentRequestRepository, paymentRequestStatusChangeRepository, validate)
+	walletService := services.NewWalletService(sqlClient.GetDB(), walletRepository, userRepository, transactionRepository, holdRepository, walletStatusChangeRepository, walletMemberRepository, standingOrderRepository, transactionService, validate)
+	holdService := services.NewHoldService(sqlClient.GetDB(), holdRepository, walletRepository, walletMemberRepository, transactionService, validate, conf.HoldConfig.DefaultTTL, conf.HoldConfig.MaxTTL)
+	standingOrderService := services.NewStandingOrderService(sqlClient.GetDB(), standingOrderRepository, standingOrderRunRepository, walletRepository, walletMemberRepository, transactionService, validate, conf.ScheduleConfig.BatchSize, conf.ScheduleConfig.MaxRetries, conf.ScheduleConfig.RetryDelay)
+	analyticsService := services.NewAnalyticsService(sqlClient.GetDB(), transactionRepository, productRepository, categoryRepository, walletMemberRepository, validate)
+	statementService := services.NewStatementService(sqlClient.GetDB(), statementRepository, walletRepository, walletMemberRepository, transactionRepository, validate, conf.StatementConfig.BatchSize)
+	reconciliationService := services.NewReconciliationService(sqlClient.GetDB(), reconciliationFindingRepository, walletRepository, transactionRepository, ledgerService, validate, conf.ReconcileConfig.BatchSize)
+	categoryService := services.NewCategoryService(sqlClient.GetDB(), categoryRepository, validate)
+	budgetService := services.NewBudgetService(sqlClient.GetDB(), budgetRepository, categoryRepository, walletRepository, transactionRepository, walletMemberRepository, validate)
+	categoryRuleService := services.NewCategoryRuleService(sqlClient.GetDB(), categoryRuleRepository, categoryRepository, walletRepository, productRepository, transactionRepository, walletMemberRepository, validate)
+	paymentRequestService := services.NewPaymentRequestService(sqlClient.GetDB(), paymentRequestRepository, paymentRequestStatusChangeRepository, userRepository, walletRepository, walletMemberRepository, transactionService, validate, conf.PaymentRequestConfig.DefaultTTL, conf.PaymentRequestConfig.MaxTTL)
+	billSplitService := services.NewBillSplitService(sqlClient.GetDB(), billSplitRepository, userRepository, walletRepository, transactionRepository, walletMemberRepository, paymentRequestService, validate)
+	topUpService := services.NewTopUpService(sqlClient.GetDB(), topUpRepository, walletRepository, walletMemberRepository, transactionService, paymentProvider, validate, conf.PaymentGatewayConfig.TopUpTTL)
+	payoutDestinationService := services.NewPayoutDestinationService(sqlClient.GetDB(), payoutDestinationRepository, payoutProvider, validate)
+	campaignService := services.NewCampaignService(sqlClient.GetDB(), campaignRepository, categoryRepository, productRepository, validate)
+	payoutService := services.NewPayoutService(sqlClient.GetDB(), payoutRepository, payoutDestinationRepository, walletRepository, transactionService, ledgerService, payoutProvider, validate, conf.PayoutConfig.ReturnWindow)
 	// Handler
 	userHandler := http.NewUserHTTPHandler(userService)
 	productHandler := http.NewProductHTTPHandler(productService)
 	walletHandler := http.NewWalletHTTPHandler(walletService)
 	transactionHandler := http.NewTransactionHTTPHandler(transactionService)
+	ledgerHandler := http.NewLedgerHTTPHandler(ledgerService)
+	exchangeRateHandler := http.NewExchangeRateHTTPHandler(exchangeRateService)
+	holdHandler := http.NewHoldHTTPHandler(holdService)
+	standingOrderHandler := http.NewStandingOrderHTTPHandler(standingOrderService)
+	spendingLimitHandler := http.NewSpendingLimitHTTPHandler(spendingLimitService)
+	statementHandler := http.NewStatementHTTPHandler(statementService)
+	reconciliationHandler := http.NewReconciliationHTTPHandler(reconciliationService)
+	categoryHandler := http.NewCategoryHTTPHandler(categoryService)
+	budgetHandler := http.NewBudgetHTTPHandler(budgetService)
+	categoryRuleHandler := http.NewCategoryRuleHTTPHandler(categoryRuleService)
+	paymentRequestHandler := http.NewPaymentRequestHTTPHandler(paymentRequestService)
+	billSplitHandler := http.NewBillSplitHTTPHandler(billSplitService)
+	topUpHandler := http.NewTopUpHTTPHandler(topUpService)
+	payoutDestinationHandler := http.NewPayoutDestinationHTTPHandler(payoutDestinationService)
+	payoutHandler := http.NewPayoutHTTPHandler(payoutService)
+	feeHandler := http.NewFeeHTTPHandler(feeService)
+	campaignHandler := http.NewCampaignHTTPHandler(campaignService)
+	rewardHandler := http.NewRewardHTTPHandler(rewardService)
+	analyticsHandler := http.NewAnalyticsHTTPHandler(analyticsService)
 
 	router := route.Router{
-		App:                ginServer.App,
-		UserHandler:        userHandler,
-		ProductHandler:     productHandler,
-		WalletHandler:      walletHandler,
-		TransactionHandler: transactionHandler,
-		AuthMiddleware:     api.NewAuthMiddleware(signaturer),
+		App:                      ginServer.App,
+		UserHandler:              userHandler,
+		ProductHandler:           productHandler,
+		WalletHandler:            walletHandler,
+		TransactionHandler:       transactionHandler,
+		LedgerHandler:            ledgerHandler,
+		ExchangeRateHandler:      exchangeRateHandler,
+		HoldHandler:              holdHandler,
+		StandingOrderHandler:     standingOrderHandler,
+		SpendingLimitHandler:     spendingLimitHandler,
+		StatementHandler:         statementHandler,
+		ReconciliationHandler:    reconciliationHandler,
+		CategoryHandler:          categoryHandler,
+		BudgetHandler:            budgetHandler,
+		CategoryRuleHandler:      categoryRuleHandler,
+		PaymentRequestHandler:    paymentRequestHandler,
+		BillSplitHandler:         billSplitHandler,
+		TopUpHandler:             topUpHandler,
+		PayoutDestinationHandler: payoutDestinationHandler,
+		PayoutHandler:            payoutHandler,
+		FeeHandler:               feeHandler,
+		CampaignHandler:          campaignHandler,
+		RewardHandler:            rewardHandler,
+		AnalyticsHandler:         analyticsHandler,
+		AuthMiddleware:           api.NewAuthMiddleware(signaturer),
+		IdempotencyMiddleware:    api.NewIdempotencyMiddleware(idempotencyService),
 	}
 	router.SwaggerRouter()
 	router.Setup()
@@ -91,6 +183,14 @@ func main() {
 	go func() {
 		echan <- ginServer.Start()
 	}()
+	go expireHolds(holdService, conf.HoldConfig.ExpiryInterval)
+	go runStandingOrders(standingOrderService, conf.ScheduleConfig.Interval)
+	go closeStatements(statementService, conf.StatementConfig.Interval)
+	go reconcileBalances(reconciliationService, conf.ReconcileConfig.Interval, conf.ReconcileConfig.AutoCorrect)
+	go expirePaymentRequests(paymentRequestService, conf.PaymentRequestConfig.ExpiryInterval)
+	go expireTopUps(topUpService, conf.PaymentGatewayConfig.ExpiryInterval)
+	go syncPayouts(payoutService, conf.PayoutConfig.SyncInterval)
+	go releaseRewards(rewardService, conf.RewardConfig.ReleaseInterval)
 
 	term := make(chan os.Signal, 1)
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
@@ -99,10 +199,174 @@ func main() {
 	case <-term:
 		slog.Info("signal terminated detected")
 	case err := <-echan:
-		slog.Error("Failed to start http server", err)
+		slog.Error("Failed to start http server", "error", err)
 	}
 }
 
+// expireHolds releases the holds past their expiry, they already stop counting
+// against the available balance on expiry, this only settles their status.
+func expireHolds(holdService services.HoldService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		expired, errException := holdService.Expire(context.Background())
+		if errException != nil {
+			slog.Error("failed to expire holds", "error", errException.Error)
+			continue
+		}
+		if expired > 0 {
+			slog.Info("expired holds", "count", expired)
+		}
+	}
+}
+
+// runStandingOrders executes the due standing orders, a run is claimed before its
+// transfer so several instances can run the scheduler side by side.
+func runStandingOrders(standingOrderService services.StandingOrderService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ran, errException := standingOrderService.RunDue(context.Background())
+		if errException != nil {
+			slog.Error("failed to run standing orders", "error", errException.Error)
+			continue
+		}
+		if ran > 0 {
+			slog.Info("ran standing orders", "count", ran)
+		}
+	}
+}
+
+// closeStatements generates the statements of the month just closed, wallets that
+// already have one are left alone so a statement never changes on its own.
+func closeStatements(statementService services.StatementService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		generated, errException := statementService.CloseMonth(context.Background())
+		if errException != nil {
+			slog.Error("failed to generate statements", "error", errException.Error)
+			continue
+		}
+		if generated > 0 {
+			slog.Info("generated statements", "count", generated)
+		}
+	}
+}
+
+// reconcileBalances compares every wallet balance with its transactions, mismatches
+// are recorded as findings and, with autoCorrect, adjusted right away.
+func reconcileBalances(reconciliationService services.ReconciliationService, interval time.Duration, autoCorrect bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		result, errException := reconciliationService.Run(context.Background(), &model.RunReconciliationReq{AutoCorrect: autoCorrect})
+		if errException != nil {
+			slog.Error("failed to reconcile balances", "error", errException.Error)
+			continue
+		}
+		if result.Mismatched > 0 {
+			slog.Warn("wallet balances out of reconciliation", "checked", result.Checked, "mismatched", result.Mismatched, "corrected", result.Corrected)
+		}
+	}
+}
+
+// expirePaymentRequests closes the pending payment requests past their expiry, they
+// can no longer be paid once expired, this only settles their status.
+func expirePaymentRequests(paymentRequestService services.PaymentRequestService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		expired, errException := paymentRequestService.Expire(context.Background())
+		if errException != nil {
+			slog.Error("failed to expire payment requests", "error", errException.Error)
+			continue
+		}
+		if expired > 0 {
+			slog.Info("expired payment requests", "count", expired)
+		}
+	}
+}
+
+// expireTopUps closes the pending top ups whose checkout was never paid in time. A
+// payment the provider still reports afterwards is credited all the same.
+func expireTopUps(topUpService services.TopUpService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		expired, errException := topUpService.Expire(context.Background())
+		if errException != nil {
+			slog.Error("failed to expire top ups", "error", errException.Error)
+			continue
+		}
+		if expired > 0 {
+			slog.Info("expired top ups", "count", expired)
+		}
+	}
+}
+
+// syncPayouts polls the payout provider for the payouts in flight, settling the
+// paid ones and giving the money of the failed and returned ones back.
+func syncPayouts(payoutService services.PayoutService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		changed, errException := payoutService.Sync(context.Background())
+		if errException != nil {
+			slog.Error("failed to sync payouts", "error", errException.Error)
+			continue
+		}
+		if changed > 0 {
+			slog.Info("synced payouts", "count", changed)
+		}
+	}
+}
+
+// releaseRewards credits the rewards whose clearing period passed, cancelling
+// the ones whose transaction was reversed meanwhile.
+func releaseRewards(rewardService services.RewardService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		released, errException := rewardService.Release(context.Background())
+		if errException != nil {
+			slog.Error("failed to release rewards", "error", errException.Error)
+			continue
+		}
+		if released > 0 {
+			slog.Info("released rewards", "count", released)
+		}
+	}
+}
+
+func initMoney(conf *config.Config) {
+	money.DefaultCurrency = conf.MoneyConfig.DefaultCurrency
+	money.JSONEncoding, _ = money.ParseEncoding(conf.MoneyConfig.JSONEncoding)
+	money.DefaultRounding, _ = money.ParseRoundingMode(conf.MoneyConfig.Rounding)
+}
+
+// spendingLimitDefaults reads the configured default limits, in the default currency.
+func spendingLimitDefaults(conf *config.Config) entity.SpendingLimit {
+	var defaults entity.SpendingLimit
+	for _, limit := range []struct {
+		value  string
+		amount *money.Money
+	}{
+		{conf.LimitConfig.PerTransaction, &defaults.PerTransaction},
+		{conf.LimitConfig.Daily, &defaults.Daily},
+		{conf.LimitConfig.Weekly, &defaults.Weekly},
+		{conf.LimitConfig.Monthly, &defaults.Monthly},
+	} {
+		amount, err := money.Parse(limit.value, money.DefaultCurrency)
+		if err != nil {
+			slog.Error("Failed to load spending limit", "error", err)
+			os.Exit(1)
+		}
+		*limit.amount = amount
+	}
+	return defaults
+}
+
 func initInfrastructure(config *config.Config) {
 	//initPostgreSQL()
 	sqlClient = initSQL(config)
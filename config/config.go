@@ -11,9 +11,21 @@ import (
 )
 
 type Config struct {
-	AppEnvConfig   *AppConfig
-	DatabaseConfig *DatabaseConfig
-	AuthConfig     *Auth
+	AppEnvConfig         *AppConfig
+	DatabaseConfig       *DatabaseConfig
+	AuthConfig           *Auth
+	MoneyConfig          *MoneyConfig
+	HoldConfig           *HoldConfig
+	ScheduleConfig       *ScheduleConfig
+	LimitConfig          *LimitConfig
+	StatementConfig      *StatementConfig
+	ReconcileConfig      *ReconcileConfig
+	PaymentRequestConfig *PaymentRequestConfig
+	PaymentGatewayConfig *PaymentGatewayConfig
+	PayoutConfig         *PayoutConfig
+	FeeConfig            *FeeConfig
+	RewardConfig         *RewardConfig
+	IdempotencyConfig    *IdempotencyConfig
 }
 
 func (c Config) IsStaging() bool {
@@ -56,9 +68,21 @@ func InitAppConfig(validate *xvalidator.Validator) *Config {
 		}
 	}
 	c := Config{
-		AppEnvConfig:   AppConfigInit(),
-		DatabaseConfig: DatabaseConfigConfig(),
-		AuthConfig:     AuthConfig(),
+		AppEnvConfig:         AppConfigInit(),
+		DatabaseConfig:       DatabaseConfigConfig(),
+		AuthConfig:           AuthConfig(),
+		MoneyConfig:          MoneyConfigInit(),
+		HoldConfig:           HoldConfigInit(),
+		ScheduleConfig:       ScheduleConfigInit(),
+		LimitConfig:          LimitConfigInit(),
+		StatementConfig:      StatementConfigInit(),
+		ReconcileConfig:      ReconcileConfigInit(),
+		PaymentRequestConfig: PaymentRequestConfigInit(),
+		PaymentGatewayConfig: PaymentGatewayConfigInit(),
+		PayoutConfig:         PayoutConfigInit(),
+		FeeConfig:            FeeConfigInit(),
+		RewardConfig:         RewardConfigInit(),
+		IdempotencyConfig:    IdempotencyConfigInit(),
 	}
 	errs := validate.Struct(c)
 	if errs != nil {
@@ -0,0 +1,15 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+type FeeConfig struct {
+	HouseWalletId string `validate:"omitempty,uuid" name:"FEE_HOUSE_WALLET_ID"` // where fees are paid into, no fee is charged without it
+}
+
+func FeeConfigInit() *FeeConfig {
+	return &FeeConfig{
+		HouseWalletId: viper.GetString("FEE_HOUSE_WALLET_ID"),
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"time"
+)
+
+type HoldConfig struct {
+	DefaultTTL     time.Duration `validate:"required,gt=0" name:"HOLD_DEFAULT_TTL"`
+	MaxTTL         time.Duration `validate:"required,gtefield=DefaultTTL" name:"HOLD_MAX_TTL"`
+	ExpiryInterval time.Duration `validate:"required,gt=0" name:"HOLD_EXPIRY_INTERVAL"`
+}
+
+func HoldConfigInit() *HoldConfig {
+	viper.SetDefault("HOLD_DEFAULT_TTL", "168h")
+	viper.SetDefault("HOLD_MAX_TTL", "720h")
+	viper.SetDefault("HOLD_EXPIRY_INTERVAL", "1m")
+	return &HoldConfig{
+		DefaultTTL:     viper.GetDuration("HOLD_DEFAULT_TTL"),
+		MaxTTL:         viper.GetDuration("HOLD_MAX_TTL"),
+		ExpiryInterval: viper.GetDuration("HOLD_EXPIRY_INTERVAL"),
+	}
+}
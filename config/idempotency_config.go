@@ -0,0 +1,17 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"time"
+)
+
+type IdempotencyConfig struct {
+	LockTimeout time.Duration `validate:"required,gt=0" name:"IDEMPOTENCY_LOCK_TIMEOUT"`
+}
+
+func IdempotencyConfigInit() *IdempotencyConfig {
+	viper.SetDefault("IDEMPOTENCY_LOCK_TIMEOUT", "5m")
+	return &IdempotencyConfig{
+		LockTimeout: viper.GetDuration("IDEMPOTENCY_LOCK_TIMEOUT"),
+	}
+}
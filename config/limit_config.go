@@ -0,0 +1,27 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+// LimitConfig holds the default spending limits, in major units of the default
+// currency. Zero means no default for that limit.
+type LimitConfig struct {
+	PerTransaction string `validate:"required,numeric" name:"LIMIT_PER_TRANSACTION"`
+	Daily          string `validate:"required,numeric" name:"LIMIT_DAILY"`
+	Weekly         string `validate:"required,numeric" name:"LIMIT_WEEKLY"`
+	Monthly        string `validate:"required,numeric" name:"LIMIT_MONTHLY"`
+}
+
+func LimitConfigInit() *LimitConfig {
+	viper.SetDefault("LIMIT_PER_TRANSACTION", "10000000")
+	viper.SetDefault("LIMIT_DAILY", "20000000")
+	viper.SetDefault("LIMIT_WEEKLY", "50000000")
+	viper.SetDefault("LIMIT_MONTHLY", "100000000")
+	return &LimitConfig{
+		PerTransaction: viper.GetString("LIMIT_PER_TRANSACTION"),
+		Daily:          viper.GetString("LIMIT_DAILY"),
+		Weekly:         viper.GetString("LIMIT_WEEKLY"),
+		Monthly:        viper.GetString("LIMIT_MONTHLY"),
+	}
+}
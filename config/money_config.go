@@ -0,0 +1,22 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+type MoneyConfig struct {
+	DefaultCurrency string `validate:"required,currency" name:"MONEY_DEFAULT_CURRENCY"`
+	JSONEncoding    string `validate:"required,eq=string|eq=integer" name:"MONEY_JSON_ENCODING"`
+	Rounding        string `validate:"required,eq=half_even|eq=half_up|eq=half_down|eq=down|eq=up" name:"MONEY_ROUNDING"`
+}
+
+func MoneyConfigInit() *MoneyConfig {
+	viper.SetDefault("MONEY_DEFAULT_CURRENCY", "IDR")
+	viper.SetDefault("MONEY_JSON_ENCODING", "string")
+	viper.SetDefault("MONEY_ROUNDING", "half_even")
+	return &MoneyConfig{
+		DefaultCurrency: viper.GetString("MONEY_DEFAULT_CURRENCY"),
+		JSONEncoding:    viper.GetString("MONEY_JSON_ENCODING"),
+		Rounding:        viper.GetString("MONEY_ROUNDING"),
+	}
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"time"
+)
+
+type PaymentGatewayConfig struct {
+	CheckoutURL    string        `validate:"required,url" name:"PAYMENT_GATEWAY_CHECKOUT_URL"`
+	WebhookSecret  string        `validate:"required,min=32" name:"PAYMENT_GATEWAY_WEBHOOK_SECRET"`
+	TopUpTTL       time.Duration `validate:"required,gt=0" name:"TOPUP_TTL"`
+	ExpiryInterval time.Duration `validate:"required,gt=0" name:"TOPUP_EXPIRY_INTERVAL"`
+}
+
+func PaymentGatewayConfigInit() *PaymentGatewayConfig {
+	viper.SetDefault("PAYMENT_GATEWAY_CHECKOUT_URL", "http://localhost:9004/checkout")
+	viper.SetDefault("TOPUP_TTL", "1h")
+	viper.SetDefault("TOPUP_EXPIRY_INTERVAL", "1m")
+	return &PaymentGatewayConfig{
+		CheckoutURL:    viper.GetString("PAYMENT_GATEWAY_CHECKOUT_URL"),
+		WebhookSecret:  viper.GetString("PAYMENT_GATEWAY_WEBHOOK_SECRET"),
+		TopUpTTL:       viper.GetDuration("TOPUP_TTL"),
+		ExpiryInterval: viper.GetDuration("TOPUP_EXPIRY_INTERVAL"),
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"time"
+)
+
+type PaymentRequestConfig struct {
+	DefaultTTL     time.Duration `validate:"required,gt=0" name:"PAYMENT_REQUEST_DEFAULT_TTL"`
+	MaxTTL         time.Duration `validate:"required,gtefield=DefaultTTL" name:"PAYMENT_REQUEST_MAX_TTL"`
+	ExpiryInterval time.Duration `validate:"required,gt=0" name:"PAYMENT_REQUEST_EXPIRY_INTERVAL"`
+}
+
+func PaymentRequestConfigInit() *PaymentRequestConfig {
+	viper.SetDefault("PAYMENT_REQUEST_DEFAULT_TTL", "168h")
+	viper.SetDefault("PAYMENT_REQUEST_MAX_TTL", "720h")
+	viper.SetDefault("PAYMENT_REQUEST_EXPIRY_INTERVAL", "1m")
+	return &PaymentRequestConfig{
+		DefaultTTL:     viper.GetDuration("PAYMENT_REQUEST_DEFAULT_TTL"),
+		MaxTTL:         viper.GetDuration("PAYMENT_REQUEST_MAX_TTL"),
+		ExpiryInterval: viper.GetDuration("PAYMENT_REQUEST_EXPIRY_INTERVAL"),
+	}
+}
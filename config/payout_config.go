@@ -0,0 +1,23 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"time"
+)
+
+type PayoutConfig struct {
+	SyncInterval   time.Duration `validate:"required,gt=0" name:"PAYOUT_SYNC_INTERVAL"`
+	ReturnWindow   time.Duration `validate:"required,gt=0" name:"PAYOUT_RETURN_WINDOW"`   // how long a paid payout may still be returned
+	SimulatorDelay time.Duration `validate:"required,gt=0" name:"PAYOUT_SIMULATOR_DELAY"` // how long the simulated bank takes to settle a payout
+}
+
+func PayoutConfigInit() *PayoutConfig {
+	viper.SetDefault("PAYOUT_SYNC_INTERVAL", "1m")
+	viper.SetDefault("PAYOUT_RETURN_WINDOW", "72h")
+	viper.SetDefault("PAYOUT_SIMULATOR_DELAY", "30s")
+	return &PayoutConfig{
+		SyncInterval:   viper.GetDuration("PAYOUT_SYNC_INTERVAL"),
+		ReturnWindow:   viper.GetDuration("PAYOUT_RETURN_WINDOW"),
+		SimulatorDelay: viper.GetDuration("PAYOUT_SIMULATOR_DELAY"),
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"time"
+)
+
+type ReconcileConfig struct {
+	Interval    time.Duration `validate:"required,gt=0" name:"RECONCILE_INTERVAL"`
+	BatchSize   int           `validate:"required,gt=0" name:"RECONCILE_BATCH_SIZE"`
+	AutoCorrect bool          `name:"RECONCILE_AUTO_CORRECT"`
+}
+
+func ReconcileConfigInit() *ReconcileConfig {
+	viper.SetDefault("RECONCILE_INTERVAL", "1h")
+	viper.SetDefault("RECONCILE_BATCH_SIZE", 100)
+	viper.SetDefault("RECONCILE_AUTO_CORRECT", false)
+	return &ReconcileConfig{
+		Interval:    viper.GetDuration("RECONCILE_INTERVAL"),
+		BatchSize:   viper.GetInt("RECONCILE_BATCH_SIZE"),
+		AutoCorrect: viper.GetBool("RECONCILE_AUTO_CORRECT"),
+	}
+}
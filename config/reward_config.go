@@ -0,0 +1,17 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"time"
+)
+
+type RewardConfig struct {
+	ReleaseInterval time.Duration `validate:"required,gt=0" name:"REWARD_RELEASE_INTERVAL"` // how often cleared rewards are credited
+}
+
+func RewardConfigInit() *RewardConfig {
+	viper.SetDefault("REWARD_RELEASE_INTERVAL", "5m")
+	return &RewardConfig{
+		ReleaseInterval: viper.GetDuration("REWARD_RELEASE_INTERVAL"),
+	}
+}
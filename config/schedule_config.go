@@ -0,0 +1,26 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"time"
+)
+
+type ScheduleConfig struct {
+	Interval   time.Duration `validate:"required,gt=0" name:"SCHEDULE_INTERVAL"`
+	BatchSize  int           `validate:"required,gt=0" name:"SCHEDULE_BATCH_SIZE"`
+	MaxRetries int           `validate:"gte=0" name:"SCHEDULE_MAX_RETRIES"`
+	RetryDelay time.Duration `validate:"required,gt=0" name:"SCHEDULE_RETRY_DELAY"`
+}
+
+func ScheduleConfigInit() *ScheduleConfig {
+	viper.SetDefault("SCHEDULE_INTERVAL", "1m")
+	viper.SetDefault("SCHEDULE_BATCH_SIZE", 100)
+	viper.SetDefault("SCHEDULE_MAX_RETRIES", 3)
+	viper.SetDefault("SCHEDULE_RETRY_DELAY", "1h")
+	return &ScheduleConfig{
+		Interval:   viper.GetDuration("SCHEDULE_INTERVAL"),
+		BatchSize:  viper.GetInt("SCHEDULE_BATCH_SIZE"),
+		MaxRetries: viper.GetInt("SCHEDULE_MAX_RETRIES"),
+		RetryDelay: viper.GetDuration("SCHEDULE_RETRY_DELAY"),
+	}
+}
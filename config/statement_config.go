@@ -0,0 +1,20 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+	"time"
+)
+
+type StatementConfig struct {
+	Interval  time.Duration `validate:"required,gt=0" name:"STATEMENT_INTERVAL"`
+	BatchSize int           `validate:"required,gt=0" name:"STATEMENT_BATCH_SIZE"`
+}
+
+func StatementConfigInit() *StatementConfig {
+	viper.SetDefault("STATEMENT_INTERVAL", "1h")
+	viper.SetDefault("STATEMENT_BATCH_SIZE", 100)
+	return &StatementConfig{
+		Interval:  viper.GetDuration("STATEMENT_INTERVAL"),
+		BatchSize: viper.GetInt("STATEMENT_BATCH_SIZE"),
+	}
+}
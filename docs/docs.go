@@ -24,9 +24,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/login": {
-            "post": {
-                "description": "Authenticates the user and returns an access token",
+        "/analytics/cash-flow": {
+            "get": {
+                "description": "Sums your income, expenses and transfers per day, week or month and currency, net of refunds.\nCovers every wallet you are a member of unless wallet_id is given, periods without transactions are left out",
                 "consumes": [
                     "application/json"
                 ],
@@ -34,70 +34,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Analytics"
                 ],
-                "summary": "User login",
+                "summary": "Get your cash flow over time",
                 "parameters": [
                     {
-                        "description": "Login Request",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateUserReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "success",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.DataResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/model.LoginUserRes"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     },
-                    "400": {
-                        "description": "error",
-                        "schema": {
-                            "$ref": "#/definitions/response.DataResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/auth/register": {
-            "post": {
-                "description": "Registers a new user with the provided username and password",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Users"
-                ],
-                "summary": "Register a new user",
-                "parameters": [
                     {
-                        "description": "Registration Request",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateUserReq"
-                        }
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "wallet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date in YYYY-MM-DD format, included",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Period to sum by, month by default",
+                        "name": "period",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -112,7 +87,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.CreateUserRes"
+                                            "$ref": "#/definitions/model.GetCashFlowRes"
                                         }
                                     }
                                 }
@@ -128,9 +103,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
+        "/analytics/categories": {
             "get": {
-                "description": "Retrieves a list of all products with optional filters, pagination, and sorting",
+                "description": "Sums what you spent, transferred, withdrew or paid in fees per category, net of refunds and largest first.\nCovers every wallet you are a member of unless wallet_id is given",
                 "consumes": [
                     "application/json"
                 ],
@@ -138,9 +113,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Analytics"
                 ],
-                "summary": "Get all products",
+                "summary": "Get your spending by category",
                 "parameters": [
                     {
                         "type": "string",
@@ -151,26 +126,20 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Number of items per page",
-                        "name": "pageSize",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Page number",
-                        "name": "page",
+                        "description": "Wallet ID",
+                        "name": "wallet_id",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter rules",
-                        "name": "filter",
+                        "description": "Start date in YYYY-MM-DD format",
+                        "name": "from",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Sort rules",
-                        "name": "sort",
+                        "description": "End date in YYYY-MM-DD format, included",
+                        "name": "to",
                         "in": "query"
                     }
                 ],
@@ -186,7 +155,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.GetAllProductRes"
+                                            "$ref": "#/definitions/model.GetCategorySpendingRes"
                                         }
                                     }
                                 }
@@ -200,9 +169,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates a new product in the catalog",
+            }
+        },
+        "/analytics/products": {
+            "get": {
+                "description": "Ranks the products you bought by quantity, net of refunds.\nCovers every wallet you are a member of unless wallet_id is given",
                 "consumes": [
                     "application/json"
                 ],
@@ -210,9 +181,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Analytics"
                 ],
-                "summary": "Create a new product",
+                "summary": "Get your most bought products",
                 "parameters": [
                     {
                         "type": "string",
@@ -222,13 +193,28 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Create Product Request",
-                        "name": "product",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateProductReq"
-                        }
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "wallet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date in YYYY-MM-DD format, included",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of products, 10 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -243,7 +229,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.CreateProductRes"
+                                            "$ref": "#/definitions/model.GetTopProductsRes"
                                         }
                                     }
                                 }
@@ -259,9 +245,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{id}": {
+        "/analytics/wallets": {
             "get": {
-                "description": "Retrieves the details of a specific product by ID",
+                "description": "Sums what came in and went out of each of your wallets, the net being how much its balance changed.\nCovers every wallet you are a member of unless wallet_id is given, wallets that did not move are left out",
                 "consumes": [
                     "application/json"
                 ],
@@ -269,9 +255,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Analytics"
                 ],
-                "summary": "Get product details",
+                "summary": "Get the net cash flow of your wallets",
                 "parameters": [
                     {
                         "type": "string",
@@ -282,10 +268,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "uuid format",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Wallet ID",
+                        "name": "wallet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date in YYYY-MM-DD format, included",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -300,7 +297,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.GetProductByIDRes"
+                                            "$ref": "#/definitions/model.GetWalletCashFlowRes"
                                         }
                                     }
                                 }
@@ -314,9 +311,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates product details",
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Authenticates the user and returns an access token",
                 "consumes": [
                     "application/json"
                 ],
@@ -324,31 +323,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Users"
                 ],
-                "summary": "Update an existing product",
+                "summary": "User login",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "uuid format",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update Product Request",
-                        "name": "product",
+                        "description": "Login Request",
+                        "name": "login",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateProductReq"
+                            "$ref": "#/definitions/model.CreateUserReq"
                         }
                     }
                 ],
@@ -364,7 +349,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.UpdateProductRes"
+                                            "$ref": "#/definitions/model.LoginUserRes"
                                         }
                                     }
                                 }
@@ -378,9 +363,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a product by ID",
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Registers a new user with the provided username and password",
                 "consumes": [
                     "application/json"
                 ],
@@ -388,23 +375,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Users"
                 ],
-                "summary": "Delete a product",
+                "summary": "Register a new user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "uuid format",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Registration Request",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateUserReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -419,7 +401,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.DeleteProductRes"
+                                            "$ref": "#/definitions/model.CreateUserRes"
                                         }
                                     }
                                 }
@@ -435,9 +417,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/transactions": {
+        "/bill-splits": {
             "get": {
-                "description": "Retrieves all transactions with optional filters, pagination, and sorting",
+                "description": "Retrieves the splits you made, newest first by default. Shares you owe are among your incoming payment requests",
                 "consumes": [
                     "application/json"
                 ],
@@ -445,9 +427,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Bill Splits"
                 ],
-                "summary": "Get all transactions",
+                "summary": "Get your bill splits",
                 "parameters": [
                     {
                         "type": "string",
@@ -470,13 +452,13 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter rules\u003cbr\u003e\u003cbr\u003e### Rules Filter\u003cbr\u003erule:\u003cbr\u003e  * {Name of Field}:{value}:{Symbol}\u003cbr\u003e\u003cbr\u003eSymbols:\u003cbr\u003e  * eq (=)\u003cbr\u003e  * lt (\u003c)\u003cbr\u003e  * gt (\u003e)\u003cbr\u003e  * lte (\u003c=)\u003cbr\u003e  * gte (\u003e=)\u003cbr\u003e  * in (in)\u003cbr\u003e  * like (like)",
+                        "description": "Filter rules",
                         "name": "filter",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Sort rules:\u003cbr\u003e\u003cbr\u003e### Rules Sort\u003cbr\u003erule:\u003cbr\u003e  * {Name of Field}:{Symbol}\u003cbr\u003e\u003cbr\u003eSymbols:\u003cbr\u003e  * asc\u003cbr\u003e  * desc\u003cbr\u003e\u003cbr\u003e",
+                        "description": "Sort rules",
                         "name": "sort",
                         "in": "query"
                     }
@@ -493,7 +475,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.GetAllTransactionRes"
+                                            "$ref": "#/definitions/model.GetAllBillSplitRes"
                                         }
                                     }
                                 }
@@ -509,7 +491,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new transaction record",
+                "description": "Splits an expense transaction, or an ad hoc amount, among users equally, by exact amounts or by percents.\nEvery participant but you is sent a payment request for their share and settles it by paying the request.\nRounding leftovers go one minor unit at a time to the participants in the order they are listed",
                 "consumes": [
                     "application/json"
                 ],
@@ -517,9 +499,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Bill Splits"
                 ],
-                "summary": "Create a new transaction",
+                "summary": "Split a bill",
                 "parameters": [
                     {
                         "type": "string",
@@ -529,12 +511,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Create Transaction Request",
-                        "name": "transaction",
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create Bill Split Request",
+                        "name": "split",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateTransactionReq"
+                            "$ref": "#/definitions/model.CreateBillSplitReq"
                         }
                     }
                 ],
@@ -550,7 +538,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.CreateTransactionRes"
+                                            "$ref": "#/definitions/model.CreateBillSplitRes"
                                         }
                                     }
                                 }
@@ -562,13 +550,25 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.DataResponse"
                         }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
                     }
                 }
             }
         },
-        "/transactions/credit": {
-            "post": {
-                "description": "Credits a specific wallet with an amount",
+        "/bill-splits/{id}": {
+            "get": {
+                "description": "Retrieves a split with the payment request of every share and how much of it is settled,\nfor its creator and its participants",
                 "consumes": [
                     "application/json"
                 ],
@@ -576,9 +576,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Bill Splits"
                 ],
-                "summary": "Credit transaction",
+                "summary": "Get a bill split",
                 "parameters": [
                     {
                         "type": "string",
@@ -588,13 +588,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Credit Transaction Request",
-                        "name": "credit",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreditTransactionReq"
-                        }
+                        "type": "string",
+                        "description": "Bill Split ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -609,7 +607,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.CreditTransactionRes"
+                                            "$ref": "#/definitions/model.GetBillSplitByIDRes"
                                         }
                                     }
                                 }
@@ -621,13 +619,91 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.DataResponse"
                         }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
                     }
                 }
             }
         },
-        "/transactions/transfer": {
+        "/campaigns": {
+            "get": {
+                "description": "Retrieves a list of all campaigns with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Campaigns"
+                ],
+                "summary": "Get all campaigns",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllCampaignRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Transfers an amount from one wallet to another",
+                "description": "Creates a cashback or bonus campaign on purchases or top ups with its budget, dates and per-user cap, admin only",
                 "consumes": [
                     "application/json"
                 ],
@@ -635,9 +711,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Campaigns"
                 ],
-                "summary": "Transfer transaction",
+                "summary": "Create a new campaign",
                 "parameters": [
                     {
                         "type": "string",
@@ -647,12 +723,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Transfer Transaction Request",
-                        "name": "transfer",
+                        "description": "Create Campaign Request",
+                        "name": "campaign",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.TransferTransactionReq"
+                            "$ref": "#/definitions/model.CreateCampaignReq"
                         }
                     }
                 ],
@@ -668,7 +744,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.TransferTransactionRes"
+                                            "$ref": "#/definitions/model.CreateCampaignRes"
                                         }
                                     }
                                 }
@@ -680,13 +756,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.DataResponse"
                         }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
                     }
                 }
             }
         },
-        "/transactions/{id}": {
+        "/campaigns/{id}": {
             "get": {
-                "description": "Retrieves details of a specific transaction by ID",
+                "description": "Retrieves the details of a specific campaign by ID, with what it spent",
                 "consumes": [
                     "application/json"
                 ],
@@ -694,9 +776,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Campaigns"
                 ],
-                "summary": "Get transaction details",
+                "summary": "Get campaign details",
                 "parameters": [
                     {
                         "type": "string",
@@ -707,7 +789,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Transaction ID",
+                        "description": "uuid format",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -725,7 +807,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.GetTransactionByIDRes"
+                                            "$ref": "#/definitions/model.GetCampaignByIDRes"
                                         }
                                     }
                                 }
@@ -740,8 +822,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Deletes a transaction by ID",
+            "put": {
+                "description": "Replaces a campaign keeping what it already spent, deactivating it ends it, admin only",
                 "consumes": [
                     "application/json"
                 ],
@@ -749,9 +831,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Campaigns"
                 ],
-                "summary": "Delete a transaction",
+                "summary": "Update an existing campaign",
                 "parameters": [
                     {
                         "type": "string",
@@ -762,10 +844,19 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Transaction ID",
+                        "description": "uuid format",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update Campaign Request",
+                        "name": "campaign",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateCampaignReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -780,7 +871,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.DeleteTransactionRes"
+                                            "$ref": "#/definitions/model.UpdateCampaignRes"
                                         }
                                     }
                                 }
@@ -792,13 +883,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.DataResponse"
                         }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
                     }
                 }
             }
         },
-        "/wallets": {
+        "/categories": {
             "get": {
-                "description": "Retrieves all wallets for the user",
+                "description": "Retrieves the system categories and the custom ones of the user with optional filters, pagination, and sorting",
                 "consumes": [
                     "application/json"
                 ],
@@ -806,9 +903,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallets"
+                    "Categories"
                 ],
-                "summary": "Get all wallets",
+                "summary": "Get all categories",
                 "parameters": [
                     {
                         "type": "string",
@@ -831,13 +928,13 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter rules\u003cbr\u003e\u003cbr\u003e### Rules Filter\u003cbr\u003erule:\u003cbr\u003e  * {Name of Field}:{value}:{Symbol}\u003cbr\u003e\u003cbr\u003eSymbols:\u003cbr\u003e  * eq (=)\u003cbr\u003e  * lt (\u003c)\u003cbr\u003e  * gt (\u003e)\u003cbr\u003e  * lte (\u003c=)\u003cbr\u003e  * gte (\u003e=)\u003cbr\u003e  * in (in)\u003cbr\u003e  * like (like)",
+                        "description": "Filter rules",
                         "name": "filter",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Sort rules:\u003cbr\u003e\u003cbr\u003e### Rules Sort\u003cbr\u003erule:\u003cbr\u003e  * {Name of Field}:{Symbol}\u003cbr\u003e\u003cbr\u003eSymbols:\u003cbr\u003e  * asc\u003cbr\u003e  * desc\u003cbr\u003e\u003cbr\u003e",
+                        "description": "Sort rules",
                         "name": "sort",
                         "in": "query"
                     }
@@ -854,7 +951,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.GetAllWalletRes"
+                                            "$ref": "#/definitions/model.GetAllCategoryRes"
                                         }
                                     }
                                 }
@@ -870,7 +967,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new wallet for the user",
+                "description": "Creates a category only the user sees, named unlike the system categories and the other categories of the user",
                 "consumes": [
                     "application/json"
                 ],
@@ -878,9 +975,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallets"
+                    "Categories"
                 ],
-                "summary": "Create a new wallet",
+                "summary": "Create a custom category",
                 "parameters": [
                     {
                         "type": "string",
@@ -890,21 +987,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Create Wallet Request",
-                        "name": "wallet",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateWalletReq"
-                        }
-                    },
-                    {
-                        "description": "Update Wallet Request",
-                        "name": "wallet",
+                        "description": "Create Category Request",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateWalletReq"
+                            "$ref": "#/definitions/model.CreateCategoryReq"
                         }
                     }
                 ],
@@ -920,7 +1008,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.CreateWalletRes"
+                                            "$ref": "#/definitions/model.CreateCategoryRes"
                                         }
                                     }
                                 }
@@ -936,9 +1024,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/wallets/transaction/{id}": {
+        "/categories/{id}": {
             "get": {
-                "description": "Retrieves details of a specific wallet, including transactions within a date range",
+                "description": "Retrieves a system category or a custom category of the user by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -946,9 +1034,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallets"
+                    "Categories"
                 ],
-                "summary": "Get wallet transaction details",
+                "summary": "Get category details",
                 "parameters": [
                     {
                         "type": "string",
@@ -959,22 +1047,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Wallet ID",
+                        "description": "uuid format",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Start date for transactions in YYYY-MM-DD format",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date for transactions in YYYY-MM-DD format",
-                        "name": "to",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -989,7 +1065,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.GetWalletByTransactionRes"
+                                            "$ref": "#/definitions/model.GetCategoryByIDRes"
                                         }
                                     }
                                 }
@@ -1003,11 +1079,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/wallets/{id}": {
-            "get": {
-                "description": "Retrieves details of a specific wallet by ID",
+            },
+            "put": {
+                "description": "Renames a custom category of the user, system categories cannot be changed",
                 "consumes": [
                     "application/json"
                 ],
@@ -1015,9 +1089,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallets"
+                    "Categories"
                 ],
-                "summary": "Get wallet details",
+                "summary": "Update a custom category",
                 "parameters": [
                     {
                         "type": "string",
@@ -1028,10 +1102,19 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Wallet ID",
+                        "description": "uuid format",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update Category Request",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateCategoryReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -1046,7 +1129,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.GetWalletByIDRes"
+                                            "$ref": "#/definitions/model.UpdateCategoryRes"
                                         }
                                     }
                                 }
@@ -1058,11 +1141,17 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.DataResponse"
                         }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
                     }
                 }
             },
-            "put": {
-                "description": "Updates wallet information",
+            "delete": {
+                "description": "Deletes a custom category of the user, its transactions become uncategorized and its budgets are deleted",
                 "consumes": [
                     "application/json"
                 ],
@@ -1070,9 +1159,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallets"
+                    "Categories"
                 ],
-                "summary": "Update an existing wallet",
+                "summary": "Delete a custom category",
                 "parameters": [
                     {
                         "type": "string",
@@ -1083,19 +1172,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Wallet ID",
+                        "description": "uuid format",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update Wallet Request",
-                        "name": "wallet",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateWalletReq"
-                        }
                     }
                 ],
                 "responses": {
@@ -1110,7 +1190,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.UpdateWalletRes"
+                                            "$ref": "#/definitions/model.DeleteCategoryRes"
                                         }
                                     }
                                 }
@@ -1122,11 +1202,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.DataResponse"
                         }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a wallet by ID",
+            }
+        },
+        "/exchange-rates": {
+            "get": {
+                "description": "Retrieves a list of all exchange rates with optional filters, pagination, and sorting",
                 "consumes": [
                     "application/json"
                 ],
@@ -1134,9 +1222,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallets"
+                    "ExchangeRates"
                 ],
-                "summary": "Delete a wallet",
+                "summary": "Get all exchange rates",
                 "parameters": [
                     {
                         "type": "string",
@@ -1147,10 +1235,27 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Wallet ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1165,7 +1270,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.DeleteWalletRes"
+                                            "$ref": "#/definitions/model.GetAllExchangeRateRes"
                                         }
                                     }
                                 }
@@ -1179,217 +1284,13984 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "entity.Product": {
-            "type": "object",
-            "properties": {
-                "available": {
-                    "type": "boolean"
-                },
-                "created_at": {
-                    "type": "string"
+            },
+            "post": {
+                "description": "Creates the rate of a currency pair, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ExchangeRates"
+                ],
+                "summary": "Create a new exchange rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Exchange Rate Request",
+                        "name": "exchangeRate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateExchangeRateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreateExchangeRateRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/exchange-rates/{id}": {
+            "get": {
+                "description": "Retrieves the details of a specific exchange rate by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ExchangeRates"
+                ],
+                "summary": "Get exchange rate details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetExchangeRateByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates the rate of a currency pair, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ExchangeRates"
+                ],
+                "summary": "Update an existing exchange rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Exchange Rate Request",
+                        "name": "exchangeRate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateExchangeRateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UpdateExchangeRateRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an exchange rate by ID, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ExchangeRates"
+                ],
+                "summary": "Delete an exchange rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.DeleteExchangeRateRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/fee-rules": {
+            "get": {
+                "description": "Retrieves a list of all fee rules with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Get all fee rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllFeeRuleRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Prices transfers, purchases or withdrawals in a currency, optionally for one wallet tier and amount range, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Create a new fee rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Fee Rule Request",
+                        "name": "feeRule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateFeeRuleReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreateFeeRuleRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/fee-rules/{id}": {
+            "get": {
+                "description": "Retrieves the details of a specific fee rule by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Get fee rule details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetFeeRuleByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces a fee rule, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Update an existing fee rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Fee Rule Request",
+                        "name": "feeRule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateFeeRuleReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UpdateFeeRuleRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a fee rule by ID, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Delete a fee rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.DeleteFeeRuleRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/fees/quote": {
+            "post": {
+                "description": "Computes the fee a transfer, purchase or withdrawal of an amount out of a wallet would be charged, nothing is booked",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Quote the fee of an operation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Quote Fee Request",
+                        "name": "quote",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.QuoteFeeReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.QuoteFeeRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/holds": {
+            "get": {
+                "description": "Retrieves the holds on the wallets you are a member of with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holds"
+                ],
+                "summary": "Get all holds",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllHoldRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Reserves funds of a wallet without moving them, the available balance drops but the ledger balance does not",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holds"
+                ],
+                "summary": "Authorize a hold",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Authorize Hold Request",
+                        "name": "hold",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.AuthorizeHoldReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.AuthorizeHoldRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/holds/{id}": {
+            "get": {
+                "description": "Retrieves the details of a specific hold by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holds"
+                ],
+                "summary": "Get hold details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hold ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetHoldByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/holds/{id}/capture": {
+            "post": {
+                "description": "Books all or part of a hold as an expense transaction, the part not captured is released",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holds"
+                ],
+                "summary": "Capture a hold",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hold ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Capture Hold Request",
+                        "name": "capture",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/model.CaptureHoldReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CaptureHoldRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/holds/{id}/void": {
+            "post": {
+                "description": "Releases a hold without moving any funds",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holds"
+                ],
+                "summary": "Void a hold",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hold ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.VoidHoldRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/accounts": {
+            "get": {
+                "description": "Retrieves the wallet, system and merchant accounts of the double-entry ledger, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ledger"
+                ],
+                "summary": "Get all ledger accounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllLedgerAccountRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/entries": {
+            "get": {
+                "description": "Retrieves the posted journal entries of the double-entry ledger, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ledger"
+                ],
+                "summary": "Get all journal entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllJournalEntryRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/entries/{id}": {
+            "get": {
+                "description": "Retrieves a journal entry with its debit and credit lines, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ledger"
+                ],
+                "summary": "Get journal entry details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Journal entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetJournalEntryByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment-requests": {
+            "get": {
+                "description": "Retrieves the requests made to you, or with direction outgoing the ones you made, newest first by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Get your payment requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "incoming (default) or outgoing",
+                        "name": "direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllPaymentRequestRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Asks the user going by payer_username for an amount paid into one of your wallets, it stays pending\nuntil they pay or decline it, you cancel it or it expires. Requires the spender role on the wallet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Request money from a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create Payment Request Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatePaymentRequestReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreatePaymentRequestRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment-requests/{id}": {
+            "get": {
+                "description": "Retrieves a request you made or were asked to pay",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Get a payment request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payment Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetPaymentRequestByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment-requests/{id}/cancel": {
+            "post": {
+                "description": "Withdraws a pending request you made",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Cancel a payment request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payment Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancel Payment Request Request",
+                        "name": "cancel",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/model.CancelPaymentRequestReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CancelPaymentRequestRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment-requests/{id}/decline": {
+            "post": {
+                "description": "Turns down a pending request made to you, the reason is kept in its status history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Decline a payment request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payment Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decline Payment Request Request",
+                        "name": "decline",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/model.DeclinePaymentRequestReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.DeclinePaymentRequestRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment-requests/{id}/pay": {
+            "post": {
+                "description": "Transfers the requested amount out of the wallet you pick, converted when its currency differs.\nOnly the payer can pay, and only while the request is pending",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Pay a payment request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payment Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pay Payment Request Request",
+                        "name": "pay",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PayPaymentRequestReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.PayPaymentRequestRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment-requests/{id}/status-history": {
+            "get": {
+                "description": "Retrieves every status change of a request, newest first by default. Expiry has no changed_by",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Get the status history of a payment request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payment Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllPaymentRequestStatusChangeRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payout-destinations": {
+            "get": {
+                "description": "Retrieves the bank accounts you saved, newest first by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payout Destinations"
+                ],
+                "summary": "Get your payout destinations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllPayoutDestinationRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Saves a bank account to withdraw to and verifies it with the payout provider. It stays pending\nwhen the provider cannot be reached, only verified destinations can be paid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payout Destinations"
+                ],
+                "summary": "Save a payout destination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create Payout Destination Request",
+                        "name": "destination",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatePayoutDestinationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreatePayoutDestinationRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "the account is already saved, or key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payout-destinations/{id}": {
+            "get": {
+                "description": "Retrieves a bank account you saved with its verification status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payout Destinations"
+                ],
+                "summary": "Get a payout destination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payout Destination ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetPayoutDestinationByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Forgets a saved bank account, payouts already sent to it keep a copy of the account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payout Destinations"
+                ],
+                "summary": "Delete a payout destination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payout Destination ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.DeletePayoutDestinationRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payout-destinations/{id}/verify": {
+            "post": {
+                "description": "Asks the payout provider again about a destination left pending",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payout Destinations"
+                ],
+                "summary": "Verify a payout destination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payout Destination ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.VerifyPayoutDestinationRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts": {
+            "get": {
+                "description": "Retrieves the payouts you made, newest first by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Get your payouts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllPayoutRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Debits the wallet and sends the money to one of your verified payout destinations. The payout goes\nfrom submitted to paid or failed, and a paid payout can still be returned by the bank. The wallet\ngets the money back by itself when the payout fails or is returned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Withdraw to a bank account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create Payout Request",
+                        "name": "payout",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatePayoutReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreatePayoutRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "the provider refused the payout, its money was given back",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/{id}": {
+            "get": {
+                "description": "Retrieves a payout you made with its status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Get a payout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetPayoutByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "description": "Retrieves a list of all products with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get all products",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllProductRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new product in the catalog",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Create a new product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Product Request",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateProductReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreateProductRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "description": "Retrieves the details of a specific product by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get product details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetProductByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates product details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update an existing product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Product Request",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateProductReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UpdateProductRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a product by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.DeleteProductRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation/findings": {
+            "get": {
+                "description": "Retrieves the wallets found out of balance with optional filters, pagination, and sorting, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "Get all reconciliation findings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllReconciliationFindingRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation/findings/{id}": {
+            "get": {
+                "description": "Retrieves a reconciliation finding by ID, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "Get reconciliation finding details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetReconciliationFindingByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation/findings/{id}/correct": {
+            "post": {
+                "description": "Checks the wallet of an open finding again, rebuilds its balance from the ledger and books an adjustment transaction for what its history misses, admin only.\nThe adjustment only completes the transaction history, no money moves as the ledger is left as it is",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "Correct a reconciliation finding",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid format",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CorrectReconciliationFindingRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation/runs": {
+            "post": {
+                "description": "Compares the balance of every wallet with the sum of its transactions and with its ledger account and records the mismatches as findings, admin only.\nAuto correction rebuilds the wallet balance from the ledger and books an adjustment transaction that only completes the history, the ledger itself is never posted to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "Reconcile wallet balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Run Reconciliation Request",
+                        "name": "run",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RunReconciliationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.RunReconciliationRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rewards": {
+            "get": {
+                "description": "Retrieves the rewards campaigns granted you, pending or credited, newest first by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rewards"
+                ],
+                "summary": "Get your rewards",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllRewardRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rewards/{id}": {
+            "get": {
+                "description": "Retrieves a reward you were granted with its status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rewards"
+                ],
+                "summary": "Get a reward",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reward ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetRewardByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/top-ups": {
+            "get": {
+                "description": "Retrieves the top ups you started, newest first by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Top Ups"
+                ],
+                "summary": "Get your top ups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllTopUpRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Starts a pending top up and opens a checkout for it at the payment provider. Pay at the returned\ncheckout URL, the wallet is credited once the provider confirms the payment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Top Ups"
+                ],
+                "summary": "Top up a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create Top Up Request",
+                        "name": "topUp",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateTopUpReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreateTopUpRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "the provider could not open a checkout",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/top-ups/{id}": {
+            "get": {
+                "description": "Retrieves a top up you started with its status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Top Ups"
+                ],
+                "summary": "Get a top up",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Top Up ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetTopUpByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions": {
+            "get": {
+                "description": "Retrieves all transactions with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Get all transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules\u003cbr\u003e\u003cbr\u003e### Rules Filter\u003cbr\u003erule:\u003cbr\u003e  * {Name of Field}:{value}:{Symbol}\u003cbr\u003e\u003cbr\u003eSymbols:\u003cbr\u003e  * eq (=)\u003cbr\u003e  * lt (\u003c)\u003cbr\u003e  * gt (\u003e)\u003cbr\u003e  * lte (\u003c=)\u003cbr\u003e  * gte (\u003e=)\u003cbr\u003e  * in (in)\u003cbr\u003e  * like (like)",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules:\u003cbr\u003e\u003cbr\u003e### Rules Sort\u003cbr\u003erule:\u003cbr\u003e  * {Name of Field}:{Symbol}\u003cbr\u003e\u003cbr\u003eSymbols:\u003cbr\u003e  * asc\u003cbr\u003e  * desc\u003cbr\u003e\u003cbr\u003e",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllTransactionRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new transaction record",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Create a new transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create Transaction Request",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateTransactionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreateTransactionRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/credit": {
+            "post": {
+                "description": "Credits a specific wallet with an amount",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Credit transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Credit Transaction Request",
+                        "name": "credit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreditTransactionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreditTransactionRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/transfer": {
+            "post": {
+                "description": "Transfers an amount from one wallet to another, the receiver given by wallet id or by username.\nA transfer to a username goes to their wallet named receiver_wallet, else to their default wallet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Transfer transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Transfer Transaction Request",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.TransferTransactionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.TransferTransactionRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/transfer/preview": {
+            "post": {
+                "description": "Resolves the wallet a transfer would pay into and converts its amount without booking anything,\nshowing the masked username of the receiver for the payer to confirm before transferring to receiver_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Preview a transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Transfer Transaction Request",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.TransferTransactionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.PreviewTransferRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "receiver not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}": {
+            "get": {
+                "description": "Retrieves details of a specific transaction by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Get transaction details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetTransactionByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Reverses what is left of a transaction with compensating transactions linked to it, restoring the wallet balances and the product stock of a purchase. The original is kept and marked as reversed. A transfer is reversed by the owner of the receiving wallet, a payment request it paid goes back to pending.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Reverse a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ReverseTransactionRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/category": {
+            "put": {
+                "description": "Files a transaction under a system category or a custom category of the user, members who may spend from its wallet only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Categorize a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Categorize Transaction Request",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CategorizeTransactionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CategorizeTransactionRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/refund": {
+            "post": {
+                "description": "Refunds a number of the items bought, which go back in stock, or an amount in the wallet currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Refund part of a purchase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Refund Transaction Request",
+                        "name": "refund",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RefundTransactionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.RefundTransactionRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/reverse": {
+            "post": {
+                "description": "Reverses what is left of a transaction with compensating transactions linked to it, restoring the wallet balances and the product stock of a purchase. The original is kept and marked as reversed. A transfer is reversed by the owner of the receiving wallet, a payment request it paid goes back to pending.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Reverse a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the request is retried with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ReverseTransactionRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "key reused for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets": {
+            "get": {
+                "description": "Retrieves all wallets for the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Get all wallets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules\u003cbr\u003e\u003cbr\u003e### Rules Filter\u003cbr\u003erule:\u003cbr\u003e  * {Name of Field}:{value}:{Symbol}\u003cbr\u003e\u003cbr\u003eSymbols:\u003cbr\u003e  * eq (=)\u003cbr\u003e  * lt (\u003c)\u003cbr\u003e  * gt (\u003e)\u003cbr\u003e  * lte (\u003c=)\u003cbr\u003e  * gte (\u003e=)\u003cbr\u003e  * in (in)\u003cbr\u003e  * like (like)",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules:\u003cbr\u003e\u003cbr\u003e### Rules Sort\u003cbr\u003erule:\u003cbr\u003e  * {Name of Field}:{Symbol}\u003cbr\u003e\u003cbr\u003eSymbols:\u003cbr\u003e  * asc\u003cbr\u003e  * desc\u003cbr\u003e\u003cbr\u003e",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllWalletRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new wallet for the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Create a new wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Wallet Request",
+                        "name": "wallet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateWalletReq"
+                        }
+                    },
+                    {
+                        "description": "Update Wallet Request",
+                        "name": "wallet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateWalletReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreateWalletRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/transaction/{id}": {
+            "get": {
+                "description": "Retrieves details of a specific wallet, including transactions within a date range",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Get wallet transaction details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date for transactions in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date for transactions in YYYY-MM-DD format",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetWalletByTransactionRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}": {
+            "get": {
+                "description": "Retrieves details of a specific wallet by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Get wallet details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetWalletByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates wallet information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Update an existing wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Wallet Request",
+                        "name": "wallet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateWalletReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UpdateWalletRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Closes a wallet of the user for good, a wallet holding money has its balance swept to another wallet of the user first.\nAuthorized holds must be settled before and the standing orders of the wallet are cancelled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Close a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID receiving the remaining balance",
+                        "name": "sweep_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CloseWalletRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/budgets": {
+            "get": {
+                "description": "Retrieves the budgets of a wallet with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Budgets"
+                ],
+                "summary": "Get the budgets of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllBudgetRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Sets what the wallet means to spend on an expense category each calendar month, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Budgets"
+                ],
+                "summary": "Create a budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Budget Request",
+                        "name": "budget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateBudgetReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreateBudgetRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/budgets/status": {
+            "get": {
+                "description": "Reports what was spent against each budget over a calendar month in UTC, net of refunds,\nalong with what was spent outside the budgeted categories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Budgets"
+                ],
+                "summary": "Get the budget status of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month formatted as YYYY-MM, defaults to the current month",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetBudgetStatusRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/budgets/{budget_id}": {
+            "put": {
+                "description": "Changes the monthly amount of a budget, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Budgets"
+                ],
+                "summary": "Update a budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Budget ID",
+                        "name": "budget_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Budget Request",
+                        "name": "budget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateBudgetReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UpdateBudgetRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a budget of the wallet, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Budgets"
+                ],
+                "summary": "Delete a budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Budget ID",
+                        "name": "budget_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.DeleteBudgetRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/default": {
+            "put": {
+                "description": "Makes a wallet the user opened the one transfers to their username go to when no wallet is named",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Set the default wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.SetDefaultWalletRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/freeze": {
+            "post": {
+                "description": "Blocks the debits of an active wallet, credits are still accepted, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Freeze a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Change Wallet Status Request",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ChangeWalletStatusReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChangeWalletStatusRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/limits": {
+            "get": {
+                "description": "Retrieves the per transaction, daily, weekly and monthly limits of a wallet with the allowance left over their rolling windows",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Get the spending limits of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetSpendingLimitRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the limits of a wallet, in the wallet's currency, a limit left out falls back to the configured default.\nOnly the owners of the wallet can change its limits",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Update the spending limits of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Spending Limit Request",
+                        "name": "limits",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateSpendingLimitReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UpdateSpendingLimitRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/members": {
+            "get": {
+                "description": "Retrieves the members of a wallet with their role and allowance, with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Get the members of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllWalletMemberRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds the user with the given username as owner, spender or viewer of the wallet, owners only.\nA spender may be given an allowance, what they may spend over 30 days",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Share a wallet with another user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Add Wallet Member Request",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.AddWalletMemberReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.AddWalletMemberRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/members/{member_id}": {
+            "put": {
+                "description": "Changes the role or the allowance of a member, owners only. The last owner cannot be demoted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Update a wallet member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet Member ID",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Wallet Member Request",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateWalletMemberReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UpdateWalletMemberRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Takes a member off the wallet, owners may remove anyone and members may remove themselves. The last owner cannot be removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Remove a wallet member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet Member ID",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.RemoveWalletMemberRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/rules": {
+            "get": {
+                "description": "Retrieves the rules of a wallet in the order they run, with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category Rules"
+                ],
+                "summary": "Get the category rules of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllCategoryRuleRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Files and tags new transactions of the wallet meeting every condition of the rule, owners only.\nRules run by priority, lowest first: the first matching rule with a category decides it and every matching rule adds its tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category Rules"
+                ],
+                "summary": "Create a category rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Category Rule Request",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateCategoryRuleReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreateCategoryRuleRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/rules/apply": {
+            "post": {
+                "description": "Runs the rules of the wallet over every transaction it booked. A matching rule overrides the category\na transaction was given by hand, transactions no rule matches are left as they are, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category Rules"
+                ],
+                "summary": "Apply the category rules to past transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ApplyCategoryRulesRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/rules/{rule_id}": {
+            "put": {
+                "description": "Replaces the conditions and the actions of a rule, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category Rules"
+                ],
+                "summary": "Update a category rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category Rule ID",
+                        "name": "rule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Category Rule Request",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateCategoryRuleReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UpdateCategoryRuleRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a rule of the wallet, transactions it already filed keep their category and tags, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category Rules"
+                ],
+                "summary": "Delete a category rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category Rule ID",
+                        "name": "rule_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.DeleteCategoryRuleRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/schedules": {
+            "get": {
+                "description": "Retrieves the standing orders of a wallet with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Orders"
+                ],
+                "summary": "Get all standing orders of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllStandingOrderRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Schedules a recurring transfer out of the wallet, runs failing for lack of balance are retried, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Orders"
+                ],
+                "summary": "Create a standing order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Standing Order Request",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateStandingOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CreateStandingOrderRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/schedules/{schedule_id}": {
+            "get": {
+                "description": "Retrieves a standing order of the wallet by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Orders"
+                ],
+                "summary": "Get standing order details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standing Order ID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetStandingOrderByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops a standing order for good, its run history is kept, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Orders"
+                ],
+                "summary": "Cancel a standing order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standing Order ID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.CancelStandingOrderRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/schedules/{schedule_id}/pause": {
+            "post": {
+                "description": "Stops the runs of a standing order until it is resumed, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Orders"
+                ],
+                "summary": "Pause a standing order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standing Order ID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.PauseStandingOrderRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/schedules/{schedule_id}/resume": {
+            "post": {
+                "description": "Reactivates a paused standing order from its next scheduled run, the runs missed while paused are skipped, owners only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Orders"
+                ],
+                "summary": "Resume a standing order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standing Order ID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ResumeStandingOrderRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/schedules/{schedule_id}/runs": {
+            "get": {
+                "description": "Retrieves the execution history of a standing order, failed runs carry the error",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Standing Orders"
+                ],
+                "summary": "Get the runs of a standing order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standing Order ID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllStandingOrderRunRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/statement": {
+            "get": {
+                "description": "Streams the transactions of a wallet within a date range as CSV, OFX or QIF, CSV rows carry the running balance.\nThe format is taken from the format query parameter, then from the Accept header, and defaults to CSV",
+                "produces": [
+                    "text/csv",
+                    "application/x-ofx",
+                    "application/qif"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Export a wallet statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date for transactions in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date for transactions in YYYY-MM-DD format",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ofx",
+                            "qif"
+                        ],
+                        "type": "string",
+                        "description": "Statement format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "statement",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/statements": {
+            "get": {
+                "description": "Retrieves the monthly statements of a wallet without their lines, with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statements"
+                ],
+                "summary": "Get all statements of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllStatementRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Builds the statement of a closed month, a statement already generated for the month is returned unchanged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statements"
+                ],
+                "summary": "Generate a monthly statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Generate Statement Request",
+                        "name": "statement",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.GenerateStatementReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GenerateStatementRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/statements/{statement_id}": {
+            "get": {
+                "description": "Retrieves a statement of the wallet with its itemized transactions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statements"
+                ],
+                "summary": "Get statement details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Statement ID",
+                        "name": "statement_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetStatementByIDRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/statements/{statement_id}/regenerate": {
+            "post": {
+                "description": "Rebuilds a statement from the current transactions of its month and bumps its version, owners of the wallet only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statements"
+                ],
+                "summary": "Regenerate a statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Statement ID",
+                        "name": "statement_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.RegenerateStatementRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/status-history": {
+            "get": {
+                "description": "Retrieves who changed the status of a wallet, when and why, with optional filters, pagination, and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Get the status history of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter rules",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort rules",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GetAllWalletStatusChangeRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/suspend": {
+            "post": {
+                "description": "Blocks all movement of an active or frozen wallet, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Suspend a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Change Wallet Status Request",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ChangeWalletStatusReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChangeWalletStatusRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/tier": {
+            "put": {
+                "description": "Moves a wallet to another tier, which changes the fees it pays, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Set the tier of a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Wallet Tier Request",
+                        "name": "tier",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SetWalletTierReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.SetWalletTierRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wallets/{id}/unfreeze": {
+            "post": {
+                "description": "Makes a frozen or suspended wallet active again, admin only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallets"
+                ],
+                "summary": "Unfreeze a wallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization JWT input: Bearer \u003cToken\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Change Wallet Status Request",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ChangeWalletStatusReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChangeWalletStatusRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/payments": {
+            "post": {
+                "description": "Called by the payment provider with the outcome of a checkout, signed with the shared secret.\nRedelivered events are acknowledged without effect",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Top Ups"
+                ],
+                "summary": "Payment provider webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hex encoded HMAC-SHA256 of the body with the webhook secret",
+                        "name": "X-Webhook-Signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook Event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/externalapi.WebhookEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.DataResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.TopUpWebhookRes"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid signature",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "$ref": "#/definitions/response.DataResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entity.BillSplit": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Dinner on Friday"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "method": {
+                    "type": "string",
+                    "example": "equal"
+                },
+                "own_share": {
+                    "description": "the creator's part, never requested",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentRequest"
+                    }
+                },
+                "transaction_id": {
+                    "description": "the expense split, none for an ad hoc amount",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "where the shares are paid into",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Budget": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Campaign": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent, 500 is 5%",
+                    "type": "integer",
+                    "example": 500
+                },
+                "budget": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "cap_period": {
+                    "type": "string",
+                    "example": "month"
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "description": "purchases filed under it only",
+                    "type": "string"
+                },
+                "clearing_days": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "first_only": {
+                    "type": "boolean"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "max_reward": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "5% back on groceries"
+                },
+                "product_id": {
+                    "description": "purchases of it only",
+                    "type": "string"
+                },
+                "spent": {
+                    "description": "rewards pending or credited",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "trigger": {
+                    "type": "string",
+                    "example": "purchase"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "user_cap": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "entity.Category": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "expense"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Groceries"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.CategoryRule": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "counterparty_wallet_id": {
+                    "description": "the other wallet of a transfer",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description_contains": {
+                    "description": "case insensitive",
+                    "type": "string",
+                    "example": "coffee"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "out"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "max_amount": {
+                    "description": "inclusive, in the wallet currency, zero for no maximum",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "description": "inclusive, in the wallet currency, zero for no minimum",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Coffee"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ExchangeRate": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "quote_currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "15750.5"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.FeeRule": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "amount_from": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "amount_to": {
+                    "description": "excluded, zero for no upper bound",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent, 150 is 1.5%",
+                    "type": "integer",
+                    "example": 150
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "max_fee": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_fee": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "transfer"
+                },
+                "tier": {
+                    "description": "none for every tier",
+                    "type": "string",
+                    "example": "premium"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Hold": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "captured_amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "authorized"
+                },
+                "transaction_id": {
+                    "description": "expense booked by the capture",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.JournalEntry": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.JournalLine"
+                    }
+                },
+                "posted_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.JournalLine": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/entity.LedgerAccount"
+                },
+                "account_id": {
+                    "type": "string"
+                },
+                "credit": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "debit": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "entry_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.LedgerAccount": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "system:funding"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Funding source"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.PaymentRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Dinner on Friday"
+                },
+                "paid_from_wallet_id": {
+                    "type": "string"
+                },
+                "payer_id": {
+                    "type": "string"
+                },
+                "payer_name": {
+                    "type": "string",
+                    "example": "jane_doe"
+                },
+                "receiver_transaction_id": {
+                    "type": "string"
+                },
+                "requester_id": {
+                    "type": "string"
+                },
+                "requester_name": {
+                    "description": "usernames never change, kept to show without the user",
+                    "type": "string",
+                    "example": "john_doe"
+                },
+                "sender_transaction_id": {
+                    "type": "string"
+                },
+                "split_id": {
+                    "description": "set on the shares of a bill split",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "where the money goes",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.PaymentRequestStatusChange": {
+            "type": "object",
+            "properties": {
+                "changed_by": {
+                    "description": "nil when the request expired",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "already paid in cash"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string",
+                    "example": "paid"
+                }
+            }
+        },
+        "entity.Payout": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "example": "1234567890"
+                },
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "bank_code": {
+                    "type": "string",
+                    "example": "BCA"
+                },
+                "closed_at": {
+                    "description": "when it failed or was returned",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "destination": {
+                    "$ref": "#/definitions/entity.PayoutDestination"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "fee": {
+                    "description": "charged on top of Amount, given back with it",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "holder_name": {
+                    "type": "string",
+                    "example": "JOHN DOE"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "simulator"
+                },
+                "provider_reference": {
+                    "type": "string"
+                },
+                "restore_transaction_id": {
+                    "description": "what gave the money back",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "submitted"
+                },
+                "submitted_at": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "description": "the withdrawal",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "who withdrew",
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.PayoutDestination": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "example": "1234567890"
+                },
+                "bank_code": {
+                    "type": "string",
+                    "example": "BCA"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "holder_name": {
+                    "description": "as the bank knows it once verified",
+                    "type": "string",
+                    "example": "JOHN DOE"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Salary account"
+                },
+                "rejection_reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "verified"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "verified_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Product": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ReconciliationFinding": {
+            "type": "object",
+            "properties": {
+                "actual_balance": {
+                    "description": "Wallet.Balance",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "adjustment_id": {
+                    "description": "the transaction booked to correct the history",
+                    "type": "string"
+                },
+                "checked_at": {
+                    "description": "last time the wallet was compared",
+                    "type": "string"
+                },
+                "closed_at": {
+                    "description": "when it was corrected or resolved",
+                    "type": "string"
+                },
+                "detected_at": {
+                    "type": "string"
+                },
+                "difference": {
+                    "description": "actual minus expected",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "expected_balance": {
+                    "description": "recomputed from the transactions",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "ledger_balance": {
+                    "description": "the wallet's ledger account, the book of record",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "ledger_difference": {
+                    "description": "actual minus ledger",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "example": "open"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Reward": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "available_at": {
+                    "type": "string"
+                },
+                "campaign": {
+                    "$ref": "#/definitions/entity.Campaign"
+                },
+                "campaign_id": {
+                    "type": "string"
+                },
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "credited_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "source_transaction_id": {
+                    "description": "what was rewarded",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "transaction_id": {
+                    "description": "the credit of the reward",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.StandingOrder": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the sender or the receiver currency, like a transfer",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "attempts": {
+                    "description": "failed attempts of the current run",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "runs transfer on behalf of this user",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_run_at": {
+                    "type": "string"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "receiver": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "receiver_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.StandingOrderRun": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "run_at": {
+                    "type": "string"
+                },
+                "standing_order_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "succeeded"
+                },
+                "transaction_id": {
+                    "description": "sender side of the transfer",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Statement": {
+            "type": "object",
+            "properties": {
+                "closing_balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StatementLine"
+                    }
+                },
+                "opening_balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2026-09"
+                },
+                "period_end": {
+                    "description": "exclusive",
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "total_credits": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "total_debits": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "transaction_count": {
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "bumped on every regeneration",
+                    "type": "integer"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.StatementLine": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "negative for debits",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "statement_id": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "transaction_time": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TopUp": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "checkout_reference": {
+                    "type": "string",
+                    "example": "chk_6f1c2b0e9d8a4c3b"
+                },
+                "checkout_url": {
+                    "description": "where the user pays",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "stub"
+                },
+                "settled_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "transaction_id": {
+                    "description": "income booked once it succeeded",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "who started it",
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Transaction": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "booked in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "charged_on_id": {
+                    "description": "set on a fee, the transaction it was charged on",
+                    "type": "string"
+                },
+                "converted_amount": {
+                    "description": "amount reaching its destination",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "counterparty_wallet_id": {
+                    "description": "the other wallet of a transfer",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "out"
+                },
+                "exchange_rate": {
+                    "description": "from OriginalAmount to ConvertedAmount",
+                    "type": "string",
+                    "example": "15750.5"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "initiated_by": {
+                    "description": "the user who made the transaction, none for system bookings",
+                    "type": "string"
+                },
+                "original_amount": {
+                    "description": "amount leaving the source of the money",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "product": {
+                    "$ref": "#/definitions/entity.Product"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "product_quantity": {
+                    "type": "integer"
+                },
+                "refunded_amount": {
+                    "description": "in the currency of Amount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "refunded_quantity": {
+                    "type": "integer"
+                },
+                "reversal_of_id": {
+                    "description": "set on the compensating transaction of a reversal or refund",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "completed"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transaction_time": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.User": {
+            "type": "object",
+            "properties": {
+                "default_wallet_id": {
+                    "description": "where transfers to the username go when no wallet is named",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "password": {
+                    "description": "Example of bcrypt-hashed password",
+                    "type": "string",
+                    "example": "$2a$12$eixZaYVK1fsbw1ZfbX3OXe.PZyWJQ0Zf10hErsTQ6FVRHiA2vwLHu"
+                },
+                "role": {
+                    "description": "admins are promoted directly in the database",
+                    "type": "string",
+                    "example": "user"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
+                }
+            }
+        },
+        "entity.Wallet": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "balance": {
+                    "description": "projection of the wallet ledger account, see LedgerAccount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_transaction": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "personal"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "tier": {
+                    "description": "set by admins",
+                    "type": "string",
+                    "example": "standard"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "entity.WalletMember": {
+            "type": "object",
+            "properties": {
+                "allowance": {
+                    "description": "what a spender may spend per WalletAllowanceWindow, zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "invited_by": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "spender"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.WalletStatusChange": {
+            "type": "object",
+            "properties": {
+                "changed_by": {
+                    "description": "the user who made the change",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "chargeback investigation"
+                },
+                "to_status": {
+                    "type": "string",
+                    "example": "frozen"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "externalapi.WebhookEvent": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "why the payment failed",
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AddWalletMemberReq": {
+            "type": "object",
+            "required": [
+                "role",
+                "username"
+            ],
+            "properties": {
+                "allowance": {
+                    "description": "what a spender may spend over 30 days, in the wallet currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "spender",
+                        "viewer"
+                    ],
+                    "example": "spender"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "jane_doe"
+                }
+            }
+        },
+        "model.AddWalletMemberRes": {
+            "type": "object",
+            "properties": {
+                "allowance": {
+                    "description": "what a spender may spend per WalletAllowanceWindow, zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "invited_by": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "spender"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ApplyCategoryRulesRes": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "description": "transactions the rules ran over",
+                    "type": "integer"
+                },
+                "updated": {
+                    "description": "transactions filed under another category or tagged",
+                    "type": "integer"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AuthorizeHoldReq": {
+            "type": "object",
+            "required": [
+                "amount",
+                "wallet_id"
+            ],
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Order #1001"
+                },
+                "expires_at": {
+                    "description": "defaults to the configured hold lifetime",
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AuthorizeHoldRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "captured_amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "authorized"
+                },
+                "transaction_id": {
+                    "description": "expense booked by the capture",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.BudgetStatus": {
+            "type": "object",
+            "properties": {
+                "budget": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "budget_id": {
+                    "type": "string"
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "exceeded": {
+                    "type": "boolean"
+                },
+                "remaining": {
+                    "description": "zero once the budget is exceeded",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "spent": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "used_percent": {
+                    "description": "rounded down, above 100 when exceeded",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CancelPaymentRequestReq": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "asked the wrong person"
+                }
+            }
+        },
+        "model.CancelPaymentRequestRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Dinner on Friday"
+                },
+                "paid_from_wallet_id": {
+                    "type": "string"
+                },
+                "payer_id": {
+                    "type": "string"
+                },
+                "payer_name": {
+                    "type": "string",
+                    "example": "jane_doe"
+                },
+                "receiver_transaction_id": {
+                    "type": "string"
+                },
+                "requester_id": {
+                    "type": "string"
+                },
+                "requester_name": {
+                    "description": "usernames never change, kept to show without the user",
+                    "type": "string",
+                    "example": "john_doe"
+                },
+                "sender_transaction_id": {
+                    "type": "string"
+                },
+                "split_id": {
+                    "description": "set on the shares of a bill split",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "where the money goes",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CancelStandingOrderRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the sender or the receiver currency, like a transfer",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "attempts": {
+                    "description": "failed attempts of the current run",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "runs transfer on behalf of this user",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_run_at": {
+                    "type": "string"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "receiver": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "receiver_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CaptureHoldReq": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                }
+            }
+        },
+        "model.CaptureHoldRes": {
+            "type": "object",
+            "properties": {
+                "fee_transaction": {
+                    "description": "the fee charged on top, if any",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    ]
+                },
+                "hold": {
+                    "$ref": "#/definitions/entity.Hold"
+                },
+                "rewards": {
+                    "description": "granted by the campaigns it qualified for",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Reward"
+                    }
+                },
+                "transaction": {
+                    "$ref": "#/definitions/entity.Transaction"
+                }
+            }
+        },
+        "model.CashFlowPeriod": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "expense": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "income": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "net": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "period": {
+                    "description": "first day of the period",
+                    "type": "string",
+                    "example": "2026-10-01"
+                },
+                "transfer_in": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "transfer_out": {
+                    "$ref": "#/definitions/money.Money"
+                }
+            }
+        },
+        "model.CategorizeTransactionReq": {
+            "type": "object",
+            "required": [
+                "category_id"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CategorizeTransactionRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "booked in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "charged_on_id": {
+                    "description": "set on a fee, the transaction it was charged on",
+                    "type": "string"
+                },
+                "converted_amount": {
+                    "description": "amount reaching its destination",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "counterparty_wallet_id": {
+                    "description": "the other wallet of a transfer",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "out"
+                },
+                "exchange_rate": {
+                    "description": "from OriginalAmount to ConvertedAmount",
+                    "type": "string",
+                    "example": "15750.5"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "initiated_by": {
+                    "description": "the user who made the transaction, none for system bookings",
+                    "type": "string"
+                },
+                "original_amount": {
+                    "description": "amount leaving the source of the money",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "product": {
+                    "$ref": "#/definitions/entity.Product"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "product_quantity": {
+                    "type": "integer"
+                },
+                "refunded_amount": {
+                    "description": "in the currency of Amount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "refunded_quantity": {
+                    "type": "integer"
+                },
+                "reversal_of_id": {
+                    "description": "set on the compensating transaction of a reversal or refund",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "completed"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transaction_time": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CategorySpending": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Groceries"
+                }
+            }
+        },
+        "model.ChangeWalletStatusReq": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "chargeback investigation"
+                }
+            }
+        },
+        "model.ChangeWalletStatusRes": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "balance": {
+                    "description": "projection of the wallet ledger account, see LedgerAccount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_transaction": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "personal"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "tier": {
+                    "description": "set by admins",
+                    "type": "string",
+                    "example": "standard"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "model.CloseWalletRes": {
+            "type": "object",
+            "properties": {
+                "sweep": {
+                    "description": "the transfer of the remaining balance",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.TransferTransactionRes"
+                        }
+                    ]
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                }
+            }
+        },
+        "model.CorrectReconciliationFindingRes": {
+            "type": "object",
+            "properties": {
+                "adjustment": {
+                    "description": "absent when the history already matched the ledger",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    ]
+                },
+                "finding": {
+                    "$ref": "#/definitions/entity.ReconciliationFinding"
+                }
+            }
+        },
+        "model.CreateBillSplitReq": {
+            "type": "object",
+            "required": [
+                "method",
+                "participants"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "of an ad hoc split, exact splits default to the sum of their shares",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Dinner on Friday"
+                },
+                "expires_at": {
+                    "description": "of the payment requests, defaults to their configured lifetime",
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string",
+                    "enum": [
+                        "equal",
+                        "exact",
+                        "percent"
+                    ],
+                    "example": "equal"
+                },
+                "participants": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/model.SplitParticipantReq"
+                    }
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "defaults to the wallet of the transaction",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateBillSplitRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Dinner on Friday"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "method": {
+                    "type": "string",
+                    "example": "equal"
+                },
+                "outstanding": {
+                    "description": "pending shares",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "own_share": {
+                    "description": "the creator's part, never requested",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentRequest"
+                    }
+                },
+                "settled": {
+                    "description": "own share and paid shares",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "open, settled or closed",
+                    "type": "string",
+                    "example": "open"
+                },
+                "transaction_id": {
+                    "description": "the expense split, none for an ad hoc amount",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "where the shares are paid into",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateBudgetReq": {
+            "type": "object",
+            "required": [
+                "amount",
+                "category_id"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "spent over a calendar month, in the wallet currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "category_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateBudgetRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateCampaignReq": {
+            "type": "object",
+            "required": [
+                "budget",
+                "currency",
+                "ends_at",
+                "name",
+                "starts_at",
+                "trigger"
+            ],
+            "properties": {
+                "active": {
+                    "description": "defaults to true",
+                    "type": "boolean"
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent",
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 500
+                },
+                "budget": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "cap_period": {
+                    "type": "string",
+                    "enum": [
+                        "month",
+                        "campaign"
+                    ],
+                    "example": "month"
+                },
+                "category_id": {
+                    "description": "a system category, purchases only",
+                    "type": "string"
+                },
+                "clearing_days": {
+                    "description": "zero credits rewards right away",
+                    "type": "integer",
+                    "maximum": 365,
+                    "example": 14
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "first_only": {
+                    "description": "reward a user's first transaction of the trigger only",
+                    "type": "boolean"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "max_reward": {
+                    "description": "per transaction, no cap when left out",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "5% back on groceries"
+                },
+                "product_id": {
+                    "description": "purchases only",
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "trigger": {
+                    "type": "string",
+                    "enum": [
+                        "purchase",
+                        "top_up"
+                    ],
+                    "example": "purchase"
+                },
+                "user_cap": {
+                    "description": "per user and cap period, no cap when left out",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.CreateCampaignRes": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent, 500 is 5%",
+                    "type": "integer",
+                    "example": 500
+                },
+                "budget": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "cap_period": {
+                    "type": "string",
+                    "example": "month"
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "description": "purchases filed under it only",
+                    "type": "string"
+                },
+                "clearing_days": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "first_only": {
+                    "type": "boolean"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "max_reward": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "5% back on groceries"
+                },
+                "product_id": {
+                    "description": "purchases of it only",
+                    "type": "string"
+                },
+                "spent": {
+                    "description": "rewards pending or credited",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "trigger": {
+                    "type": "string",
+                    "example": "purchase"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "user_cap": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.CreateCategoryReq": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "kind": {
+                    "description": "defaults to expense",
+                    "type": "string",
+                    "enum": [
+                        "expense",
+                        "income"
+                    ],
+                    "example": "expense"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "Groceries"
+                }
+            }
+        },
+        "model.CreateCategoryRes": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "expense"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Groceries"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateCategoryRuleReq": {
+            "type": "object",
+            "required": [
+                "name",
+                "tags"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "counterparty_wallet_id": {
+                    "type": "string"
+                },
+                "description_contains": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "example": "coffee"
+                },
+                "direction": {
+                    "type": "string",
+                    "enum": [
+                        "in",
+                        "out"
+                    ],
+                    "example": "out"
+                },
+                "max_amount": {
+                    "description": "in the wallet currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "description": "in the wallet currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "Coffee"
+                },
+                "priority": {
+                    "description": "lowest runs first",
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.CreateCategoryRuleRes": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "counterparty_wallet_id": {
+                    "description": "the other wallet of a transfer",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description_contains": {
+                    "description": "case insensitive",
+                    "type": "string",
+                    "example": "coffee"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "out"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "max_amount": {
+                    "description": "inclusive, in the wallet currency, zero for no maximum",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "description": "inclusive, in the wallet currency, zero for no minimum",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Coffee"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateExchangeRateReq": {
+            "type": "object",
+            "required": [
+                "base_currency",
+                "quote_currency",
+                "rate"
+            ],
+            "properties": {
+                "base_currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "quote_currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "15750.5"
+                }
+            }
+        },
+        "model.CreateExchangeRateRes": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "quote_currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "15750.5"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateFeeRuleReq": {
+            "type": "object",
+            "required": [
+                "currency",
+                "operation"
+            ],
+            "properties": {
+                "active": {
+                    "description": "defaults to true",
+                    "type": "boolean"
+                },
+                "amount_from": {
+                    "description": "defaults to zero",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "amount_to": {
+                    "description": "excluded, no upper bound when left out",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent",
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 150
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "max_fee": {
+                    "description": "no cap when left out",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_fee": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "transfer",
+                        "purchase",
+                        "withdrawal"
+                    ],
+                    "example": "transfer"
+                },
+                "tier": {
+                    "type": "string",
+                    "enum": [
+                        "standard",
+                        "premium",
+                        "business"
+                    ],
+                    "example": "premium"
+                }
+            }
+        },
+        "model.CreateFeeRuleRes": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "amount_from": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "amount_to": {
+                    "description": "excluded, zero for no upper bound",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent, 150 is 1.5%",
+                    "type": "integer",
+                    "example": 150
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "max_fee": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_fee": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "transfer"
+                },
+                "tier": {
+                    "description": "none for every tier",
+                    "type": "string",
+                    "example": "premium"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreatePaymentRequestReq": {
+            "type": "object",
+            "required": [
+                "amount",
+                "payer_username",
+                "wallet_id"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "in the currency of the wallet",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "expires_at": {
+                    "description": "defaults to the configured request lifetime",
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Dinner on Friday"
+                },
+                "payer_username": {
+                    "type": "string",
+                    "example": "jane_doe"
+                },
+                "wallet_id": {
+                    "description": "where the money goes, requires the spender role on it",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreatePaymentRequestRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Dinner on Friday"
+                },
+                "paid_from_wallet_id": {
+                    "type": "string"
+                },
+                "payer_id": {
+                    "type": "string"
+                },
+                "payer_name": {
+                    "type": "string",
+                    "example": "jane_doe"
+                },
+                "receiver_transaction_id": {
+                    "type": "string"
+                },
+                "requester_id": {
+                    "type": "string"
+                },
+                "requester_name": {
+                    "description": "usernames never change, kept to show without the user",
+                    "type": "string",
+                    "example": "john_doe"
+                },
+                "sender_transaction_id": {
+                    "type": "string"
+                },
+                "split_id": {
+                    "description": "set on the shares of a bill split",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "where the money goes",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreatePayoutDestinationReq": {
+            "type": "object",
+            "required": [
+                "account_number",
+                "bank_code",
+                "holder_name"
+            ],
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "maxLength": 34,
+                    "example": "1234567890"
+                },
+                "bank_code": {
+                    "type": "string",
+                    "maxLength": 16,
+                    "example": "BCA"
+                },
+                "holder_name": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "example": "John Doe"
+                },
+                "label": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "Salary account"
+                }
+            }
+        },
+        "model.CreatePayoutDestinationRes": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "example": "1234567890"
+                },
+                "bank_code": {
+                    "type": "string",
+                    "example": "BCA"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "holder_name": {
+                    "description": "as the bank knows it once verified",
+                    "type": "string",
+                    "example": "JOHN DOE"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Salary account"
+                },
+                "rejection_reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "verified"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "verified_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreatePayoutReq": {
+            "type": "object",
+            "required": [
+                "amount",
+                "destination_id",
+                "wallet_id"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "in the currency of the wallet",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "destination_id": {
+                    "description": "a verified destination of yours",
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "requires the spender role on it",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreatePayoutRes": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "example": "1234567890"
+                },
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "bank_code": {
+                    "type": "string",
+                    "example": "BCA"
+                },
+                "closed_at": {
+                    "description": "when it failed or was returned",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "destination": {
+                    "$ref": "#/definitions/entity.PayoutDestination"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "fee": {
+                    "description": "charged on top of Amount, given back with it",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "holder_name": {
+                    "type": "string",
+                    "example": "JOHN DOE"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "simulator"
+                },
+                "provider_reference": {
+                    "type": "string"
+                },
+                "restore_transaction_id": {
+                    "description": "what gave the money back",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "submitted"
+                },
+                "submitted_at": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "description": "the withdrawal",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "who withdrew",
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateProductReq": {
+            "type": "object",
+            "required": [
+                "name",
+                "price",
+                "quantity"
+            ],
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CreateProductRes": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateStandingOrderReq": {
+            "type": "object",
+            "required": [
+                "amount",
+                "receiver_id",
+                "schedule"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "in the sender or the receiver currency, the other side is converted on each run",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Rent"
+                },
+                "end_at": {
+                    "description": "no run is made after it",
+                    "type": "string"
+                },
+                "receiver_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "description": "daily, weekly, monthly or a cron expression like \"0 9 1 * *\"",
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "start_at": {
+                    "description": "defaults to now, daily, weekly and monthly repeat from it",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateStandingOrderRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the sender or the receiver currency, like a transfer",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "attempts": {
+                    "description": "failed attempts of the current run",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "runs transfer on behalf of this user",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_run_at": {
+                    "type": "string"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "receiver": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "receiver_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateTopUpReq": {
+            "type": "object",
+            "required": [
+                "amount",
+                "wallet_id"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "in the currency of the wallet",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "wallet_id": {
+                    "description": "requires the spender role on it",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateTopUpRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "checkout_reference": {
+                    "type": "string",
+                    "example": "chk_6f1c2b0e9d8a4c3b"
+                },
+                "checkout_url": {
+                    "description": "where the user pays",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "stub"
+                },
+                "settled_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "transaction_id": {
+                    "description": "income booked once it succeeded",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "who started it",
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateTransactionReq": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "product_quantity",
+                "wallet_id"
+            ],
+            "properties": {
+                "category_id": {
+                    "description": "left to the wallet rules, then Shopping",
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "product_quantity": {
+                    "type": "integer"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateTransactionRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "booked in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "charged_on_id": {
+                    "description": "set on a fee, the transaction it was charged on",
+                    "type": "string"
+                },
+                "converted_amount": {
+                    "description": "amount reaching its destination",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "counterparty_wallet_id": {
+                    "description": "the other wallet of a transfer",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "out"
+                },
+                "exchange_rate": {
+                    "description": "from OriginalAmount to ConvertedAmount",
+                    "type": "string",
+                    "example": "15750.5"
+                },
+                "fee_transaction": {
+                    "description": "the fee charged on top, if any",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "initiated_by": {
+                    "description": "the user who made the transaction, none for system bookings",
+                    "type": "string"
+                },
+                "original_amount": {
+                    "description": "amount leaving the source of the money",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "product": {
+                    "$ref": "#/definitions/entity.Product"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "product_quantity": {
+                    "type": "integer"
+                },
+                "refunded_amount": {
+                    "description": "in the currency of Amount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "refunded_quantity": {
+                    "type": "integer"
+                },
+                "reversal_of_id": {
+                    "description": "set on the compensating transaction of a reversal or refund",
+                    "type": "string"
+                },
+                "rewards": {
+                    "description": "granted by the campaigns it qualified for",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Reward"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "completed"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transaction_time": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateUserReq": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "SecurePass123!"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
+                }
+            }
+        },
+        "model.CreateUserRes": {
+            "type": "object",
+            "properties": {
+                "default_wallet_id": {
+                    "description": "where transfers to the username go when no wallet is named",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "password": {
+                    "description": "Example of bcrypt-hashed password",
+                    "type": "string",
+                    "example": "$2a$12$eixZaYVK1fsbw1ZfbX3OXe.PZyWJQ0Zf10hErsTQ6FVRHiA2vwLHu"
+                },
+                "role": {
+                    "description": "admins are promoted directly in the database",
+                    "type": "string",
+                    "example": "user"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
+                }
+            }
+        },
+        "model.CreateWalletReq": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "fixed at creation, defaults to the configured currency",
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "personal"
+                }
+            }
+        },
+        "model.CreateWalletRes": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "balance": {
+                    "description": "projection of the wallet ledger account, see LedgerAccount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_transaction": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "personal"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "tier": {
+                    "description": "set by admins",
+                    "type": "string",
+                    "example": "standard"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "model.CreditTransactionReq": {
+            "type": "object",
+            "required": [
+                "amount",
+                "wallet_id"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "converted into the wallet currency when it differs",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "category_id": {
+                    "description": "left to the wallet rules, then Top Up",
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreditTransactionRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "booked in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "charged_on_id": {
+                    "description": "set on a fee, the transaction it was charged on",
+                    "type": "string"
+                },
+                "converted_amount": {
+                    "description": "amount reaching its destination",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "counterparty_wallet_id": {
+                    "description": "the other wallet of a transfer",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "out"
+                },
+                "exchange_rate": {
+                    "description": "from OriginalAmount to ConvertedAmount",
+                    "type": "string",
+                    "example": "15750.5"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "initiated_by": {
+                    "description": "the user who made the transaction, none for system bookings",
+                    "type": "string"
+                },
+                "original_amount": {
+                    "description": "amount leaving the source of the money",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "product": {
+                    "$ref": "#/definitions/entity.Product"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "product_quantity": {
+                    "type": "integer"
+                },
+                "refunded_amount": {
+                    "description": "in the currency of Amount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "refunded_quantity": {
+                    "type": "integer"
+                },
+                "reversal_of_id": {
+                    "description": "set on the compensating transaction of a reversal or refund",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "completed"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transaction_time": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeclinePaymentRequestReq": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "already paid in cash"
+                }
+            }
+        },
+        "model.DeclinePaymentRequestRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Dinner on Friday"
+                },
+                "paid_from_wallet_id": {
+                    "type": "string"
+                },
+                "payer_id": {
+                    "type": "string"
+                },
+                "payer_name": {
+                    "type": "string",
+                    "example": "jane_doe"
+                },
+                "receiver_transaction_id": {
+                    "type": "string"
+                },
+                "requester_id": {
+                    "type": "string"
+                },
+                "requester_name": {
+                    "description": "usernames never change, kept to show without the user",
+                    "type": "string",
+                    "example": "john_doe"
+                },
+                "sender_transaction_id": {
+                    "type": "string"
+                },
+                "split_id": {
+                    "description": "set on the shares of a bill split",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "where the money goes",
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeleteBudgetRes": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeleteCategoryRes": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeleteCategoryRuleRes": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeleteExchangeRateRes": {
+            "type": "object"
+        },
+        "model.DeleteFeeRuleRes": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeletePayoutDestinationRes": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeleteProductRes": {
+            "type": "object"
+        },
+        "model.GenerateStatementReq": {
+            "type": "object",
+            "required": [
+                "period"
+            ],
+            "properties": {
+                "period": {
+                    "description": "a closed calendar month, YYYY-MM in UTC",
+                    "type": "string",
+                    "example": "2026-09"
+                }
+            }
+        },
+        "model.GenerateStatementRes": {
+            "type": "object",
+            "properties": {
+                "closing_balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StatementLine"
+                    }
+                },
+                "opening_balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2026-09"
+                },
+                "period_end": {
+                    "description": "exclusive",
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "total_credits": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "total_debits": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "transaction_count": {
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "bumped on every regeneration",
+                    "type": "integer"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetAllBillSplitRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.BillSplit"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllBudgetRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Budget"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllCampaignRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Campaign"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllCategoryRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Category"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllCategoryRuleRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CategoryRule"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllExchangeRateRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ExchangeRate"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllFeeRuleRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.FeeRule"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllHoldRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Hold"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllJournalEntryRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.JournalEntry"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllLedgerAccountRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.LedgerAccount"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllPaymentRequestRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentRequest"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllPaymentRequestStatusChangeRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentRequestStatusChange"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllPayoutDestinationRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PayoutDestination"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllPayoutRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Payout"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllProductRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Product"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllReconciliationFindingRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ReconciliationFinding"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllRewardRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Reward"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllStandingOrderRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StandingOrder"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllStandingOrderRunRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StandingOrderRun"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllStatementRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Statement"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllTopUpRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TopUp"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllTransactionRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Transaction"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllWalletMemberRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.WalletMember"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllWalletRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Wallet"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetAllWalletStatusChangeRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "The actual data",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.WalletStatusChange"
+                    }
+                },
+                "limit": {
+                    "description": "The size of the page",
+                    "type": "integer"
+                },
+                "page": {
+                    "description": "The current page",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "The total number of pages",
+                    "type": "integer"
+                },
+                "total_row_per_page": {
+                    "description": "The total number of data per page",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "The total number of data",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.GetBillSplitByIDRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Dinner on Friday"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "method": {
+                    "type": "string",
+                    "example": "equal"
+                },
+                "outstanding": {
+                    "description": "pending shares",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "own_share": {
+                    "description": "the creator's part, never requested",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentRequest"
+                    }
+                },
+                "settled": {
+                    "description": "own share and paid shares",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "open, settled or closed",
+                    "type": "string",
+                    "example": "open"
+                },
+                "transaction_id": {
+                    "description": "the expense split, none for an ad hoc amount",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "where the shares are paid into",
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetBudgetStatusRes": {
+            "type": "object",
+            "properties": {
+                "budgets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.BudgetStatus"
+                    }
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2026-10"
+                },
+                "period_end": {
+                    "description": "exclusive",
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "total_budget": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "total_spent": {
+                    "description": "spent in the budgeted categories",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "unbudgeted": {
+                    "description": "spent in every other category, or in none",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetCampaignByIDRes": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent, 500 is 5%",
+                    "type": "integer",
+                    "example": 500
+                },
+                "budget": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "cap_period": {
+                    "type": "string",
+                    "example": "month"
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "description": "purchases filed under it only",
+                    "type": "string"
+                },
+                "clearing_days": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "first_only": {
+                    "type": "boolean"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "max_reward": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "5% back on groceries"
+                },
+                "product_id": {
+                    "description": "purchases of it only",
+                    "type": "string"
+                },
+                "spent": {
+                    "description": "rewards pending or credited",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "trigger": {
+                    "type": "string",
+                    "example": "purchase"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "user_cap": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.GetCashFlowRes": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "month"
+                },
+                "periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CashFlowPeriod"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetCategoryByIDRes": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "expense"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Groceries"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetCategorySpendingRes": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CategorySpending"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetExchangeRateByIDRes": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "quote_currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "15750.5"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetFeeRuleByIDRes": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "amount_from": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "amount_to": {
+                    "description": "excluded, zero for no upper bound",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent, 150 is 1.5%",
+                    "type": "integer",
+                    "example": 150
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "max_fee": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_fee": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "transfer"
+                },
+                "tier": {
+                    "description": "none for every tier",
+                    "type": "string",
+                    "example": "premium"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetHoldByIDRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "captured_amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "authorized"
+                },
+                "transaction_id": {
+                    "description": "expense booked by the capture",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetJournalEntryByIDRes": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.JournalLine"
+                    }
+                },
+                "posted_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetPaymentRequestByIDRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Dinner on Friday"
+                },
+                "paid_from_wallet_id": {
+                    "type": "string"
+                },
+                "payer_id": {
+                    "type": "string"
+                },
+                "payer_name": {
+                    "type": "string",
+                    "example": "jane_doe"
+                },
+                "receiver_transaction_id": {
+                    "type": "string"
+                },
+                "requester_id": {
+                    "type": "string"
+                },
+                "requester_name": {
+                    "description": "usernames never change, kept to show without the user",
+                    "type": "string",
+                    "example": "john_doe"
+                },
+                "sender_transaction_id": {
+                    "type": "string"
+                },
+                "split_id": {
+                    "description": "set on the shares of a bill split",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "description": "where the money goes",
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetPayoutByIDRes": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "example": "1234567890"
+                },
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "bank_code": {
+                    "type": "string",
+                    "example": "BCA"
+                },
+                "closed_at": {
+                    "description": "when it failed or was returned",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "destination": {
+                    "$ref": "#/definitions/entity.PayoutDestination"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "fee": {
+                    "description": "charged on top of Amount, given back with it",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "holder_name": {
+                    "type": "string",
+                    "example": "JOHN DOE"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "simulator"
+                },
+                "provider_reference": {
+                    "type": "string"
+                },
+                "restore_transaction_id": {
+                    "description": "what gave the money back",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "submitted"
+                },
+                "submitted_at": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "description": "the withdrawal",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "who withdrew",
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetPayoutDestinationByIDRes": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "example": "1234567890"
+                },
+                "bank_code": {
+                    "type": "string",
+                    "example": "BCA"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "holder_name": {
+                    "description": "as the bank knows it once verified",
+                    "type": "string",
+                    "example": "JOHN DOE"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Salary account"
+                },
+                "rejection_reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "verified"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "verified_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetProductByIDRes": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetReconciliationFindingByIDRes": {
+            "type": "object",
+            "properties": {
+                "actual_balance": {
+                    "description": "Wallet.Balance",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "adjustment_id": {
+                    "description": "the transaction booked to correct the history",
+                    "type": "string"
+                },
+                "checked_at": {
+                    "description": "last time the wallet was compared",
+                    "type": "string"
+                },
+                "closed_at": {
+                    "description": "when it was corrected or resolved",
+                    "type": "string"
+                },
+                "detected_at": {
+                    "type": "string"
+                },
+                "difference": {
+                    "description": "actual minus expected",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "expected_balance": {
+                    "description": "recomputed from the transactions",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "ledger_balance": {
+                    "description": "the wallet's ledger account, the book of record",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "ledger_difference": {
+                    "description": "actual minus ledger",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "example": "open"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetRewardByIDRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "available_at": {
+                    "type": "string"
+                },
+                "campaign": {
+                    "$ref": "#/definitions/entity.Campaign"
+                },
+                "campaign_id": {
+                    "type": "string"
+                },
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "credited_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "source_transaction_id": {
+                    "description": "what was rewarded",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "transaction_id": {
+                    "description": "the credit of the reward",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetSpendingLimitRes": {
+            "type": "object",
+            "properties": {
+                "limits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SpendingAllowance"
+                    }
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetStandingOrderByIDRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the sender or the receiver currency, like a transfer",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "attempts": {
+                    "description": "failed attempts of the current run",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "runs transfer on behalf of this user",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_run_at": {
+                    "type": "string"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "receiver": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "receiver_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetStatementByIDRes": {
+            "type": "object",
+            "properties": {
+                "closing_balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StatementLine"
+                    }
+                },
+                "opening_balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2026-09"
+                },
+                "period_end": {
+                    "description": "exclusive",
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "total_credits": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "total_debits": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "transaction_count": {
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "bumped on every regeneration",
+                    "type": "integer"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetTopProductsRes": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ProductSales"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetTopUpByIDRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "checkout_reference": {
+                    "type": "string",
+                    "example": "chk_6f1c2b0e9d8a4c3b"
+                },
+                "checkout_url": {
+                    "description": "where the user pays",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "stub"
+                },
+                "settled_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "transaction_id": {
+                    "description": "income booked once it succeeded",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "who started it",
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetTransactionByIDRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "booked in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "charged_on_id": {
+                    "description": "set on a fee, the transaction it was charged on",
+                    "type": "string"
+                },
+                "converted_amount": {
+                    "description": "amount reaching its destination",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "counterparty_wallet_id": {
+                    "description": "the other wallet of a transfer",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "out"
+                },
+                "exchange_rate": {
+                    "description": "from OriginalAmount to ConvertedAmount",
+                    "type": "string",
+                    "example": "15750.5"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "initiated_by": {
+                    "description": "the user who made the transaction, none for system bookings",
+                    "type": "string"
+                },
+                "original_amount": {
+                    "description": "amount leaving the source of the money",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "product": {
+                    "$ref": "#/definitions/entity.Product"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "product_quantity": {
+                    "type": "integer"
+                },
+                "refunded_amount": {
+                    "description": "in the currency of Amount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "refunded_quantity": {
+                    "type": "integer"
+                },
+                "reversal_of_id": {
+                    "description": "set on the compensating transaction of a reversal or refund",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "completed"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transaction_time": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GetWalletByIDRes": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "available_balance": {
+                    "description": "ledger balance minus active holds",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "balance": {
+                    "description": "projection of the wallet ledger account, see LedgerAccount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_transaction": {
+                    "type": "string"
+                },
+                "ledger_balance": {
+                    "description": "booked on the ledger",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "personal"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "tier": {
+                    "description": "set by admins",
+                    "type": "string",
+                    "example": "standard"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "model.GetWalletByTransactionRes": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "balance": {
+                    "description": "projection of the wallet ledger account, see LedgerAccount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_transaction": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "personal"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "tier": {
+                    "description": "set by admins",
+                    "type": "string",
+                    "example": "standard"
+                },
+                "transaction": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Transaction"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "model.GetWalletCashFlowRes": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "wallets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WalletCashFlow"
+                    }
+                }
+            }
+        },
+        "model.LoginUserRes": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "description": "JWT token example",
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
+                }
+            }
+        },
+        "model.PauseStandingOrderRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the sender or the receiver currency, like a transfer",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "attempts": {
+                    "description": "failed attempts of the current run",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "runs transfer on behalf of this user",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_run_at": {
+                    "type": "string"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "receiver": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "receiver_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.PayPaymentRequestReq": {
+            "type": "object",
+            "required": [
+                "wallet_id"
+            ],
+            "properties": {
+                "category_id": {
+                    "description": "of the payer's side, like a transfer",
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.PayPaymentRequestRes": {
+            "type": "object",
+            "properties": {
+                "request": {
+                    "$ref": "#/definitions/entity.PaymentRequest"
+                },
+                "transfer": {
+                    "$ref": "#/definitions/model.TransferTransactionRes"
+                }
+            }
+        },
+        "model.PreviewTransferRes": {
+            "type": "object",
+            "properties": {
+                "credit": {
+                    "description": "what would reach the receiver wallet",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "debit": {
+                    "description": "what would leave the sender wallet",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "exchange_rate": {
+                    "type": "string",
+                    "example": "15750.5"
+                },
+                "fee": {
+                    "description": "charged to the sender on top of Debit",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "receiver_id": {
+                    "type": "string"
+                },
+                "receiver_name": {
+                    "description": "masked username of the user the wallet belongs to",
+                    "type": "string",
+                    "example": "j******e"
+                },
+                "receiver_wallet_name": {
+                    "type": "string",
+                    "example": "savings"
+                },
+                "total": {
+                    "description": "Debit and Fee together",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.ProductSales": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "purchases": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "model.QuoteFeeReq": {
+            "type": "object",
+            "required": [
+                "amount",
+                "operation",
+                "wallet_id"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "in the currency of the wallet",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "transfer",
+                        "purchase",
+                        "withdrawal"
+                    ],
+                    "example": "transfer"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.QuoteFeeRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "what the operation moves, in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "fee": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "transfer"
+                },
+                "rule_id": {
+                    "type": "string"
+                },
+                "total": {
+                    "description": "what would leave the wallet",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.RefundTransactionReq": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "model.RefundTransactionRes": {
+            "type": "object",
+            "properties": {
+                "compensations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Transaction"
+                    }
+                },
+                "original": {
+                    "$ref": "#/definitions/entity.Transaction"
+                }
+            }
+        },
+        "model.RegenerateStatementRes": {
+            "type": "object",
+            "properties": {
+                "closing_balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StatementLine"
+                    }
+                },
+                "opening_balance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2026-09"
+                },
+                "period_end": {
+                    "description": "exclusive",
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "total_credits": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "total_debits": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "transaction_count": {
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "bumped on every regeneration",
+                    "type": "integer"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RemoveWalletMemberRes": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ResumeStandingOrderRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the sender or the receiver currency, like a transfer",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "attempts": {
+                    "description": "failed attempts of the current run",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "runs transfer on behalf of this user",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_run_at": {
+                    "type": "string"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "receiver": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "receiver_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ReverseTransactionRes": {
+            "type": "object",
+            "properties": {
+                "compensations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Transaction"
+                    }
+                },
+                "original": {
+                    "$ref": "#/definitions/entity.Transaction"
+                }
+            }
+        },
+        "model.RunReconciliationReq": {
+            "type": "object",
+            "properties": {
+                "auto_correct": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.RunReconciliationRes": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "description": "wallets compared",
+                    "type": "integer"
+                },
+                "corrected": {
+                    "description": "mismatches corrected",
+                    "type": "integer"
+                },
+                "mismatched": {
+                    "description": "wallets whose balance differs from their transactions or their ledger account",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.SetDefaultWalletRes": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "balance": {
+                    "description": "projection of the wallet ledger account, see LedgerAccount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_transaction": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "personal"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "tier": {
+                    "description": "set by admins",
+                    "type": "string",
+                    "example": "standard"
                 },
-                "description": {
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "model.SetWalletTierReq": {
+            "type": "object",
+            "required": [
+                "tier"
+            ],
+            "properties": {
+                "tier": {
+                    "type": "string",
+                    "enum": [
+                        "standard",
+                        "premium",
+                        "business"
+                    ],
+                    "example": "premium"
+                }
+            }
+        },
+        "model.SetWalletTierRes": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "balance": {
+                    "description": "projection of the wallet ledger account, see LedgerAccount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "closed_at": {
                     "type": "string"
                 },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
                 "id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
+                "last_transaction": {
+                    "type": "string"
+                },
                 "name": {
+                    "type": "string",
+                    "example": "personal"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "tier": {
+                    "description": "set by admins",
+                    "type": "string",
+                    "example": "standard"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "model.SpendingAllowance": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "description": "the limit is the configured default, not set on the wallet",
+                    "type": "boolean"
+                },
+                "limit": {
+                    "description": "null when the wallet has no such limit",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "period": {
+                    "type": "string",
+                    "example": "daily"
+                },
+                "remaining": {
+                    "description": "null when the wallet has no such limit",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "used": {
+                    "description": "outflows over the rolling window, zero for the per transaction cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.SplitParticipantReq": {
+            "type": "object",
+            "required": [
+                "username"
+            ],
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "percent": {
+                    "description": "up to two decimals",
+                    "type": "string",
+                    "example": "33.34"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "jane_doe"
+                }
+            }
+        },
+        "model.TopUpWebhookRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "checkout_reference": {
+                    "type": "string",
+                    "example": "chk_6f1c2b0e9d8a4c3b"
+                },
+                "checkout_url": {
+                    "description": "where the user pays",
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "created_at": {
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "integer"
+                "expires_at": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "stub"
+                },
+                "settled_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "transaction_id": {
+                    "description": "income booked once it succeeded",
+                    "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "user_id": {
+                    "description": "who started it",
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
                 }
             }
         },
-        "entity.Transaction": {
+        "model.TransferTransactionReq": {
             "type": "object",
+            "required": [
+                "amount",
+                "wallet_id"
+            ],
             "properties": {
                 "amount": {
-                    "type": "number"
+                    "description": "in the sender or the receiver currency, the other side is converted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
                 },
-                "description": {
+                "category_id": {
+                    "description": "of the sender side, left to the wallet rules, then Transfer",
+                    "type": "string"
+                },
+                "receiver_id": {
+                    "type": "string"
+                },
+                "receiver_username": {
+                    "type": "string",
+                    "example": "jane_doe"
+                },
+                "receiver_wallet": {
+                    "type": "string",
+                    "example": "savings"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.TransferTransactionRes": {
+            "type": "object",
+            "properties": {
+                "fee_transaction": {
+                    "description": "the fee charged to the sender, if any",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    ]
+                },
+                "receiver_transaction": {
+                    "$ref": "#/definitions/entity.Transaction"
+                },
+                "sender_transaction": {
+                    "$ref": "#/definitions/entity.Transaction"
+                }
+            }
+        },
+        "model.UpdateBudgetReq": {
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                }
+            }
+        },
+        "model.UpdateBudgetRes": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "product": {
-                    "$ref": "#/definitions/entity.Product"
+                "updated_at": {
+                    "type": "string"
+                },
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.UpdateCampaignReq": {
+            "type": "object",
+            "required": [
+                "budget",
+                "currency",
+                "ends_at",
+                "name",
+                "starts_at",
+                "trigger"
+            ],
+            "properties": {
+                "active": {
+                    "description": "defaults to true",
+                    "type": "boolean"
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent",
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 500
+                },
+                "budget": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "cap_period": {
+                    "type": "string",
+                    "enum": [
+                        "month",
+                        "campaign"
+                    ],
+                    "example": "month"
+                },
+                "category_id": {
+                    "description": "a system category, purchases only",
+                    "type": "string"
+                },
+                "clearing_days": {
+                    "description": "zero credits rewards right away",
+                    "type": "integer",
+                    "maximum": 365,
+                    "example": 14
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "first_only": {
+                    "description": "reward a user's first transaction of the trigger only",
+                    "type": "boolean"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "max_reward": {
+                    "description": "per transaction, no cap when left out",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "5% back on groceries"
                 },
                 "product_id": {
+                    "description": "purchases only",
                     "type": "string"
                 },
-                "transaction_time": {
+                "starts_at": {
+                    "type": "string"
+                },
+                "trigger": {
+                    "type": "string",
+                    "enum": [
+                        "purchase",
+                        "top_up"
+                    ],
+                    "example": "purchase"
+                },
+                "user_cap": {
+                    "description": "per user and cap period, no cap when left out",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.UpdateCampaignRes": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "basis_points": {
+                    "description": "hundredths of a percent, 500 is 5%",
+                    "type": "integer",
+                    "example": 500
+                },
+                "budget": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "cap_period": {
+                    "type": "string",
+                    "example": "month"
+                },
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
+                    "description": "purchases filed under it only",
+                    "type": "string"
+                },
+                "clearing_days": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "first_only": {
+                    "type": "boolean"
+                },
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "max_reward": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "5% back on groceries"
+                },
+                "product_id": {
+                    "description": "purchases of it only",
+                    "type": "string"
+                },
+                "spent": {
+                    "description": "rewards pending or credited",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "trigger": {
+                    "type": "string",
+                    "example": "purchase"
+                },
+                "updated_at": {
                     "type": "string"
                 },
-                "type": {
+                "updated_by": {
                     "type": "string"
                 },
-                "wallet": {
-                    "$ref": "#/definitions/entity.Wallet"
-                },
-                "wallet_id": {
-                    "type": "string"
+                "user_cap": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
                 }
             }
         },
-        "entity.User": {
+        "model.UpdateCategoryReq": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
-                },
-                "password": {
-                    "description": "Example of bcrypt-hashed password",
+                "kind": {
+                    "description": "defaults to expense",
                     "type": "string",
-                    "example": "$2a$12$eixZaYVK1fsbw1ZfbX3OXe.PZyWJQ0Zf10hErsTQ6FVRHiA2vwLHu"
+                    "enum": [
+                        "expense",
+                        "income"
+                    ],
+                    "example": "expense"
                 },
-                "username": {
+                "name": {
                     "type": "string",
-                    "example": "john_doe"
+                    "maxLength": 64,
+                    "example": "Groceries"
                 }
             }
         },
-        "entity.Wallet": {
+        "model.UpdateCategoryRes": {
             "type": "object",
-            "required": [
-                "user_id"
-            ],
             "properties": {
-                "balance": {
-                    "type": "number"
+                "created_at": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "last_transaction": {
-                    "type": "string"
+                "kind": {
+                    "type": "string",
+                    "example": "expense"
                 },
                 "name": {
                     "type": "string",
-                    "example": "personal"
+                    "example": "Groceries"
+                },
+                "updated_at": {
+                    "type": "string"
                 },
                 "user": {
                     "$ref": "#/definitions/entity.User"
                 },
                 "user_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "type": "string"
                 }
             }
         },
-        "model.CreateProductReq": {
+        "model.UpdateCategoryRuleReq": {
             "type": "object",
             "required": [
                 "name",
-                "price",
-                "quantity"
+                "tags"
             ],
             "properties": {
-                "available": {
-                    "type": "boolean"
-                },
-                "description": {
+                "category_id": {
                     "type": "string"
                 },
-                "name": {
+                "counterparty_wallet_id": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
-                },
-                "quantity": {
-                    "type": "integer"
-                }
-            }
-        },
-        "model.CreateProductRes": {
-            "type": "object",
-            "properties": {
-                "available": {
-                    "type": "boolean"
+                "description_contains": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "example": "coffee"
                 },
-                "created_at": {
-                    "type": "string"
+                "direction": {
+                    "type": "string",
+                    "enum": [
+                        "in",
+                        "out"
+                    ],
+                    "example": "out"
                 },
-                "description": {
-                    "type": "string"
+                "max_amount": {
+                    "description": "in the wallet currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
                 },
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                "min_amount": {
+                    "description": "in the wallet currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
                 },
                 "name": {
-                    "type": "string"
-                },
-                "price": {
-                    "type": "number"
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "Coffee"
                 },
-                "quantity": {
+                "priority": {
+                    "description": "lowest runs first",
                     "type": "integer"
                 },
-                "updated_at": {
+                "product_id": {
                     "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "model.CreateTransactionReq": {
+        "model.UpdateCategoryRuleRes": {
             "type": "object",
-            "required": [
-                "product_id",
-                "product_quantity",
-                "wallet_id"
-            ],
             "properties": {
-                "product_id": {
+                "category": {
+                    "$ref": "#/definitions/entity.Category"
+                },
+                "category_id": {
                     "type": "string"
                 },
-                "product_quantity": {
-                    "type": "integer"
+                "counterparty_wallet_id": {
+                    "description": "the other wallet of a transfer",
+                    "type": "string"
                 },
-                "wallet_id": {
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "model.CreateTransactionRes": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "number"
                 },
-                "description": {
+                "created_by": {
                     "type": "string"
                 },
+                "description_contains": {
+                    "description": "case insensitive",
+                    "type": "string",
+                    "example": "coffee"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "out"
+                },
                 "id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "product": {
-                    "$ref": "#/definitions/entity.Product"
+                "max_amount": {
+                    "description": "inclusive, in the wallet currency, zero for no maximum",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "min_amount": {
+                    "description": "inclusive, in the wallet currency, zero for no minimum",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Coffee"
+                },
+                "priority": {
+                    "type": "integer"
                 },
                 "product_id": {
                     "type": "string"
                 },
-                "transaction_time": {
-                    "type": "string"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "type": {
+                "updated_at": {
                     "type": "string"
                 },
                 "wallet": {
@@ -1400,234 +15272,220 @@ const docTemplate = `{
                 }
             }
         },
-        "model.CreateUserReq": {
+        "model.UpdateExchangeRateReq": {
             "type": "object",
             "required": [
-                "password",
-                "username"
+                "base_currency",
+                "quote_currency",
+                "rate"
             ],
             "properties": {
-                "password": {
-                    "type": "string",
-                    "minLength": 8,
-                    "example": "SecurePass123!"
-                },
-                "username": {
-                    "type": "string",
-                    "example": "john_doe"
-                }
-            }
-        },
-        "model.CreateUserRes": {
-            "type": "object",
-            "properties": {
-                "id": {
+                "base_currency": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "example": "USD"
                 },
-                "password": {
-                    "description": "Example of bcrypt-hashed password",
+                "quote_currency": {
                     "type": "string",
-                    "example": "$2a$12$eixZaYVK1fsbw1ZfbX3OXe.PZyWJQ0Zf10hErsTQ6FVRHiA2vwLHu"
+                    "example": "IDR"
                 },
-                "username": {
+                "rate": {
                     "type": "string",
-                    "example": "john_doe"
+                    "example": "15750.5"
                 }
             }
         },
-        "model.CreateWalletReq": {
+        "model.UpdateExchangeRateRes": {
             "type": "object",
             "properties": {
-                "name": {
+                "base_currency": {
                     "type": "string",
-                    "example": "personal"
-                }
-            }
-        },
-        "model.CreateWalletRes": {
-            "type": "object",
-            "required": [
-                "user_id"
-            ],
-            "properties": {
-                "balance": {
-                    "type": "number"
+                    "example": "USD"
+                },
+                "created_at": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "last_transaction": {
-                    "type": "string"
+                "quote_currency": {
+                    "type": "string",
+                    "example": "IDR"
                 },
-                "name": {
+                "rate": {
                     "type": "string",
-                    "example": "personal"
+                    "example": "15750.5"
                 },
-                "user": {
-                    "$ref": "#/definitions/entity.User"
+                "updated_at": {
+                    "type": "string"
                 },
-                "user_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                "updated_by": {
+                    "type": "string"
                 }
             }
         },
-        "model.CreditTransactionReq": {
+        "model.UpdateFeeRuleReq": {
             "type": "object",
             "required": [
-                "amount",
-                "wallet_id"
+                "currency",
+                "operation"
             ],
             "properties": {
-                "amount": {
-                    "type": "number"
+                "active": {
+                    "description": "defaults to true",
+                    "type": "boolean"
                 },
-                "wallet_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.CreditTransactionRes": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "number"
+                "amount_from": {
+                    "description": "defaults to zero",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
                 },
-                "description": {
-                    "type": "string"
+                "amount_to": {
+                    "description": "excluded, no upper bound when left out",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
                 },
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                "basis_points": {
+                    "description": "hundredths of a percent",
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 150
                 },
-                "product": {
-                    "$ref": "#/definitions/entity.Product"
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
                 },
-                "product_id": {
-                    "type": "string"
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
                 },
-                "transaction_time": {
-                    "type": "string"
+                "max_fee": {
+                    "description": "no cap when left out",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
                 },
-                "type": {
-                    "type": "string"
+                "min_fee": {
+                    "$ref": "#/definitions/money.Money"
                 },
-                "wallet": {
-                    "$ref": "#/definitions/entity.Wallet"
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "transfer",
+                        "purchase",
+                        "withdrawal"
+                    ],
+                    "example": "transfer"
                 },
-                "wallet_id": {
-                    "type": "string"
+                "tier": {
+                    "type": "string",
+                    "enum": [
+                        "standard",
+                        "premium",
+                        "business"
+                    ],
+                    "example": "premium"
                 }
             }
         },
-        "model.DeleteProductRes": {
-            "type": "object"
-        },
-        "model.DeleteTransactionRes": {
-            "type": "object"
-        },
-        "model.DeleteWalletRes": {
-            "type": "object"
-        },
-        "model.GetAllProductRes": {
+        "model.UpdateFeeRuleRes": {
             "type": "object",
             "properties": {
-                "data": {
-                    "description": "The actual data",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entity.Product"
-                    }
+                "active": {
+                    "type": "boolean"
                 },
-                "limit": {
-                    "description": "The size of the page",
-                    "type": "integer"
+                "amount_from": {
+                    "$ref": "#/definitions/money.Money"
                 },
-                "page": {
-                    "description": "The current page",
-                    "type": "integer"
+                "amount_to": {
+                    "description": "excluded, zero for no upper bound",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
                 },
-                "total_pages": {
-                    "description": "The total number of pages",
-                    "type": "integer"
+                "basis_points": {
+                    "description": "hundredths of a percent, 150 is 1.5%",
+                    "type": "integer",
+                    "example": 150
                 },
-                "total_row_per_page": {
-                    "description": "The total number of data per page",
-                    "type": "integer"
+                "created_at": {
+                    "type": "string"
                 },
-                "total_rows": {
-                    "description": "The total number of data",
-                    "type": "integer"
-                }
-            }
-        },
-        "model.GetAllTransactionRes": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "description": "The actual data",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entity.Transaction"
-                    }
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
                 },
-                "limit": {
-                    "description": "The size of the page",
-                    "type": "integer"
+                "flat": {
+                    "$ref": "#/definitions/money.Money"
                 },
-                "page": {
-                    "description": "The current page",
-                    "type": "integer"
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "total_pages": {
-                    "description": "The total number of pages",
-                    "type": "integer"
+                "max_fee": {
+                    "description": "zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
                 },
-                "total_row_per_page": {
-                    "description": "The total number of data per page",
-                    "type": "integer"
+                "min_fee": {
+                    "$ref": "#/definitions/money.Money"
                 },
-                "total_rows": {
-                    "description": "The total number of data",
-                    "type": "integer"
+                "operation": {
+                    "type": "string",
+                    "example": "transfer"
+                },
+                "tier": {
+                    "description": "none for every tier",
+                    "type": "string",
+                    "example": "premium"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
                 }
             }
         },
-        "model.GetAllWalletRes": {
+        "model.UpdateProductReq": {
             "type": "object",
+            "required": [
+                "name",
+                "price",
+                "quantity"
+            ],
             "properties": {
-                "data": {
-                    "description": "The actual data",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entity.Wallet"
-                    }
-                },
-                "limit": {
-                    "description": "The size of the page",
-                    "type": "integer"
+                "available": {
+                    "type": "boolean"
                 },
-                "page": {
-                    "description": "The current page",
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "total_pages": {
-                    "description": "The total number of pages",
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "total_row_per_page": {
-                    "description": "The total number of data per page",
-                    "type": "integer"
+                "price": {
+                    "$ref": "#/definitions/money.Money"
                 },
-                "total_rows": {
-                    "description": "The total number of data",
+                "quantity": {
                     "type": "integer"
                 }
             }
         },
-        "model.GetProductByIDRes": {
+        "model.UpdateProductRes": {
             "type": "object",
             "properties": {
                 "available": {
@@ -1647,7 +15505,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "price": {
-                    "type": "number"
+                    "$ref": "#/definitions/money.Money"
                 },
                 "quantity": {
                     "type": "integer"
@@ -1657,29 +15515,86 @@ const docTemplate = `{
                 }
             }
         },
-        "model.GetTransactionByIDRes": {
+        "model.UpdateSpendingLimitReq": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
+                "daily": {
+                    "$ref": "#/definitions/money.Money"
                 },
-                "description": {
+                "monthly": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "per_transaction": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "weekly": {
+                    "$ref": "#/definitions/money.Money"
+                }
+            }
+        },
+        "model.UpdateSpendingLimitRes": {
+            "type": "object",
+            "properties": {
+                "limits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SpendingAllowance"
+                    }
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.UpdateWalletMemberReq": {
+            "type": "object",
+            "properties": {
+                "allowance": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "spender",
+                        "viewer"
+                    ],
+                    "example": "viewer"
+                }
+            }
+        },
+        "model.UpdateWalletMemberRes": {
+            "type": "object",
+            "properties": {
+                "allowance": {
+                    "description": "what a spender may spend per WalletAllowanceWindow, zero for no cap",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "product": {
-                    "$ref": "#/definitions/entity.Product"
-                },
-                "product_id": {
+                "invited_by": {
                     "type": "string"
                 },
-                "transaction_time": {
+                "role": {
+                    "type": "string",
+                    "example": "spender"
+                },
+                "updated_at": {
                     "type": "string"
                 },
-                "type": {
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
                     "type": "string"
                 },
                 "wallet": {
@@ -1690,43 +15605,40 @@ const docTemplate = `{
                 }
             }
         },
-        "model.GetWalletByIDRes": {
+        "model.UpdateWalletReq": {
             "type": "object",
-            "required": [
-                "user_id"
-            ],
             "properties": {
-                "balance": {
-                    "type": "number"
-                },
-                "id": {
+                "currency": {
+                    "description": "fixed at creation, defaults to the configured currency",
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
-                },
-                "last_transaction": {
-                    "type": "string"
+                    "example": "IDR"
                 },
                 "name": {
                     "type": "string",
                     "example": "personal"
-                },
-                "user": {
-                    "$ref": "#/definitions/entity.User"
-                },
-                "user_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 }
             }
         },
-        "model.GetWalletByTransactionRes": {
+        "model.UpdateWalletRes": {
             "type": "object",
             "required": [
                 "user_id"
             ],
             "properties": {
                 "balance": {
-                    "type": "number"
+                    "description": "projection of the wallet ledger account, see LedgerAccount",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
                 },
                 "id": {
                     "type": "string",
@@ -1739,11 +15651,14 @@ const docTemplate = `{
                     "type": "string",
                     "example": "personal"
                 },
-                "transaction": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entity.Transaction"
-                    }
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "tier": {
+                    "description": "set by admins",
+                    "type": "string",
+                    "example": "standard"
                 },
                 "user": {
                     "$ref": "#/definitions/entity.User"
@@ -1754,80 +15669,64 @@ const docTemplate = `{
                 }
             }
         },
-        "model.LoginUserRes": {
+        "model.VerifyPayoutDestinationRes": {
             "type": "object",
             "properties": {
-                "token": {
-                    "description": "JWT token example",
+                "account_number": {
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"
+                    "example": "1234567890"
                 },
-                "username": {
+                "bank_code": {
                     "type": "string",
-                    "example": "john_doe"
-                }
-            }
-        },
-        "model.TransferTransactionReq": {
-            "type": "object",
-            "required": [
-                "amount",
-                "receiver_id",
-                "wallet_id"
-            ],
-            "properties": {
-                "amount": {
-                    "type": "number"
+                    "example": "BCA"
                 },
-                "receiver_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "wallet_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.TransferTransactionRes": {
-            "type": "object",
-            "properties": {
-                "receiver_transaction": {
-                    "$ref": "#/definitions/entity.Transaction"
+                "holder_name": {
+                    "description": "as the bank knows it once verified",
+                    "type": "string",
+                    "example": "JOHN DOE"
                 },
-                "sender_transaction": {
-                    "$ref": "#/definitions/entity.Transaction"
-                }
-            }
-        },
-        "model.UpdateProductReq": {
-            "type": "object",
-            "required": [
-                "name",
-                "price",
-                "quantity"
-            ],
-            "properties": {
-                "available": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "description": {
+                "label": {
+                    "type": "string",
+                    "example": "Salary account"
+                },
+                "rejection_reason": {
                     "type": "string"
                 },
-                "name": {
+                "status": {
+                    "type": "string",
+                    "example": "verified"
+                },
+                "updated_at": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "user_id": {
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "integer"
+                "verified_at": {
+                    "type": "string"
                 }
             }
         },
-        "model.UpdateProductRes": {
+        "model.VoidHoldRes": {
             "type": "object",
             "properties": {
-                "available": {
-                    "type": "boolean"
+                "amount": {
+                    "description": "in the wallet's currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "captured_amount": {
+                    "$ref": "#/definitions/money.Money"
                 },
                 "created_at": {
                     "type": "string"
@@ -1835,59 +15734,59 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "expires_at": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "name": {
+                "status": {
+                    "type": "string",
+                    "example": "authorized"
+                },
+                "transaction_id": {
+                    "description": "expense booked by the capture",
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "updated_at": {
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "integer"
+                "wallet": {
+                    "$ref": "#/definitions/entity.Wallet"
                 },
-                "updated_at": {
+                "wallet_id": {
                     "type": "string"
                 }
             }
         },
-        "model.UpdateWalletReq": {
+        "model.WalletCashFlow": {
             "type": "object",
             "properties": {
-                "name": {
-                    "type": "string",
-                    "example": "personal"
+                "inflow": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "net": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "outflow": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "wallet_id": {
+                    "type": "string"
                 }
             }
         },
-        "model.UpdateWalletRes": {
+        "money.Money": {
             "type": "object",
-            "required": [
-                "user_id"
-            ],
             "properties": {
-                "balance": {
-                    "type": "number"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
-                },
-                "last_transaction": {
-                    "type": "string"
-                },
-                "name": {
+                "amount": {
                     "type": "string",
-                    "example": "personal"
-                },
-                "user": {
-                    "$ref": "#/definitions/entity.User"
+                    "example": "1500.00"
                 },
-                "user_id": {
+                "currency": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "example": "IDR"
                 }
             }
         },
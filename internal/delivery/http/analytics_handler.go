@@ -0,0 +1,160 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+	"strconv"
+)
+
+type AnalyticsHTTPHandler struct {
+	Handler
+	AnalyticsService service.AnalyticsService
+}
+
+func NewAnalyticsHTTPHandler(analyticsService service.AnalyticsService) *AnalyticsHTTPHandler {
+	return &AnalyticsHTTPHandler{
+		AnalyticsService: analyticsService,
+	}
+}
+
+// parseAnalyticsReq reads the wallet and the date range the analytics cover.
+func (h *AnalyticsHTTPHandler) parseAnalyticsReq(ctx *gin.Context) (model.AnalyticsReq, bool) {
+	fromDate, toDate, err := h.ParseDateParam(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, "Invalid date format. Use YYYY-MM-DD.")
+		return model.AnalyticsReq{}, false
+	}
+	return model.AnalyticsReq{
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		WalletId: ctx.Query("wallet_id"),
+		From:     fromDate,
+		To:       toDate,
+	}, true
+}
+
+// CashFlow godoc
+// @Summary Get your cash flow over time
+// @Description Sums your income, expenses and transfers per day, week or month and currency, net of refunds.
+// @Description Covers every wallet you are a member of unless wallet_id is given, periods without transactions are left out
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param wallet_id query string false "Wallet ID"
+// @Param from query string false "Start date in YYYY-MM-DD format"
+// @Param to query string false "End date in YYYY-MM-DD format, included"
+// @Param period query string false "Period to sum by, month by default" Enums(day, week, month)
+// @Success 200 {object} response.DataResponse{data=model.GetCashFlowRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /analytics/cash-flow [get]
+func (h *AnalyticsHTTPHandler) CashFlow(ctx *gin.Context) {
+	base, ok := h.parseAnalyticsReq(ctx)
+	if !ok {
+		return
+	}
+	request := model.GetCashFlowReq{
+		AnalyticsReq: base,
+		Period:       ctx.DefaultQuery("period", model.AnalyticsPeriodMonth),
+	}
+	response, errException := h.AnalyticsService.CashFlow(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// TopProducts godoc
+// @Summary Get your most bought products
+// @Description Ranks the products you bought by quantity, net of refunds.
+// @Description Covers every wallet you are a member of unless wallet_id is given
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param wallet_id query string false "Wallet ID"
+// @Param from query string false "Start date in YYYY-MM-DD format"
+// @Param to query string false "End date in YYYY-MM-DD format, included"
+// @Param limit query int false "Number of products, 10 by default and at most 100"
+// @Success 200 {object} response.DataResponse{data=model.GetTopProductsRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /analytics/products [get]
+func (h *AnalyticsHTTPHandler) TopProducts(ctx *gin.Context) {
+	base, ok := h.parseAnalyticsReq(ctx)
+	if !ok {
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil {
+		h.BadRequestJSON(ctx, "Invalid limit.")
+		return
+	}
+	request := model.GetTopProductsReq{
+		AnalyticsReq: base,
+		Limit:        limit,
+	}
+	response, errException := h.AnalyticsService.TopProducts(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// SpendingByCategory godoc
+// @Summary Get your spending by category
+// @Description Sums what you spent, transferred, withdrew or paid in fees per category, net of refunds and largest first.
+// @Description Covers every wallet you are a member of unless wallet_id is given
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param wallet_id query string false "Wallet ID"
+// @Param from query string false "Start date in YYYY-MM-DD format"
+// @Param to query string false "End date in YYYY-MM-DD format, included"
+// @Success 200 {object} response.DataResponse{data=model.GetCategorySpendingRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /analytics/categories [get]
+func (h *AnalyticsHTTPHandler) SpendingByCategory(ctx *gin.Context) {
+	base, ok := h.parseAnalyticsReq(ctx)
+	if !ok {
+		return
+	}
+	request := model.GetCategorySpendingReq{AnalyticsReq: base}
+	response, errException := h.AnalyticsService.SpendingByCategory(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// WalletCashFlow godoc
+// @Summary Get the net cash flow of your wallets
+// @Description Sums what came in and went out of each of your wallets, the net being how much its balance changed.
+// @Description Covers every wallet you are a member of unless wallet_id is given, wallets that did not move are left out
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param wallet_id query string false "Wallet ID"
+// @Param from query string false "Start date in YYYY-MM-DD format"
+// @Param to query string false "End date in YYYY-MM-DD format, included"
+// @Success 200 {object} response.DataResponse{data=model.GetWalletCashFlowRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /analytics/wallets [get]
+func (h *AnalyticsHTTPHandler) WalletCashFlow(ctx *gin.Context) {
+	base, ok := h.parseAnalyticsReq(ctx)
+	if !ok {
+		return
+	}
+	request := model.GetWalletCashFlowReq{AnalyticsReq: base}
+	response, errException := h.AnalyticsService.WalletCashFlow(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type BillSplitHTTPHandler struct {
+	Handler
+	BillSplitService service.BillSplitService
+}
+
+func NewBillSplitHTTPHandler(billSplitService service.BillSplitService) *BillSplitHTTPHandler {
+	return &BillSplitHTTPHandler{
+		BillSplitService: billSplitService,
+	}
+}
+
+// Create godoc
+// @Summary Split a bill
+// @Description Splits an expense transaction, or an ad hoc amount, among users equally, by exact amounts or by percents.
+// @Description Every participant but you is sent a payment request for their share and settles it by paying the request.
+// @Description Rounding leftovers go one minor unit at a time to the participants in the order they are listed
+// @Tags Bill Splits
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param split body model.CreateBillSplitReq true "Create Bill Split Request"
+// @Success 200 {object} response.DataResponse{data=model.CreateBillSplitRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /bill-splits [post]
+func (h *BillSplitHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreateBillSplitReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.BillSplitService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get your bill splits
+// @Description Retrieves the splits you made, newest first by default. Shares you owe are among your incoming payment requests
+// @Tags Bill Splits
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllBillSplitRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /bill-splits [get]
+func (h *BillSplitHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllBillSplitReq{
+		UserId: h.ParseGetKey(ctx, "user_id"),
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.BillSplitService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get a bill split
+// @Description Retrieves a split with the payment request of every share and how much of it is settled,
+// @Description for its creator and its participants
+// @Tags Bill Splits
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Bill Split ID"
+// @Success 200 {object} response.DataResponse{data=model.GetBillSplitByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "error"
+// @Router /bill-splits/{id} [get]
+func (h *BillSplitHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetBillSplitByIDReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.BillSplitService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type BudgetHTTPHandler struct {
+	Handler
+	BudgetService service.BudgetService
+}
+
+func NewBudgetHTTPHandler(budgetService service.BudgetService) *BudgetHTTPHandler {
+	return &BudgetHTTPHandler{
+		BudgetService: budgetService,
+	}
+}
+
+// Create godoc
+// @Summary Create a budget
+// @Description Sets what the wallet means to spend on an expense category each calendar month, owners only
+// @Tags Budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param budget body model.CreateBudgetReq true "Create Budget Request"
+// @Success 200 {object} response.DataResponse{data=model.CreateBudgetRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/budgets [post]
+func (h *BudgetHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreateBudgetReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.WalletId = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.BudgetService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Update godoc
+// @Summary Update a budget
+// @Description Changes the monthly amount of a budget, owners only
+// @Tags Budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param budget_id path string true "Budget ID"
+// @Param budget body model.UpdateBudgetReq true "Update Budget Request"
+// @Success 200 {object} response.DataResponse{data=model.UpdateBudgetRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/budgets/{budget_id} [put]
+func (h *BudgetHTTPHandler) Update(ctx *gin.Context) {
+	var request model.UpdateBudgetReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.WalletId = ctx.Param("id")
+	request.ID = ctx.Param("budget_id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.BudgetService.Update(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get the budgets of a wallet
+// @Description Retrieves the budgets of a wallet with optional filters, pagination, and sorting
+// @Tags Budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllBudgetRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/budgets [get]
+func (h *BudgetHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllBudgetReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		Page:     page,
+		Filter:   filter,
+		Sort:     sort,
+	}
+	response, errException := h.BudgetService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Delete godoc
+// @Summary Delete a budget
+// @Description Deletes a budget of the wallet, owners only
+// @Tags Budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param budget_id path string true "Budget ID"
+// @Success 200 {object} response.DataResponse{data=model.DeleteBudgetRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/budgets/{budget_id} [delete]
+func (h *BudgetHTTPHandler) Delete(ctx *gin.Context) {
+	request := model.DeleteBudgetReq{
+		WalletId: ctx.Param("id"),
+		ID:       ctx.Param("budget_id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.BudgetService.Delete(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Status godoc
+// @Summary Get the budget status of a wallet
+// @Description Reports what was spent against each budget over a calendar month in UTC, net of refunds,
+// @Description along with what was spent outside the budgeted categories
+// @Tags Budgets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param period query string false "Month formatted as YYYY-MM, defaults to the current month"
+// @Success 200 {object} response.DataResponse{data=model.GetBudgetStatusRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/budgets/status [get]
+func (h *BudgetHTTPHandler) Status(ctx *gin.Context) {
+	request := model.GetBudgetStatusReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		Period:   ctx.Query("period"),
+	}
+	response, errException := h.BudgetService.Status(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type CampaignHTTPHandler struct {
+	Handler
+	CampaignService service.CampaignService
+}
+
+func NewCampaignHTTPHandler(campaignService service.CampaignService) *CampaignHTTPHandler {
+	return &CampaignHTTPHandler{
+		CampaignService: campaignService,
+	}
+}
+
+// Create godoc
+// @Summary Create a new campaign
+// @Description Creates a cashback or bonus campaign on purchases or top ups with its budget, dates and per-user cap, admin only
+// @Tags Campaigns
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param campaign body model.CreateCampaignReq true "Create Campaign Request"
+// @Success 200 {object} response.DataResponse{data=model.CreateCampaignRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /campaigns [post]
+func (h *CampaignHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreateCampaignReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.CampaignService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Update godoc
+// @Summary Update an existing campaign
+// @Description Replaces a campaign keeping what it already spent, deactivating it ends it, admin only
+// @Tags Campaigns
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Param campaign body model.UpdateCampaignReq true "Update Campaign Request"
+// @Success 200 {object} response.DataResponse{data=model.UpdateCampaignRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /campaigns/{id} [put]
+func (h *CampaignHTTPHandler) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var request model.UpdateCampaignReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.ID = id
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.CampaignService.Update(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get all campaigns
+// @Description Retrieves a list of all campaigns with optional filters, pagination, and sorting
+// @Tags Campaigns
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllCampaignRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /campaigns [get]
+func (h *CampaignHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllCampaignReq{
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.CampaignService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get campaign details
+// @Description Retrieves the details of a specific campaign by ID, with what it spent
+// @Tags Campaigns
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Success 200 {object} response.DataResponse{data=model.GetCampaignByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /campaigns/{id} [get]
+func (h *CampaignHTTPHandler) Detail(ctx *gin.Context) {
+	id := ctx.Param("id")
+	request := model.GetCampaignByIDReq{
+		ID: id,
+	}
+	response, errException := h.CampaignService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
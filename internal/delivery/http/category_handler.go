@@ -0,0 +1,157 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type CategoryHTTPHandler struct {
+	Handler
+	CategoryService service.CategoryService
+}
+
+func NewCategoryHTTPHandler(categoryService service.CategoryService) *CategoryHTTPHandler {
+	return &CategoryHTTPHandler{
+		CategoryService: categoryService,
+	}
+}
+
+// Create godoc
+// @Summary Create a custom category
+// @Description Creates a category only the user sees, named unlike the system categories and the other categories of the user
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param category body model.CreateCategoryReq true "Create Category Request"
+// @Success 200 {object} response.DataResponse{data=model.CreateCategoryRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /categories [post]
+func (h *CategoryHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreateCategoryReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.CategoryService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Update godoc
+// @Summary Update a custom category
+// @Description Renames a custom category of the user, system categories cannot be changed
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Param category body model.UpdateCategoryReq true "Update Category Request"
+// @Success 200 {object} response.DataResponse{data=model.UpdateCategoryRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /categories/{id} [put]
+func (h *CategoryHTTPHandler) Update(ctx *gin.Context) {
+	var request model.UpdateCategoryReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.ID = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.CategoryService.Update(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get all categories
+// @Description Retrieves the system categories and the custom ones of the user with optional filters, pagination, and sorting
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllCategoryRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /categories [get]
+func (h *CategoryHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllCategoryReq{
+		UserId: h.ParseGetKey(ctx, "user_id"),
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.CategoryService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get category details
+// @Description Retrieves a system category or a custom category of the user by ID
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Success 200 {object} response.DataResponse{data=model.GetCategoryByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /categories/{id} [get]
+func (h *CategoryHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetCategoryByIDReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.CategoryService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Delete godoc
+// @Summary Delete a custom category
+// @Description Deletes a custom category of the user, its transactions become uncategorized and its budgets are deleted
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Success 200 {object} response.DataResponse{data=model.DeleteCategoryRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /categories/{id} [delete]
+func (h *CategoryHTTPHandler) Delete(ctx *gin.Context) {
+	request := model.DeleteCategoryReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.CategoryService.Delete(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
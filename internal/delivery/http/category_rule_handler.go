@@ -0,0 +1,169 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type CategoryRuleHTTPHandler struct {
+	Handler
+	CategoryRuleService service.CategoryRuleService
+}
+
+func NewCategoryRuleHTTPHandler(categoryRuleService service.CategoryRuleService) *CategoryRuleHTTPHandler {
+	return &CategoryRuleHTTPHandler{
+		CategoryRuleService: categoryRuleService,
+	}
+}
+
+// Create godoc
+// @Summary Create a category rule
+// @Description Files and tags new transactions of the wallet meeting every condition of the rule, owners only.
+// @Description Rules run by priority, lowest first: the first matching rule with a category decides it and every matching rule adds its tags
+// @Tags Category Rules
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param rule body model.CreateCategoryRuleReq true "Create Category Rule Request"
+// @Success 200 {object} response.DataResponse{data=model.CreateCategoryRuleRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/rules [post]
+func (h *CategoryRuleHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreateCategoryRuleReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.WalletId = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.CategoryRuleService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Update godoc
+// @Summary Update a category rule
+// @Description Replaces the conditions and the actions of a rule, owners only
+// @Tags Category Rules
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param rule_id path string true "Category Rule ID"
+// @Param rule body model.UpdateCategoryRuleReq true "Update Category Rule Request"
+// @Success 200 {object} response.DataResponse{data=model.UpdateCategoryRuleRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/rules/{rule_id} [put]
+func (h *CategoryRuleHTTPHandler) Update(ctx *gin.Context) {
+	var request model.UpdateCategoryRuleReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.WalletId = ctx.Param("id")
+	request.ID = ctx.Param("rule_id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.CategoryRuleService.Update(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get the category rules of a wallet
+// @Description Retrieves the rules of a wallet in the order they run, with optional filters, pagination, and sorting
+// @Tags Category Rules
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllCategoryRuleRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/rules [get]
+func (h *CategoryRuleHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllCategoryRuleReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		Page:     page,
+		Filter:   filter,
+		Sort:     sort,
+	}
+	response, errException := h.CategoryRuleService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Delete godoc
+// @Summary Delete a category rule
+// @Description Deletes a rule of the wallet, transactions it already filed keep their category and tags, owners only
+// @Tags Category Rules
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param rule_id path string true "Category Rule ID"
+// @Success 200 {object} response.DataResponse{data=model.DeleteCategoryRuleRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/rules/{rule_id} [delete]
+func (h *CategoryRuleHTTPHandler) Delete(ctx *gin.Context) {
+	request := model.DeleteCategoryRuleReq{
+		WalletId: ctx.Param("id"),
+		ID:       ctx.Param("rule_id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.CategoryRuleService.Delete(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Apply godoc
+// @Summary Apply the category rules to past transactions
+// @Description Runs the rules of the wallet over every transaction it booked. A matching rule overrides the category
+// @Description a transaction was given by hand, transactions no rule matches are left as they are, owners only
+// @Tags Category Rules
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Success 200 {object} response.DataResponse{data=model.ApplyCategoryRulesRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/rules/apply [post]
+func (h *CategoryRuleHTTPHandler) Apply(ctx *gin.Context) {
+	request := model.ApplyCategoryRulesReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.CategoryRuleService.Apply(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
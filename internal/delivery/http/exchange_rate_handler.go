@@ -0,0 +1,158 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type ExchangeRateHTTPHandler struct {
+	Handler
+	ExchangeRateService service.ExchangeRateService
+}
+
+func NewExchangeRateHTTPHandler(exchangeRateService service.ExchangeRateService) *ExchangeRateHTTPHandler {
+	return &ExchangeRateHTTPHandler{
+		ExchangeRateService: exchangeRateService,
+	}
+}
+
+// Create godoc
+// @Summary Create a new exchange rate
+// @Description Creates the rate of a currency pair, admin only
+// @Tags ExchangeRates
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param exchangeRate body model.CreateExchangeRateReq true "Create Exchange Rate Request"
+// @Success 200 {object} response.DataResponse{data=model.CreateExchangeRateRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /exchange-rates [post]
+func (h *ExchangeRateHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreateExchangeRateReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UpdatedBy = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.ExchangeRateService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Update godoc
+// @Summary Update an existing exchange rate
+// @Description Updates the rate of a currency pair, admin only
+// @Tags ExchangeRates
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Param exchangeRate body model.UpdateExchangeRateReq true "Update Exchange Rate Request"
+// @Success 200 {object} response.DataResponse{data=model.UpdateExchangeRateRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /exchange-rates/{id} [put]
+func (h *ExchangeRateHTTPHandler) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var request model.UpdateExchangeRateReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.ID = id
+	request.UpdatedBy = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.ExchangeRateService.Update(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get all exchange rates
+// @Description Retrieves a list of all exchange rates with optional filters, pagination, and sorting
+// @Tags ExchangeRates
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllExchangeRateRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /exchange-rates [get]
+func (h *ExchangeRateHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllExchangeRateReq{
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.ExchangeRateService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get exchange rate details
+// @Description Retrieves the details of a specific exchange rate by ID
+// @Tags ExchangeRates
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Success 200 {object} response.DataResponse{data=model.GetExchangeRateByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /exchange-rates/{id} [get]
+func (h *ExchangeRateHTTPHandler) Detail(ctx *gin.Context) {
+	id := ctx.Param("id")
+	request := model.GetExchangeRateByIDReq{
+		ID: id,
+	}
+	response, errException := h.ExchangeRateService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Delete godoc
+// @Summary Delete an exchange rate
+// @Description Deletes an exchange rate by ID, admin only
+// @Tags ExchangeRates
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Success 200 {object} response.DataResponse{data=model.DeleteExchangeRateRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /exchange-rates/{id} [delete]
+func (h *ExchangeRateHTTPHandler) Delete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	request := model.DeleteExchangeRateReq{
+		ID: id,
+	}
+	response, errException := h.ExchangeRateService.Delete(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
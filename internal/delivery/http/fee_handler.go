@@ -0,0 +1,185 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type FeeHTTPHandler struct {
+	Handler
+	FeeService service.FeeService
+}
+
+func NewFeeHTTPHandler(feeService service.FeeService) *FeeHTTPHandler {
+	return &FeeHTTPHandler{
+		FeeService: feeService,
+	}
+}
+
+// Create godoc
+// @Summary Create a new fee rule
+// @Description Prices transfers, purchases or withdrawals in a currency, optionally for one wallet tier and amount range, admin only
+// @Tags Fees
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param feeRule body model.CreateFeeRuleReq true "Create Fee Rule Request"
+// @Success 200 {object} response.DataResponse{data=model.CreateFeeRuleRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /fee-rules [post]
+func (h *FeeHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreateFeeRuleReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.FeeService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Update godoc
+// @Summary Update an existing fee rule
+// @Description Replaces a fee rule, admin only
+// @Tags Fees
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Param feeRule body model.UpdateFeeRuleReq true "Update Fee Rule Request"
+// @Success 200 {object} response.DataResponse{data=model.UpdateFeeRuleRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /fee-rules/{id} [put]
+func (h *FeeHTTPHandler) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var request model.UpdateFeeRuleReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.ID = id
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.FeeService.Update(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get all fee rules
+// @Description Retrieves a list of all fee rules with optional filters, pagination, and sorting
+// @Tags Fees
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllFeeRuleRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /fee-rules [get]
+func (h *FeeHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllFeeRuleReq{
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.FeeService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get fee rule details
+// @Description Retrieves the details of a specific fee rule by ID
+// @Tags Fees
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Success 200 {object} response.DataResponse{data=model.GetFeeRuleByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /fee-rules/{id} [get]
+func (h *FeeHTTPHandler) Detail(ctx *gin.Context) {
+	id := ctx.Param("id")
+	request := model.GetFeeRuleByIDReq{
+		ID: id,
+	}
+	response, errException := h.FeeService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Delete godoc
+// @Summary Delete a fee rule
+// @Description Deletes a fee rule by ID, admin only
+// @Tags Fees
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Success 200 {object} response.DataResponse{data=model.DeleteFeeRuleRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /fee-rules/{id} [delete]
+func (h *FeeHTTPHandler) Delete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	request := model.DeleteFeeRuleReq{
+		ID: id,
+	}
+	response, errException := h.FeeService.Delete(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Quote godoc
+// @Summary Quote the fee of an operation
+// @Description Computes the fee a transfer, purchase or withdrawal of an amount out of a wallet would be charged, nothing is booked
+// @Tags Fees
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param quote body model.QuoteFeeReq true "Quote Fee Request"
+// @Success 200 {object} response.DataResponse{data=model.QuoteFeeRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /fees/quote [post]
+func (h *FeeHTTPHandler) Quote(ctx *gin.Context) {
+	var request model.QuoteFeeReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.FeeService.Quote(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,163 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type HoldHTTPHandler struct {
+	Handler
+	HoldService service.HoldService
+}
+
+func NewHoldHTTPHandler(holdService service.HoldService) *HoldHTTPHandler {
+	return &HoldHTTPHandler{
+		HoldService: holdService,
+	}
+}
+
+// Authorize godoc
+// @Summary Authorize a hold
+// @Description Reserves funds of a wallet without moving them, the available balance drops but the ledger balance does not
+// @Tags Holds
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param hold body model.AuthorizeHoldReq true "Authorize Hold Request"
+// @Success 200 {object} response.DataResponse{data=model.AuthorizeHoldRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /holds [post]
+func (h *HoldHTTPHandler) Authorize(ctx *gin.Context) {
+	var request model.AuthorizeHoldReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.HoldService.Authorize(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Capture godoc
+// @Summary Capture a hold
+// @Description Books all or part of a hold as an expense transaction, the part not captured is released
+// @Tags Holds
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param id path string true "Hold ID"
+// @Param capture body model.CaptureHoldReq false "Capture Hold Request"
+// @Success 200 {object} response.DataResponse{data=model.CaptureHoldRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /holds/{id}/capture [post]
+func (h *HoldHTTPHandler) Capture(ctx *gin.Context) {
+	var request model.CaptureHoldReq
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			h.BadRequestJSON(ctx, err.Error())
+			return
+		}
+	}
+	request.ID = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.HoldService.Capture(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Void godoc
+// @Summary Void a hold
+// @Description Releases a hold without moving any funds
+// @Tags Holds
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param id path string true "Hold ID"
+// @Success 200 {object} response.DataResponse{data=model.VoidHoldRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /holds/{id}/void [post]
+func (h *HoldHTTPHandler) Void(ctx *gin.Context) {
+	request := model.VoidHoldReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.HoldService.Void(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get all holds
+// @Description Retrieves the holds on the wallets you are a member of with optional filters, pagination, and sorting
+// @Tags Holds
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllHoldRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /holds [get]
+func (h *HoldHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllHoldReq{
+		UserId: h.ParseGetKey(ctx, "user_id"),
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.HoldService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get hold details
+// @Description Retrieves the details of a specific hold by ID
+// @Tags Holds
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Hold ID"
+// @Success 200 {object} response.DataResponse{data=model.GetHoldByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /holds/{id} [get]
+func (h *HoldHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetHoldByIDReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.HoldService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type LedgerHTTPHandler struct {
+	Handler
+	LedgerService service.LedgerService
+}
+
+func NewLedgerHTTPHandler(ledgerService service.LedgerService) *LedgerHTTPHandler {
+	return &LedgerHTTPHandler{
+		LedgerService: ledgerService,
+	}
+}
+
+// FindAccounts godoc
+// @Summary Get all ledger accounts
+// @Description Retrieves the wallet, system and merchant accounts of the double-entry ledger, admin only
+// @Tags Ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllLedgerAccountRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /ledger/accounts [get]
+func (h *LedgerHTTPHandler) FindAccounts(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllLedgerAccountReq{
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.LedgerService.FindAccounts(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// FindEntries godoc
+// @Summary Get all journal entries
+// @Description Retrieves the posted journal entries of the double-entry ledger, admin only
+// @Tags Ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllJournalEntryRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /ledger/entries [get]
+func (h *LedgerHTTPHandler) FindEntries(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllJournalEntryReq{
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.LedgerService.FindEntries(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// DetailEntry godoc
+// @Summary Get journal entry details
+// @Description Retrieves a journal entry with its debit and credit lines, admin only
+// @Tags Ledger
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Journal entry ID"
+// @Success 200 {object} response.DataResponse{data=model.GetJournalEntryByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /ledger/entries/{id} [get]
+func (h *LedgerHTTPHandler) DetailEntry(ctx *gin.Context) {
+	id := ctx.Param("id")
+	request := model.GetJournalEntryByIDReq{
+		ID: id,
+	}
+	response, errException := h.LedgerService.DetailEntry(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -3,6 +3,8 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 	"log/slog"
+	"net/http"
+	"product-wallet/internal/entity"
 	"product-wallet/pkg/signature"
 	"strings"
 )
@@ -33,11 +35,21 @@ func (m *AuthMiddleware) JWTAuthentication(c *gin.Context) {
 
 	c.Set("username", res.Username)
 	c.Set("user_id", res.UserId)
+	c.Set("role", res.Role)
 	c.Set("access_token", res.Token)
 
 	c.Next()
 }
 
+// AdminAuthorization only lets users with the admin role through, it runs after JWTAuthentication.
+func (m *AuthMiddleware) AdminAuthorization(c *gin.Context) {
+	if m.ParseGetKey(c, "role") != entity.UserRoleAdmin {
+		m.ErrorJSON(c, http.StatusForbidden, "admin role is required")
+		return
+	}
+	c.Next()
+}
+
 func (m *AuthMiddleware) ErrorHandler(c *gin.Context) {
 
 	defer func() {
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log/slog"
+	"net/http"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotent-Replayed"
+)
+
+type IdempotencyMiddleware struct {
+	Middleware
+	idempotencyService service.IdempotencyService
+}
+
+func NewIdempotencyMiddleware(idempotencyService service.IdempotencyService) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{idempotencyService: idempotencyService}
+}
+
+// responseRecorder keeps a copy of the body written by the handler.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
+
+// Idempotency replays the stored response of a request retried with the same
+// Idempotency-Key header. Requests without the header are served as usual, it
+// runs after JWTAuthentication since keys are scoped to the user. It guards the
+// routes that change something, reads have nothing to replay.
+func (m *IdempotencyMiddleware) Idempotency(c *gin.Context) {
+	key := c.GetHeader(IdempotencyKeyHeader)
+	if key == "" {
+		c.Next()
+		return
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		m.BadRequestJSON(c, err.Error())
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	reserved, errException := m.idempotencyService.Begin(c, &model.BeginIdempotencyReq{
+		UserId: m.ParseGetKey(c, "user_id"),
+		Key:    key,
+		Method: c.Request.Method,
+		Path:   c.Request.URL.RequestURI(),
+		Body:   body,
+	})
+	if errException != nil {
+		m.ExceptionJSON(c, errException)
+		return
+	}
+	if reserved.Replay {
+		c.Header(IdempotencyReplayedHeader, "true")
+		c.Data(reserved.StatusCode, "application/json; charset=utf-8", []byte(reserved.Response))
+		c.Abort()
+		return
+	}
+
+	recorder := &responseRecorder{ResponseWriter: c.Writer}
+	c.Writer = recorder
+	// a panicking handler never gets a response stored, the key is released before
+	// the panic goes on to gin's recovery so the client can retry
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			m.release(c, reserved.Id)
+			panic(recovered)
+		}
+	}()
+	c.Next()
+
+	// server errors may be transient, the client is allowed to retry them
+	if recorder.Status() >= http.StatusInternalServerError {
+		m.release(c, reserved.Id)
+		return
+	}
+	if errException := m.idempotencyService.Complete(c, &model.CompleteIdempotencyReq{
+		ID:         reserved.Id,
+		StatusCode: recorder.Status(),
+		Response:   recorder.body.Bytes(),
+	}); errException != nil {
+		slog.Error("failed to store idempotent response", "error", errException.Error)
+	}
+}
+
+func (m *IdempotencyMiddleware) release(c *gin.Context, id string) {
+	if errException := m.idempotencyService.Release(c, &model.ReleaseIdempotencyReq{
+		ID: id,
+	}); errException != nil {
+		slog.Error("failed to release idempotency key", "error", errException.Error)
+	}
+}
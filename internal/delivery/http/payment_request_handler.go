@@ -0,0 +1,245 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type PaymentRequestHTTPHandler struct {
+	Handler
+	PaymentRequestService service.PaymentRequestService
+}
+
+func NewPaymentRequestHTTPHandler(paymentRequestService service.PaymentRequestService) *PaymentRequestHTTPHandler {
+	return &PaymentRequestHTTPHandler{
+		PaymentRequestService: paymentRequestService,
+	}
+}
+
+// Create godoc
+// @Summary Request money from a user
+// @Description Asks the user going by payer_username for an amount paid into one of your wallets, it stays pending
+// @Description until they pay or decline it, you cancel it or it expires. Requires the spender role on the wallet
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param request body model.CreatePaymentRequestReq true "Create Payment Request Request"
+// @Success 200 {object} response.DataResponse{data=model.CreatePaymentRequestRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /payment-requests [post]
+func (h *PaymentRequestHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreatePaymentRequestReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.PaymentRequestService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Pay godoc
+// @Summary Pay a payment request
+// @Description Transfers the requested amount out of the wallet you pick, converted when its currency differs.
+// @Description Only the payer can pay, and only while the request is pending
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param id path string true "Payment Request ID"
+// @Param pay body model.PayPaymentRequestReq true "Pay Payment Request Request"
+// @Success 200 {object} response.DataResponse{data=model.PayPaymentRequestRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /payment-requests/{id}/pay [post]
+func (h *PaymentRequestHTTPHandler) Pay(ctx *gin.Context) {
+	var request model.PayPaymentRequestReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.ID = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.PaymentRequestService.Pay(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Decline godoc
+// @Summary Decline a payment request
+// @Description Turns down a pending request made to you, the reason is kept in its status history
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param id path string true "Payment Request ID"
+// @Param decline body model.DeclinePaymentRequestReq false "Decline Payment Request Request"
+// @Success 200 {object} response.DataResponse{data=model.DeclinePaymentRequestRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /payment-requests/{id}/decline [post]
+func (h *PaymentRequestHTTPHandler) Decline(ctx *gin.Context) {
+	var request model.DeclinePaymentRequestReq
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			h.BadRequestJSON(ctx, err.Error())
+			return
+		}
+	}
+	request.ID = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.PaymentRequestService.Decline(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Cancel godoc
+// @Summary Cancel a payment request
+// @Description Withdraws a pending request you made
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param id path string true "Payment Request ID"
+// @Param cancel body model.CancelPaymentRequestReq false "Cancel Payment Request Request"
+// @Success 200 {object} response.DataResponse{data=model.CancelPaymentRequestRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /payment-requests/{id}/cancel [post]
+func (h *PaymentRequestHTTPHandler) Cancel(ctx *gin.Context) {
+	var request model.CancelPaymentRequestReq
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			h.BadRequestJSON(ctx, err.Error())
+			return
+		}
+	}
+	request.ID = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.PaymentRequestService.Cancel(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get your payment requests
+// @Description Retrieves the requests made to you, or with direction outgoing the ones you made, newest first by default
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param direction query string false "incoming (default) or outgoing"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllPaymentRequestRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /payment-requests [get]
+func (h *PaymentRequestHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllPaymentRequestReq{
+		UserId:    h.ParseGetKey(ctx, "user_id"),
+		Direction: ctx.DefaultQuery("direction", model.PaymentRequestIncoming),
+		Page:      page,
+		Filter:    filter,
+		Sort:      sort,
+	}
+	response, errException := h.PaymentRequestService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get a payment request
+// @Description Retrieves a request you made or were asked to pay
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Payment Request ID"
+// @Success 200 {object} response.DataResponse{data=model.GetPaymentRequestByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "error"
+// @Router /payment-requests/{id} [get]
+func (h *PaymentRequestHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetPaymentRequestByIDReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.PaymentRequestService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// FindStatusChanges godoc
+// @Summary Get the status history of a payment request
+// @Description Retrieves every status change of a request, newest first by default. Expiry has no changed_by
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Payment Request ID"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllPaymentRequestStatusChangeRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "error"
+// @Router /payment-requests/{id}/status-history [get]
+func (h *PaymentRequestHTTPHandler) FindStatusChanges(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllPaymentRequestStatusChangeReq{
+		RequestId: ctx.Param("id"),
+		UserId:    h.ParseGetKey(ctx, "user_id"),
+		Page:      page,
+		Filter:    filter,
+		Sort:      sort,
+	}
+	response, errException := h.PaymentRequestService.FindStatusChanges(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,159 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type PayoutDestinationHTTPHandler struct {
+	Handler
+	PayoutDestinationService service.PayoutDestinationService
+}
+
+func NewPayoutDestinationHTTPHandler(payoutDestinationService service.PayoutDestinationService) *PayoutDestinationHTTPHandler {
+	return &PayoutDestinationHTTPHandler{
+		PayoutDestinationService: payoutDestinationService,
+	}
+}
+
+// Create godoc
+// @Summary Save a payout destination
+// @Description Saves a bank account to withdraw to and verifies it with the payout provider. It stays pending
+// @Description when the provider cannot be reached, only verified destinations can be paid
+// @Tags Payout Destinations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param destination body model.CreatePayoutDestinationReq true "Create Payout Destination Request"
+// @Success 200 {object} response.DataResponse{data=model.CreatePayoutDestinationRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "the account is already saved, or key reused for a different request"
+// @Router /payout-destinations [post]
+func (h *PayoutDestinationHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreatePayoutDestinationReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.PayoutDestinationService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Verify godoc
+// @Summary Verify a payout destination
+// @Description Asks the payout provider again about a destination left pending
+// @Tags Payout Destinations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param id path string true "Payout Destination ID"
+// @Success 200 {object} response.DataResponse{data=model.VerifyPayoutDestinationRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /payout-destinations/{id}/verify [post]
+func (h *PayoutDestinationHTTPHandler) Verify(ctx *gin.Context) {
+	request := model.VerifyPayoutDestinationReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.PayoutDestinationService.Verify(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Delete godoc
+// @Summary Delete a payout destination
+// @Description Forgets a saved bank account, payouts already sent to it keep a copy of the account
+// @Tags Payout Destinations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Payout Destination ID"
+// @Success 200 {object} response.DataResponse{data=model.DeletePayoutDestinationRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "error"
+// @Router /payout-destinations/{id} [delete]
+func (h *PayoutDestinationHTTPHandler) Delete(ctx *gin.Context) {
+	request := model.DeletePayoutDestinationReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.PayoutDestinationService.Delete(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get your payout destinations
+// @Description Retrieves the bank accounts you saved, newest first by default
+// @Tags Payout Destinations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllPayoutDestinationRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /payout-destinations [get]
+func (h *PayoutDestinationHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllPayoutDestinationReq{
+		UserId: h.ParseGetKey(ctx, "user_id"),
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.PayoutDestinationService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get a payout destination
+// @Description Retrieves a bank account you saved with its verification status
+// @Tags Payout Destinations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Payout Destination ID"
+// @Success 200 {object} response.DataResponse{data=model.GetPayoutDestinationByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "error"
+// @Router /payout-destinations/{id} [get]
+func (h *PayoutDestinationHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetPayoutDestinationByIDReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.PayoutDestinationService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
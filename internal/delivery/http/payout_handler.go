@@ -0,0 +1,110 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type PayoutHTTPHandler struct {
+	Handler
+	PayoutService service.PayoutService
+}
+
+func NewPayoutHTTPHandler(payoutService service.PayoutService) *PayoutHTTPHandler {
+	return &PayoutHTTPHandler{
+		PayoutService: payoutService,
+	}
+}
+
+// Create godoc
+// @Summary Withdraw to a bank account
+// @Description Debits the wallet and sends the money to one of your verified payout destinations. The payout goes
+// @Description from submitted to paid or failed, and a paid payout can still be returned by the bank. The wallet
+// @Description gets the money back by itself when the payout fails or is returned
+// @Tags Payouts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param payout body model.CreatePayoutReq true "Create Payout Request"
+// @Success 200 {object} response.DataResponse{data=model.CreatePayoutRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Failure 500 {object} response.DataResponse "the provider refused the payout, its money was given back"
+// @Router /payouts [post]
+func (h *PayoutHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreatePayoutReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.PayoutService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get your payouts
+// @Description Retrieves the payouts you made, newest first by default
+// @Tags Payouts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllPayoutRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /payouts [get]
+func (h *PayoutHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllPayoutReq{
+		UserId: h.ParseGetKey(ctx, "user_id"),
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.PayoutService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get a payout
+// @Description Retrieves a payout you made with its status
+// @Tags Payouts
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Payout ID"
+// @Success 200 {object} response.DataResponse{data=model.GetPayoutByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "error"
+// @Router /payouts/{id} [get]
+func (h *PayoutHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetPayoutByIDReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.PayoutService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,129 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type ReconciliationHTTPHandler struct {
+	Handler
+	ReconciliationService service.ReconciliationService
+}
+
+func NewReconciliationHTTPHandler(reconciliationService service.ReconciliationService) *ReconciliationHTTPHandler {
+	return &ReconciliationHTTPHandler{
+		ReconciliationService: reconciliationService,
+	}
+}
+
+// Run godoc
+// @Summary Reconcile wallet balances
+// @Description Compares the balance of every wallet with the sum of its transactions and with its ledger account and records the mismatches as findings, admin only.
+// @Description Auto correction rebuilds the wallet balance from the ledger and books an adjustment transaction that only completes the history, the ledger itself is never posted to
+// @Tags Reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param run body model.RunReconciliationReq true "Run Reconciliation Request"
+// @Success 200 {object} response.DataResponse{data=model.RunReconciliationRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /reconciliation/runs [post]
+func (h *ReconciliationHTTPHandler) Run(ctx *gin.Context) {
+	var request model.RunReconciliationReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	response, errException := h.ReconciliationService.Run(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get all reconciliation findings
+// @Description Retrieves the wallets found out of balance with optional filters, pagination, and sorting, admin only
+// @Tags Reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllReconciliationFindingRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /reconciliation/findings [get]
+func (h *ReconciliationHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllReconciliationFindingReq{
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.ReconciliationService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get reconciliation finding details
+// @Description Retrieves a reconciliation finding by ID, admin only
+// @Tags Reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Success 200 {object} response.DataResponse{data=model.GetReconciliationFindingByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /reconciliation/findings/{id} [get]
+func (h *ReconciliationHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetReconciliationFindingByIDReq{
+		ID: ctx.Param("id"),
+	}
+	response, errException := h.ReconciliationService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Correct godoc
+// @Summary Correct a reconciliation finding
+// @Description Checks the wallet of an open finding again, rebuilds its balance from the ledger and books an adjustment transaction for what its history misses, admin only.
+// @Description The adjustment only completes the transaction history, no money moves as the ledger is left as it is
+// @Tags Reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "uuid format"
+// @Success 200 {object} response.DataResponse{data=model.CorrectReconciliationFindingRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /reconciliation/findings/{id}/correct [post]
+func (h *ReconciliationHTTPHandler) Correct(ctx *gin.Context) {
+	request := model.CorrectReconciliationFindingReq{
+		ID: ctx.Param("id"),
+	}
+	response, errException := h.ReconciliationService.Correct(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type RewardHTTPHandler struct {
+	Handler
+	RewardService service.RewardService
+}
+
+func NewRewardHTTPHandler(rewardService service.RewardService) *RewardHTTPHandler {
+	return &RewardHTTPHandler{
+		RewardService: rewardService,
+	}
+}
+
+// Find godoc
+// @Summary Get your rewards
+// @Description Retrieves the rewards campaigns granted you, pending or credited, newest first by default
+// @Tags Rewards
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllRewardRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /rewards [get]
+func (h *RewardHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllRewardReq{
+		UserId: h.ParseGetKey(ctx, "user_id"),
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.RewardService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get a reward
+// @Description Retrieves a reward you were granted with its status
+// @Tags Rewards
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Reward ID"
+// @Success 200 {object} response.DataResponse{data=model.GetRewardByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "error"
+// @Router /rewards/{id} [get]
+func (h *RewardHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetRewardByIDReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.RewardService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
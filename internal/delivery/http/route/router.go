@@ -7,12 +7,32 @@ import (
 )
 
 type Router struct {
-	App                *gin.Engine
-	UserHandler        *http.UserHTTPHandler
-	ProductHandler     *http.ProductHTTPHandler
-	WalletHandler      *http.WalletHTTPHandler
-	TransactionHandler *http.TransactionHTTPHandler
-	AuthMiddleware     *api.AuthMiddleware
+	App                      *gin.Engine
+	UserHandler              *http.UserHTTPHandler
+	ProductHandler           *http.ProductHTTPHandler
+	WalletHandler            *http.WalletHTTPHandler
+	TransactionHandler       *http.TransactionHTTPHandler
+	LedgerHandler            *http.LedgerHTTPHandler
+	ExchangeRateHandler      *http.ExchangeRateHTTPHandler
+	HoldHandler              *http.HoldHTTPHandler
+	StandingOrderHandler     *http.StandingOrderHTTPHandler
+	SpendingLimitHandler     *http.SpendingLimitHTTPHandler
+	StatementHandler         *http.StatementHTTPHandler
+	ReconciliationHandler    *http.ReconciliationHTTPHandler
+	CategoryHandler          *http.CategoryHTTPHandler
+	BudgetHandler            *http.BudgetHTTPHandler
+	CategoryRuleHandler      *http.CategoryRuleHTTPHandler
+	PaymentRequestHandler    *http.PaymentRequestHTTPHandler
+	BillSplitHandler         *http.BillSplitHTTPHandler
+	TopUpHandler             *http.TopUpHTTPHandler
+	PayoutDestinationHandler *http.PayoutDestinationHTTPHandler
+	PayoutHandler            *http.PayoutHTTPHandler
+	FeeHandler               *http.FeeHTTPHandler
+	CampaignHandler          *http.CampaignHTTPHandler
+	RewardHandler            *http.RewardHTTPHandler
+	AnalyticsHandler         *http.AnalyticsHTTPHandler
+	AuthMiddleware           *api.AuthMiddleware
+	IdempotencyMiddleware    *api.IdempotencyMiddleware
 }
 
 func (h *Router) Setup() {
@@ -25,9 +45,17 @@ func (h *Router) Setup() {
 		guestApi.POST("/login", h.UserHandler.Login)
 	}
 
+	// Webhook routes, authenticated by the sender's signature
+	webhookApi := h.App.Group("/webhooks")
+	{
+		webhookApi.POST("/payments", h.TopUpHandler.Webhook)
+	}
+
 	// Private routes for authenticated users
 	privateApi := h.App.Group("")
 	privateApi.Use(h.AuthMiddleware.JWTAuthentication)
+	// replays the writes retried with an Idempotency-Key, reads have nothing to replay
+	idempotent := h.IdempotencyMiddleware.Idempotency
 	{
 		// Product Routes
 		productApi := privateApi.Group("/products")
@@ -47,18 +75,206 @@ func (h *Router) Setup() {
 			walletApi.GET("", h.WalletHandler.Find)
 			walletApi.GET("/:id", h.WalletHandler.Detail)
 			walletApi.GET("/transaction/:id", h.WalletHandler.DetailWalletTransaction)
-			walletApi.DELETE("/:id", h.WalletHandler.Delete)
+			walletApi.GET("/:id/statement", h.WalletHandler.ExportStatement)
+			walletApi.DELETE("/:id", h.WalletHandler.Close)
+			walletApi.PUT("/:id/default", h.WalletHandler.SetDefault)
+
+			// Lifecycle of a wallet, only admins freeze, unfreeze and set its tier
+			walletApi.GET("/:id/status-history", h.WalletHandler.FindStatusChanges)
+			walletApi.POST("/:id/freeze", h.AuthMiddleware.AdminAuthorization, h.WalletHandler.Freeze)
+			walletApi.POST("/:id/suspend", h.AuthMiddleware.AdminAuthorization, h.WalletHandler.Suspend)
+			walletApi.POST("/:id/unfreeze", h.AuthMiddleware.AdminAuthorization, h.WalletHandler.Unfreeze)
+			walletApi.PUT("/:id/tier", h.AuthMiddleware.AdminAuthorization, h.WalletHandler.SetTier)
+
+			// Members sharing a wallet
+			walletApi.POST("/:id/members", h.WalletHandler.AddMember)
+			walletApi.GET("/:id/members", h.WalletHandler.FindMembers)
+			walletApi.PUT("/:id/members/:member_id", h.WalletHandler.UpdateMember)
+			walletApi.DELETE("/:id/members/:member_id", h.WalletHandler.RemoveMember)
+
+			// Monthly statements of a wallet
+			walletApi.POST("/:id/statements", h.StatementHandler.Generate)
+			walletApi.GET("/:id/statements", h.StatementHandler.Find)
+			walletApi.GET("/:id/statements/:statement_id", h.StatementHandler.Detail)
+			walletApi.POST("/:id/statements/:statement_id/regenerate", h.StatementHandler.Regenerate)
+
+			// Spending limits of a wallet
+			walletApi.GET("/:id/limits", h.SpendingLimitHandler.Detail)
+			walletApi.PUT("/:id/limits", h.SpendingLimitHandler.Update)
+
+			// Standing orders of a wallet
+			walletApi.POST("/:id/schedules", h.StandingOrderHandler.Create)
+			walletApi.GET("/:id/schedules", h.StandingOrderHandler.Find)
+			walletApi.GET("/:id/schedules/:schedule_id", h.StandingOrderHandler.Detail)
+			walletApi.GET("/:id/schedules/:schedule_id/runs", h.StandingOrderHandler.FindRuns)
+			walletApi.POST("/:id/schedules/:schedule_id/pause", h.StandingOrderHandler.Pause)
+			walletApi.POST("/:id/schedules/:schedule_id/resume", h.StandingOrderHandler.Resume)
+			walletApi.DELETE("/:id/schedules/:schedule_id", h.StandingOrderHandler.Cancel)
+
+			// Monthly budgets of a wallet
+			walletApi.POST("/:id/budgets", h.BudgetHandler.Create)
+			walletApi.GET("/:id/budgets", h.BudgetHandler.Find)
+			walletApi.GET("/:id/budgets/status", h.BudgetHandler.Status)
+			walletApi.PUT("/:id/budgets/:budget_id", h.BudgetHandler.Update)
+			walletApi.DELETE("/:id/budgets/:budget_id", h.BudgetHandler.Delete)
+
+			// Rules filing and tagging the transactions of a wallet
+			walletApi.POST("/:id/rules", h.CategoryRuleHandler.Create)
+			walletApi.GET("/:id/rules", h.CategoryRuleHandler.Find)
+			walletApi.POST("/:id/rules/apply", h.CategoryRuleHandler.Apply)
+			walletApi.PUT("/:id/rules/:rule_id", h.CategoryRuleHandler.Update)
+			walletApi.DELETE("/:id/rules/:rule_id", h.CategoryRuleHandler.Delete)
 		}
 
 		// Transaction Routes
 		transactionApi := privateApi.Group("/transactions")
 		{
-			transactionApi.POST("", h.TransactionHandler.Create)
+			transactionApi.POST("", idempotent, h.TransactionHandler.Create)
 			transactionApi.GET("/:id", h.TransactionHandler.Detail)
 			transactionApi.GET("", h.TransactionHandler.Find)
-			transactionApi.POST("/credit", h.TransactionHandler.Credit)
-			transactionApi.POST("/transfer", h.TransactionHandler.Transfer)
-			transactionApi.DELETE("/:id", h.TransactionHandler.Delete)
+			transactionApi.POST("/credit", idempotent, h.TransactionHandler.Credit)
+			transactionApi.POST("/transfer", idempotent, h.TransactionHandler.Transfer)
+			transactionApi.POST("/transfer/preview", h.TransactionHandler.PreviewTransfer)
+			transactionApi.POST("/:id/reverse", idempotent, h.TransactionHandler.Reverse)
+			transactionApi.POST("/:id/refund", idempotent, h.TransactionHandler.Refund)
+			transactionApi.DELETE("/:id", idempotent, h.TransactionHandler.Reverse)
+			transactionApi.PUT("/:id/category", idempotent, h.TransactionHandler.Categorize)
+		}
+
+		// Payment Request Routes, money asked of one user by another
+		paymentRequestApi := privateApi.Group("/payment-requests")
+		{
+			paymentRequestApi.POST("", idempotent, h.PaymentRequestHandler.Create)
+			paymentRequestApi.GET("", h.PaymentRequestHandler.Find)
+			paymentRequestApi.GET("/:id", h.PaymentRequestHandler.Detail)
+			paymentRequestApi.GET("/:id/status-history", h.PaymentRequestHandler.FindStatusChanges)
+			paymentRequestApi.POST("/:id/pay", idempotent, h.PaymentRequestHandler.Pay)
+			paymentRequestApi.POST("/:id/decline", idempotent, h.PaymentRequestHandler.Decline)
+			paymentRequestApi.POST("/:id/cancel", idempotent, h.PaymentRequestHandler.Cancel)
+		}
+
+		// Bill Split Routes, shares are settled through payment requests
+		billSplitApi := privateApi.Group("/bill-splits")
+		{
+			billSplitApi.POST("", idempotent, h.BillSplitHandler.Create)
+			billSplitApi.GET("", h.BillSplitHandler.Find)
+			billSplitApi.GET("/:id", h.BillSplitHandler.Detail)
+		}
+
+		// Top Up Routes, settled by the payment provider's webhook
+		topUpApi := privateApi.Group("/top-ups")
+		{
+			topUpApi.POST("", idempotent, h.TopUpHandler.Create)
+			topUpApi.GET("", h.TopUpHandler.Find)
+			topUpApi.GET("/:id", h.TopUpHandler.Detail)
+		}
+
+		// Payout Destination Routes, the bank accounts payouts are sent to
+		payoutDestinationApi := privateApi.Group("/payout-destinations")
+		{
+			payoutDestinationApi.POST("", idempotent, h.PayoutDestinationHandler.Create)
+			payoutDestinationApi.GET("", h.PayoutDestinationHandler.Find)
+			payoutDestinationApi.GET("/:id", h.PayoutDestinationHandler.Detail)
+			payoutDestinationApi.POST("/:id/verify", idempotent, h.PayoutDestinationHandler.Verify)
+			payoutDestinationApi.DELETE("/:id", idempotent, h.PayoutDestinationHandler.Delete)
+		}
+
+		// Payout Routes, settled by polling the payout provider
+		payoutApi := privateApi.Group("/payouts")
+		{
+			payoutApi.POST("", idempotent, h.PayoutHandler.Create)
+			payoutApi.GET("", h.PayoutHandler.Find)
+			payoutApi.GET("/:id", h.PayoutHandler.Detail)
+		}
+
+		// Category Routes, system categories are shared and read only
+		categoryApi := privateApi.Group("/categories")
+		{
+			categoryApi.POST("", h.CategoryHandler.Create)
+			categoryApi.GET("", h.CategoryHandler.Find)
+			categoryApi.GET("/:id", h.CategoryHandler.Detail)
+			categoryApi.PUT("/:id", h.CategoryHandler.Update)
+			categoryApi.DELETE("/:id", h.CategoryHandler.Delete)
+		}
+
+		// Hold Routes
+		holdApi := privateApi.Group("/holds")
+		{
+			holdApi.POST("", idempotent, h.HoldHandler.Authorize)
+			holdApi.GET("", h.HoldHandler.Find)
+			holdApi.GET("/:id", h.HoldHandler.Detail)
+			holdApi.POST("/:id/capture", idempotent, h.HoldHandler.Capture)
+			holdApi.POST("/:id/void", idempotent, h.HoldHandler.Void)
+		}
+
+		// Ledger Routes, admin only as the ledger spans every wallet and the system accounts
+		ledgerApi := privateApi.Group("/ledger")
+		ledgerApi.Use(h.AuthMiddleware.AdminAuthorization)
+		{
+			ledgerApi.GET("/accounts", h.LedgerHandler.FindAccounts)
+			ledgerApi.GET("/entries", h.LedgerHandler.FindEntries)
+			ledgerApi.GET("/entries/:id", h.LedgerHandler.DetailEntry)
+		}
+
+		// Exchange Rate Routes, only admins manage rates
+		exchangeRateApi := privateApi.Group("/exchange-rates")
+		{
+			exchangeRateApi.GET("", h.ExchangeRateHandler.Find)
+			exchangeRateApi.GET("/:id", h.ExchangeRateHandler.Detail)
+			exchangeRateApi.POST("", h.AuthMiddleware.AdminAuthorization, h.ExchangeRateHandler.Create)
+			exchangeRateApi.PUT("/:id", h.AuthMiddleware.AdminAuthorization, h.ExchangeRateHandler.Update)
+			exchangeRateApi.DELETE("/:id", h.AuthMiddleware.AdminAuthorization, h.ExchangeRateHandler.Delete)
+		}
+
+		// Fee Rule Routes, only admins manage fees
+		feeRuleApi := privateApi.Group("/fee-rules")
+		{
+			feeRuleApi.GET("", h.FeeHandler.Find)
+			feeRuleApi.GET("/:id", h.FeeHandler.Detail)
+			feeRuleApi.POST("", h.AuthMiddleware.AdminAuthorization, h.FeeHandler.Create)
+			feeRuleApi.PUT("/:id", h.AuthMiddleware.AdminAuthorization, h.FeeHandler.Update)
+			feeRuleApi.DELETE("/:id", h.AuthMiddleware.AdminAuthorization, h.FeeHandler.Delete)
+		}
+
+		// Fee Routes, what an operation would cost before it is confirmed
+		feeApi := privateApi.Group("/fees")
+		{
+			feeApi.POST("/quote", h.FeeHandler.Quote)
+		}
+
+		// Campaign Routes, only admins run promotions
+		campaignApi := privateApi.Group("/campaigns")
+		{
+			campaignApi.GET("", h.CampaignHandler.Find)
+			campaignApi.GET("/:id", h.CampaignHandler.Detail)
+			campaignApi.POST("", h.AuthMiddleware.AdminAuthorization, h.CampaignHandler.Create)
+			campaignApi.PUT("/:id", h.AuthMiddleware.AdminAuthorization, h.CampaignHandler.Update)
+		}
+
+		// Reward Routes, what campaigns granted the user
+		rewardApi := privateApi.Group("/rewards")
+		{
+			rewardApi.GET("", h.RewardHandler.Find)
+			rewardApi.GET("/:id", h.RewardHandler.Detail)
+		}
+
+		// Analytics Routes, aggregates over the wallets the user may view
+		analyticsApi := privateApi.Group("/analytics")
+		{
+			analyticsApi.GET("/cash-flow", h.AnalyticsHandler.CashFlow)
+			analyticsApi.GET("/products", h.AnalyticsHandler.TopProducts)
+			analyticsApi.GET("/categories", h.AnalyticsHandler.SpendingByCategory)
+			analyticsApi.GET("/wallets", h.AnalyticsHandler.WalletCashFlow)
+		}
+
+		// Reconciliation Routes, admin only
+		reconciliationApi := privateApi.Group("/reconciliation")
+		reconciliationApi.Use(h.AuthMiddleware.AdminAuthorization)
+		{
+			reconciliationApi.POST("/runs", h.ReconciliationHandler.Run)
+			reconciliationApi.GET("/findings", h.ReconciliationHandler.Find)
+			reconciliationApi.GET("/findings/:id", h.ReconciliationHandler.Detail)
+			reconciliationApi.POST("/findings/:id/correct", h.ReconciliationHandler.Correct)
 		}
 	}
 }
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type SpendingLimitHTTPHandler struct {
+	Handler
+	SpendingLimitService service.SpendingLimitService
+}
+
+func NewSpendingLimitHTTPHandler(spendingLimitService service.SpendingLimitService) *SpendingLimitHTTPHandler {
+	return &SpendingLimitHTTPHandler{
+		SpendingLimitService: spendingLimitService,
+	}
+}
+
+// Detail godoc
+// @Summary Get the spending limits of a wallet
+// @Description Retrieves the per transaction, daily, weekly and monthly limits of a wallet with the allowance left over their rolling windows
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Success 200 {object} response.DataResponse{data=model.GetSpendingLimitRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/limits [get]
+func (h *SpendingLimitHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetSpendingLimitReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.SpendingLimitService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Update godoc
+// @Summary Update the spending limits of a wallet
+// @Description Replaces the limits of a wallet, in the wallet's currency, a limit left out falls back to the configured default.
+// @Description Only the owners of the wallet can change its limits
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param limits body model.UpdateSpendingLimitReq true "Update Spending Limit Request"
+// @Success 200 {object} response.DataResponse{data=model.UpdateSpendingLimitRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/limits [put]
+func (h *SpendingLimitHTTPHandler) Update(ctx *gin.Context) {
+	var request model.UpdateSpendingLimitReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.WalletId = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.SpendingLimitService.Update(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,225 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type StandingOrderHTTPHandler struct {
+	Handler
+	StandingOrderService service.StandingOrderService
+}
+
+func NewStandingOrderHTTPHandler(standingOrderService service.StandingOrderService) *StandingOrderHTTPHandler {
+	return &StandingOrderHTTPHandler{
+		StandingOrderService: standingOrderService,
+	}
+}
+
+// Create godoc
+// @Summary Create a standing order
+// @Description Schedules a recurring transfer out of the wallet, runs failing for lack of balance are retried, owners only
+// @Tags Standing Orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param schedule body model.CreateStandingOrderReq true "Create Standing Order Request"
+// @Success 200 {object} response.DataResponse{data=model.CreateStandingOrderRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/schedules [post]
+func (h *StandingOrderHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreateStandingOrderReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.WalletId = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.StandingOrderService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get all standing orders of a wallet
+// @Description Retrieves the standing orders of a wallet with optional filters, pagination, and sorting
+// @Tags Standing Orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllStandingOrderRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/schedules [get]
+func (h *StandingOrderHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllStandingOrderReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		Page:     page,
+		Filter:   filter,
+		Sort:     sort,
+	}
+	response, errException := h.StandingOrderService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get standing order details
+// @Description Retrieves a standing order of the wallet by ID
+// @Tags Standing Orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param schedule_id path string true "Standing Order ID"
+// @Success 200 {object} response.DataResponse{data=model.GetStandingOrderByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/schedules/{schedule_id} [get]
+func (h *StandingOrderHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetStandingOrderByIDReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		ID:       ctx.Param("schedule_id"),
+	}
+	response, errException := h.StandingOrderService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// FindRuns godoc
+// @Summary Get the runs of a standing order
+// @Description Retrieves the execution history of a standing order, failed runs carry the error
+// @Tags Standing Orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param schedule_id path string true "Standing Order ID"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllStandingOrderRunRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/schedules/{schedule_id}/runs [get]
+func (h *StandingOrderHTTPHandler) FindRuns(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllStandingOrderRunReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		ID:       ctx.Param("schedule_id"),
+		Page:     page,
+		Filter:   filter,
+		Sort:     sort,
+	}
+	response, errException := h.StandingOrderService.FindRuns(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Pause godoc
+// @Summary Pause a standing order
+// @Description Stops the runs of a standing order until it is resumed, owners only
+// @Tags Standing Orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param schedule_id path string true "Standing Order ID"
+// @Success 200 {object} response.DataResponse{data=model.PauseStandingOrderRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/schedules/{schedule_id}/pause [post]
+func (h *StandingOrderHTTPHandler) Pause(ctx *gin.Context) {
+	request := model.PauseStandingOrderReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		ID:       ctx.Param("schedule_id"),
+	}
+	response, errException := h.StandingOrderService.Pause(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Resume godoc
+// @Summary Resume a standing order
+// @Description Reactivates a paused standing order from its next scheduled run, the runs missed while paused are skipped, owners only
+// @Tags Standing Orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param schedule_id path string true "Standing Order ID"
+// @Success 200 {object} response.DataResponse{data=model.ResumeStandingOrderRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/schedules/{schedule_id}/resume [post]
+func (h *StandingOrderHTTPHandler) Resume(ctx *gin.Context) {
+	request := model.ResumeStandingOrderReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		ID:       ctx.Param("schedule_id"),
+	}
+	response, errException := h.StandingOrderService.Resume(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Cancel godoc
+// @Summary Cancel a standing order
+// @Description Stops a standing order for good, its run history is kept, owners only
+// @Tags Standing Orders
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param schedule_id path string true "Standing Order ID"
+// @Success 200 {object} response.DataResponse{data=model.CancelStandingOrderRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/schedules/{schedule_id} [delete]
+func (h *StandingOrderHTTPHandler) Cancel(ctx *gin.Context) {
+	request := model.CancelStandingOrderReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		ID:       ctx.Param("schedule_id"),
+	}
+	response, errException := h.StandingOrderService.Cancel(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -0,0 +1,135 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type StatementHTTPHandler struct {
+	Handler
+	StatementService service.StatementService
+}
+
+func NewStatementHTTPHandler(statementService service.StatementService) *StatementHTTPHandler {
+	return &StatementHTTPHandler{
+		StatementService: statementService,
+	}
+}
+
+// Generate godoc
+// @Summary Generate a monthly statement
+// @Description Builds the statement of a closed month, a statement already generated for the month is returned unchanged
+// @Tags Statements
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param statement body model.GenerateStatementReq true "Generate Statement Request"
+// @Success 200 {object} response.DataResponse{data=model.GenerateStatementRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/statements [post]
+func (h *StatementHTTPHandler) Generate(ctx *gin.Context) {
+	var request model.GenerateStatementReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.WalletId = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.StatementService.Generate(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get all statements of a wallet
+// @Description Retrieves the monthly statements of a wallet without their lines, with optional filters, pagination, and sorting
+// @Tags Statements
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllStatementRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/statements [get]
+func (h *StatementHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllStatementReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		Page:     page,
+		Filter:   filter,
+		Sort:     sort,
+	}
+	response, errException := h.StatementService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get statement details
+// @Description Retrieves a statement of the wallet with its itemized transactions
+// @Tags Statements
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param statement_id path string true "Statement ID"
+// @Success 200 {object} response.DataResponse{data=model.GetStatementByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/statements/{statement_id} [get]
+func (h *StatementHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetStatementByIDReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		ID:       ctx.Param("statement_id"),
+	}
+	response, errException := h.StatementService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Regenerate godoc
+// @Summary Regenerate a statement
+// @Description Rebuilds a statement from the current transactions of its month and bumps its version, owners of the wallet only
+// @Tags Statements
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param statement_id path string true "Statement ID"
+// @Success 200 {object} response.DataResponse{data=model.RegenerateStatementRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/statements/{statement_id}/regenerate [post]
+func (h *StatementHTTPHandler) Regenerate(ctx *gin.Context) {
+	request := model.RegenerateStatementReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		ID:       ctx.Param("statement_id"),
+	}
+	response, errException := h.StatementService.Regenerate(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
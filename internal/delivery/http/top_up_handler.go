@@ -0,0 +1,142 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	_ "product-wallet/internal/delivery/http/response"
+	_ "product-wallet/internal/gateway/externalapi"
+	"product-wallet/internal/model"
+	service "product-wallet/internal/services"
+)
+
+type TopUpHTTPHandler struct {
+	Handler
+	TopUpService service.TopUpService
+}
+
+func NewTopUpHTTPHandler(topUpService service.TopUpService) *TopUpHTTPHandler {
+	return &TopUpHTTPHandler{
+		TopUpService: topUpService,
+	}
+}
+
+// Create godoc
+// @Summary Top up a wallet
+// @Description Starts a pending top up and opens a checkout for it at the payment provider. Pay at the returned
+// @Description checkout URL, the wallet is credited once the provider confirms the payment
+// @Tags Top Ups
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param topUp body model.CreateTopUpReq true "Create Top Up Request"
+// @Success 200 {object} response.DataResponse{data=model.CreateTopUpRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Failure 500 {object} response.DataResponse "the provider could not open a checkout"
+// @Router /top-ups [post]
+func (h *TopUpHTTPHandler) Create(ctx *gin.Context) {
+	var request model.CreateTopUpReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.TopUpService.Create(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Find godoc
+// @Summary Get your top ups
+// @Description Retrieves the top ups you started, newest first by default
+// @Tags Top Ups
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllTopUpRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /top-ups [get]
+func (h *TopUpHTTPHandler) Find(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllTopUpReq{
+		UserId: h.ParseGetKey(ctx, "user_id"),
+		Page:   page,
+		Filter: filter,
+		Sort:   sort,
+	}
+	response, errException := h.TopUpService.Find(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Detail godoc
+// @Summary Get a top up
+// @Description Retrieves a top up you started with its status
+// @Tags Top Ups
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Top Up ID"
+// @Success 200 {object} response.DataResponse{data=model.GetTopUpByIDRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "error"
+// @Router /top-ups/{id} [get]
+func (h *TopUpHTTPHandler) Detail(ctx *gin.Context) {
+	request := model.GetTopUpByIDReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.TopUpService.Detail(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Webhook godoc
+// @Summary Payment provider webhook
+// @Description Called by the payment provider with the outcome of a checkout, signed with the shared secret.
+// @Description Redelivered events are acknowledged without effect
+// @Tags Top Ups
+// @Accept json
+// @Produce json
+// @Param X-Webhook-Signature header string true "Hex encoded HMAC-SHA256 of the body with the webhook secret"
+// @Param event body externalapi.WebhookEvent true "Webhook Event"
+// @Success 200 {object} response.DataResponse{data=model.TopUpWebhookRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 401 {object} response.DataResponse "invalid signature"
+// @Failure 404 {object} response.DataResponse "error"
+// @Router /webhooks/payments [post]
+func (h *TopUpHTTPHandler) Webhook(ctx *gin.Context) {
+	payload, err := ctx.GetRawData()
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.TopUpWebhookReq{
+		Payload:   payload,
+		Signature: ctx.GetHeader("X-Webhook-Signature"),
+	}
+	response, errException := h.TopUpService.HandleWebhook(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
@@ -25,9 +25,11 @@ func NewTransactionHTTPHandler(transactionService service.TransactionService) *T
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
 // @Param transaction body model.CreateTransactionReq true "Create Transaction Request"
 // @Success 200 {object} response.DataResponse{data=model.CreateTransactionRes} "success"
 // @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
 // @Router /transactions [post]
 func (h *TransactionHTTPHandler) Create(ctx *gin.Context) {
 	var request model.CreateTransactionReq
@@ -35,6 +37,7 @@ func (h *TransactionHTTPHandler) Create(ctx *gin.Context) {
 		h.BadRequestJSON(ctx, err.Error())
 		return
 	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
 	response, errException := h.TransactionService.Create(ctx, &request)
 	if errException != nil {
 		h.ExceptionJSON(ctx, errException)
@@ -50,14 +53,17 @@ func (h *TransactionHTTPHandler) Create(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
 // @Param id path string true "Transaction ID"
 // @Success 200 {object} response.DataResponse{data=model.GetTransactionByIDRes} "success"
 // @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
 // @Router /transactions/{id} [get]
 func (h *TransactionHTTPHandler) Detail(ctx *gin.Context) {
 	id := ctx.Param("id")
 	request := model.GetTransactionByIDReq{
-		ID: id,
+		ID:     id,
+		UserId: h.ParseGetKey(ctx, "user_id"),
 	}
 	response, errException := h.TransactionService.Detail(ctx, &request)
 	if errException != nil {
@@ -74,12 +80,14 @@ func (h *TransactionHTTPHandler) Detail(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
 // @Param pageSize query string false "Number of items per page"
 // @Param page query string false "Page number"
 // @Param filter query string false "Filter rules<br><br>### Rules Filter<br>rule:<br>  * {Name of Field}:{value}:{Symbol}<br><br>Symbols:<br>  * eq (=)<br>  * lt (<)<br>  * gt (>)<br>  * lte (<=)<br>  * gte (>=)<br>  * in (in)<br>  * like (like)"
 // @Param sort query string false "Sort rules:<br><br>### Rules Sort<br>rule:<br>  * {Name of Field}:{Symbol}<br><br>Symbols:<br>  * asc<br>  * desc<br><br>"
 // @Success 200 {object} response.DataResponse{data=model.GetAllTransactionRes} "success"
 // @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
 // @Router /transactions [get]
 func (h *TransactionHTTPHandler) Find(ctx *gin.Context) {
 	// Parse pagination, sorting, and filter parameters
@@ -91,6 +99,7 @@ func (h *TransactionHTTPHandler) Find(ctx *gin.Context) {
 
 	// Construct the request object
 	request := model.GetAllTransactionReq{
+		UserId: h.ParseGetKey(ctx, "user_id"),
 		Page:   page,
 		Filter: filter,
 		Sort:   sort,
@@ -114,9 +123,11 @@ func (h *TransactionHTTPHandler) Find(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
 // @Param credit body model.CreditTransactionReq true "Credit Transaction Request"
 // @Success 200 {object} response.DataResponse{data=model.CreditTransactionRes} "success"
 // @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
 // @Router /transactions/credit [post]
 func (h *TransactionHTTPHandler) Credit(ctx *gin.Context) {
 	var request model.CreditTransactionReq
@@ -124,6 +135,7 @@ func (h *TransactionHTTPHandler) Credit(ctx *gin.Context) {
 		h.BadRequestJSON(ctx, err.Error())
 		return
 	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
 	response, errException := h.TransactionService.Credit(ctx, &request)
 	if errException != nil {
 		h.ExceptionJSON(ctx, errException)
@@ -134,14 +146,17 @@ func (h *TransactionHTTPHandler) Credit(ctx *gin.Context) {
 
 // Transfer godoc
 // @Summary Transfer transaction
-// @Description Transfers an amount from one wallet to another
+// @Description Transfers an amount from one wallet to another, the receiver given by wallet id or by username.
+// @Description A transfer to a username goes to their wallet named receiver_wallet, else to their default wallet
 // @Tags Transactions
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
 // @Param transfer body model.TransferTransactionReq true "Transfer Transaction Request"
 // @Success 200 {object} response.DataResponse{data=model.TransferTransactionRes} "success"
 // @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
 // @Router /transactions/transfer [post]
 func (h *TransactionHTTPHandler) Transfer(ctx *gin.Context) {
 	var request model.TransferTransactionReq
@@ -149,6 +164,7 @@ func (h *TransactionHTTPHandler) Transfer(ctx *gin.Context) {
 		h.BadRequestJSON(ctx, err.Error())
 		return
 	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
 	response, errException := h.TransactionService.Transfer(ctx, &request)
 	if errException != nil {
 		h.ExceptionJSON(ctx, errException)
@@ -157,23 +173,114 @@ func (h *TransactionHTTPHandler) Transfer(ctx *gin.Context) {
 	h.DataJSON(ctx, response)
 }
 
-// Delete godoc
-// @Summary Delete a transaction
-// @Description Deletes a transaction by ID
+// PreviewTransfer godoc
+// @Summary Preview a transfer
+// @Description Resolves the wallet a transfer would pay into and converts its amount without booking anything,
+// @Description showing the masked username of the receiver for the payer to confirm before transferring to receiver_id
 // @Tags Transactions
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param transfer body model.TransferTransactionReq true "Transfer Transaction Request"
+// @Success 200 {object} response.DataResponse{data=model.PreviewTransferRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 404 {object} response.DataResponse "receiver not found"
+// @Router /transactions/transfer/preview [post]
+func (h *TransactionHTTPHandler) PreviewTransfer(ctx *gin.Context) {
+	var request model.TransferTransactionReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.TransactionService.PreviewTransfer(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Reverse godoc
+// @Summary Reverse a transaction
+// @Description Reverses what is left of a transaction with compensating transactions linked to it, restoring the wallet balances and the product stock of a purchase. The original is kept and marked as reversed. A transfer is reversed by the owner of the receiving wallet, a payment request it paid goes back to pending.
+// @Tags Transactions
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
 // @Param id path string true "Transaction ID"
-// @Success 200 {object} response.DataResponse{data=model.DeleteTransactionRes} "success"
+// @Success 200 {object} response.DataResponse{data=model.ReverseTransactionRes} "success"
 // @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
 // @Router /transactions/{id} [delete]
-func (h *TransactionHTTPHandler) Delete(ctx *gin.Context) {
+// @Router /transactions/{id}/reverse [post]
+func (h *TransactionHTTPHandler) Reverse(ctx *gin.Context) {
 	id := ctx.Param("id")
-	request := model.DeleteTransactionReq{
-		ID: id,
+	request := model.ReverseTransactionReq{
+		ID:     id,
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.TransactionService.Reverse(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Refund godoc
+// @Summary Refund part of a purchase
+// @Description Refunds a number of the items bought, which go back in stock, or an amount in the wallet currency
+// @Tags Transactions
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param Idempotency-Key header string false "Replays the stored response when the request is retried with the same key"
+// @Param id path string true "Transaction ID"
+// @Param refund body model.RefundTransactionReq true "Refund Transaction Request"
+// @Success 200 {object} response.DataResponse{data=model.RefundTransactionRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 409 {object} response.DataResponse "key reused for a different request"
+// @Router /transactions/{id}/refund [post]
+func (h *TransactionHTTPHandler) Refund(ctx *gin.Context) {
+	var request model.RefundTransactionReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.ID = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.TransactionService.Refund(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Categorize godoc
+// @Summary Categorize a transaction
+// @Description Files a transaction under a system category or a custom category of the user, members who may spend from its wallet only
+// @Tags Transactions
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Transaction ID"
+// @Param category body model.CategorizeTransactionReq true "Categorize Transaction Request"
+// @Success 200 {object} response.DataResponse{data=model.CategorizeTransactionRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /transactions/{id}/category [put]
+func (h *TransactionHTTPHandler) Categorize(ctx *gin.Context) {
+	var request model.CategorizeTransactionReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
 	}
-	response, errException := h.TransactionService.Delete(ctx, &request)
+	request.ID = ctx.Param("id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.TransactionService.Categorize(ctx, &request)
 	if errException != nil {
 		h.ExceptionJSON(ctx, errException)
 		return
@@ -1,10 +1,15 @@
 package http
 
 import (
+	"context"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"log/slog"
 	_ "product-wallet/internal/delivery/http/response"
 	"product-wallet/internal/model"
 	service "product-wallet/internal/services"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/statement"
 )
 
 type WalletHTTPHandler struct {
@@ -96,6 +101,7 @@ func (h WalletHTTPHandler) Find(ctx *gin.Context) {
 		return
 	}
 	request := model.GetAllWalletReq{
+		UserId: h.ParseGetKey(ctx, "user_id"),
 		Page:   page,
 		Filter: filter,
 		Sort:   sort,
@@ -122,7 +128,8 @@ func (h WalletHTTPHandler) Find(ctx *gin.Context) {
 func (h WalletHTTPHandler) Detail(ctx *gin.Context) {
 	id := ctx.Param("id")
 	request := model.GetWalletByIDReq{
-		ID: id,
+		ID:     id,
+		UserId: h.ParseGetKey(ctx, "user_id"),
 	}
 	response, errException := h.WalletService.Detail(ctx, &request)
 	if errException != nil {
@@ -158,9 +165,10 @@ func (h WalletHTTPHandler) DetailWalletTransaction(ctx *gin.Context) {
 
 	// Create the request for the service
 	request := model.GetWalletByTransactionReq{
-		ID:   id,
-		From: fromDate,
-		To:   toDate,
+		ID:     id,
+		UserId: h.ParseGetKey(ctx, "user_id"),
+		From:   fromDate,
+		To:     toDate,
 	}
 
 	// Call the service method
@@ -174,23 +182,364 @@ func (h WalletHTTPHandler) DetailWalletTransaction(ctx *gin.Context) {
 	h.DataJSON(ctx, response)
 }
 
-// Delete godoc
-// @Summary Delete a wallet
-// @Description Deletes a wallet by ID
+// ExportStatement godoc
+// @Summary Export a wallet statement
+// @Description Streams the transactions of a wallet within a date range as CSV, OFX or QIF, CSV rows carry the running balance.
+// @Description The format is taken from the format query parameter, then from the Accept header, and defaults to CSV
+// @Tags Wallets
+// @Produce text/csv
+// @Produce application/x-ofx
+// @Produce application/qif
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param from query string false "Start date for transactions in YYYY-MM-DD format"
+// @Param to query string false "End date for transactions in YYYY-MM-DD format"
+// @Param format query string false "Statement format" Enums(csv, ofx, qif)
+// @Success 200 {file} file "statement"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/statement [get]
+func (h WalletHTTPHandler) ExportStatement(ctx *gin.Context) {
+	fromDate, toDate, err := h.ParseDateParam(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, "Invalid date format. Use YYYY-MM-DD.")
+		return
+	}
+	format := statement.CSV
+	if query := ctx.Query("format"); query != "" {
+		format, err = statement.ParseFormat(query)
+		if err != nil {
+			h.BadRequestJSON(ctx, err.Error())
+			return
+		}
+	} else if accepted, ok := statement.FormatFromAccept(ctx.GetHeader("Accept")); ok {
+		format = accepted
+	}
+	request := model.ExportStatementReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+		From:   fromDate,
+		To:     toDate,
+		Format: format,
+	}
+
+	ctx.Header("Content-Type", format.ContentType())
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="statement-%s.%s"`, request.ID, format))
+	if errException := h.WalletService.ExportStatement(ctx, &request, ctx.Writer); errException != nil {
+		if ctx.Writer.Written() {
+			// the statement is already on its way, all that is left is to cut it short
+			slog.Error("failed to export statement", "wallet_id", request.ID, "error", errException.Error)
+			ctx.Abort()
+			return
+		}
+		ctx.Writer.Header().Del("Content-Type")
+		ctx.Writer.Header().Del("Content-Disposition")
+		h.ExceptionJSON(ctx, errException)
+	}
+}
+
+// Close godoc
+// @Summary Close a wallet
+// @Description Closes a wallet of the user for good, a wallet holding money has its balance swept to another wallet of the user first.
+// @Description Authorized holds must be settled before and the standing orders of the wallet are cancelled
 // @Tags Wallets
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
 // @Param id path string true "Wallet ID"
-// @Success 200 {object} response.DataResponse{data=model.DeleteWalletRes} "success"
+// @Param sweep_to query string false "Wallet ID receiving the remaining balance"
+// @Success 200 {object} response.DataResponse{data=model.CloseWalletRes} "success"
 // @Failure 400 {object} response.DataResponse "error"
 // @Router /wallets/{id} [delete]
-func (h WalletHTTPHandler) Delete(ctx *gin.Context) {
-	id := ctx.Param("id")
-	request := model.DeleteWalletReq{
-		ID: id,
+func (h WalletHTTPHandler) Close(ctx *gin.Context) {
+	request := model.CloseWalletReq{
+		ID:      ctx.Param("id"),
+		UserId:  h.ParseGetKey(ctx, "user_id"),
+		SweepTo: ctx.Query("sweep_to"),
+	}
+	response, errException := h.WalletService.Close(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// SetDefault godoc
+// @Summary Set the default wallet
+// @Description Makes a wallet the user opened the one transfers to their username go to when no wallet is named
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Success 200 {object} response.DataResponse{data=model.SetDefaultWalletRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/default [put]
+func (h WalletHTTPHandler) SetDefault(ctx *gin.Context) {
+	request := model.SetDefaultWalletReq{
+		ID:     ctx.Param("id"),
+		UserId: h.ParseGetKey(ctx, "user_id"),
+	}
+	response, errException := h.WalletService.SetDefault(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// Freeze godoc
+// @Summary Freeze a wallet
+// @Description Blocks the debits of an active wallet, credits are still accepted, admin only
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param status body model.ChangeWalletStatusReq true "Change Wallet Status Request"
+// @Success 200 {object} response.DataResponse{data=model.ChangeWalletStatusRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/freeze [post]
+func (h WalletHTTPHandler) Freeze(ctx *gin.Context) {
+	h.changeStatus(ctx, h.WalletService.Freeze)
+}
+
+// Suspend godoc
+// @Summary Suspend a wallet
+// @Description Blocks all movement of an active or frozen wallet, admin only
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param status body model.ChangeWalletStatusReq true "Change Wallet Status Request"
+// @Success 200 {object} response.DataResponse{data=model.ChangeWalletStatusRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/suspend [post]
+func (h WalletHTTPHandler) Suspend(ctx *gin.Context) {
+	h.changeStatus(ctx, h.WalletService.Suspend)
+}
+
+// Unfreeze godoc
+// @Summary Unfreeze a wallet
+// @Description Makes a frozen or suspended wallet active again, admin only
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param status body model.ChangeWalletStatusReq true "Change Wallet Status Request"
+// @Success 200 {object} response.DataResponse{data=model.ChangeWalletStatusRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/unfreeze [post]
+func (h WalletHTTPHandler) Unfreeze(ctx *gin.Context) {
+	h.changeStatus(ctx, h.WalletService.Unfreeze)
+}
+
+func (h WalletHTTPHandler) changeStatus(
+	ctx *gin.Context,
+	change func(context.Context, *model.ChangeWalletStatusReq) (*model.ChangeWalletStatusRes, *exception.Exception),
+) {
+	var request model.ChangeWalletStatusReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.ID = ctx.Param("id")
+	request.ChangedBy = h.ParseGetKey(ctx, "user_id")
+	response, errException := change(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// SetTier godoc
+// @Summary Set the tier of a wallet
+// @Description Moves a wallet to another tier, which changes the fees it pays, admin only
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param tier body model.SetWalletTierReq true "Set Wallet Tier Request"
+// @Success 200 {object} response.DataResponse{data=model.SetWalletTierRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/tier [put]
+func (h WalletHTTPHandler) SetTier(ctx *gin.Context) {
+	var request model.SetWalletTierReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.ID = ctx.Param("id")
+	response, errException := h.WalletService.SetTier(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// FindStatusChanges godoc
+// @Summary Get the status history of a wallet
+// @Description Retrieves who changed the status of a wallet, when and why, with optional filters, pagination, and sorting
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllWalletStatusChangeRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/status-history [get]
+func (h WalletHTTPHandler) FindStatusChanges(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllWalletStatusChangeReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		Page:     page,
+		Filter:   filter,
+		Sort:     sort,
+	}
+	response, errException := h.WalletService.FindStatusChanges(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// AddMember godoc
+// @Summary Share a wallet with another user
+// @Description Adds the user with the given username as owner, spender or viewer of the wallet, owners only.
+// @Description A spender may be given an allowance, what they may spend over 30 days
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param member body model.AddWalletMemberReq true "Add Wallet Member Request"
+// @Success 200 {object} response.DataResponse{data=model.AddWalletMemberRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/members [post]
+func (h WalletHTTPHandler) AddMember(ctx *gin.Context) {
+	var request model.AddWalletMemberReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.WalletId = ctx.Param("id")
+	request.InvitedBy = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.WalletService.AddMember(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// FindMembers godoc
+// @Summary Get the members of a wallet
+// @Description Retrieves the members of a wallet with their role and allowance, with optional filters, pagination, and sorting
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param pageSize query string false "Number of items per page"
+// @Param page query string false "Page number"
+// @Param filter query string false "Filter rules"
+// @Param sort query string false "Sort rules"
+// @Success 200 {object} response.DataResponse{data=model.GetAllWalletMemberRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Router /wallets/{id}/members [get]
+func (h WalletHTTPHandler) FindMembers(ctx *gin.Context) {
+	page, sort, filter, err := h.ParsePaginationParams(ctx)
+	if err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request := model.GetAllWalletMemberReq{
+		WalletId: ctx.Param("id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
+		Page:     page,
+		Filter:   filter,
+		Sort:     sort,
+	}
+	response, errException := h.WalletService.FindMembers(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// UpdateMember godoc
+// @Summary Update a wallet member
+// @Description Changes the role or the allowance of a member, owners only. The last owner cannot be demoted
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param member_id path string true "Wallet Member ID"
+// @Param member body model.UpdateWalletMemberReq true "Update Wallet Member Request"
+// @Success 200 {object} response.DataResponse{data=model.UpdateWalletMemberRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/members/{member_id} [put]
+func (h WalletHTTPHandler) UpdateMember(ctx *gin.Context) {
+	var request model.UpdateWalletMemberReq
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		h.BadRequestJSON(ctx, err.Error())
+		return
+	}
+	request.WalletId = ctx.Param("id")
+	request.ID = ctx.Param("member_id")
+	request.UserId = h.ParseGetKey(ctx, "user_id")
+	response, errException := h.WalletService.UpdateMember(ctx, &request)
+	if errException != nil {
+		h.ExceptionJSON(ctx, errException)
+		return
+	}
+	h.DataJSON(ctx, response)
+}
+
+// RemoveMember godoc
+// @Summary Remove a wallet member
+// @Description Takes a member off the wallet, owners may remove anyone and members may remove themselves. The last owner cannot be removed
+// @Tags Wallets
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization JWT input: Bearer <Token>"
+// @Param id path string true "Wallet ID"
+// @Param member_id path string true "Wallet Member ID"
+// @Success 200 {object} response.DataResponse{data=model.RemoveWalletMemberRes} "success"
+// @Failure 400 {object} response.DataResponse "error"
+// @Failure 403 {object} response.DataResponse "error"
+// @Router /wallets/{id}/members/{member_id} [delete]
+func (h WalletHTTPHandler) RemoveMember(ctx *gin.Context) {
+	request := model.RemoveWalletMemberReq{
+		WalletId: ctx.Param("id"),
+		ID:       ctx.Param("member_id"),
+		UserId:   h.ParseGetKey(ctx, "user_id"),
 	}
-	response, errException := h.WalletService.Delete(ctx, &request)
+	response, errException := h.WalletService.RemoveMember(ctx, &request)
 	if errException != nil {
 		h.ExceptionJSON(ctx, errException)
 		return
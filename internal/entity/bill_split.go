@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	BillSplitTableName = "bill_split"
+)
+
+const (
+	BillSplitMethodEqual   = "equal"
+	BillSplitMethodExact   = "exact"
+	BillSplitMethodPercent = "percent"
+)
+
+const (
+	BillSplitStatusOpen    = "open"    // some shares are still pending
+	BillSplitStatusSettled = "settled" // every share was paid
+	BillSplitStatusClosed  = "closed"  // nothing is pending but some shares were never paid
+)
+
+// BillSplit shares an expense among several users. Every share but the one of
+// its creator is asked for by a payment request into WalletId, so a share is
+// settled by paying its request.
+type BillSplit struct {
+	Id            string           `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CreatedBy     string           `gorm:"type:uuid;index" json:"created_by"`
+	WalletId      string           `gorm:"type:uuid;index" json:"wallet_id"`                                           // where the shares are paid into
+	Wallet        *Wallet          `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"` // never shown, participants must not see its balance
+	TransactionId *string          `gorm:"type:uuid;index" json:"transaction_id,omitempty"`                            // the expense split, none for an ad hoc amount
+	Description   string           `gorm:"size:255" json:"description" example:"Dinner on Friday"`
+	Method        string           `gorm:"size:16" json:"method" example:"equal"`
+	Amount        money.Money      `gorm:"embedded;embeddedPrefix:amount_" json:"amount"` // in the wallet's currency
+	OwnShare      money.Money      `gorm:"embedded;embeddedPrefix:own_" json:"own_share"` // the creator's part, never requested
+	Requests      []PaymentRequest `gorm:"foreignKey:SplitId;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"requests,omitempty"`
+	CreatedAt     *time.Time       `json:"created_at"`
+	UpdatedAt     *time.Time       `json:"updated_at"`
+}
+
+// Progress sums what was settled and what is still pending of a split loaded with
+// its requests, the creator's own share counting as settled.
+func (model *BillSplit) Progress() (status string, settled, outstanding money.Money) {
+	settled = model.OwnShare.Normalize()
+	outstanding = money.Zero(settled.Currency)
+	status = BillSplitStatusSettled
+	for _, request := range model.Requests {
+		switch request.Status {
+		case PaymentRequestStatusPaid:
+			settled.Units += request.Amount.Units
+		case PaymentRequestStatusPending:
+			outstanding.Units += request.Amount.Units
+			status = BillSplitStatusOpen
+		default:
+			if status == BillSplitStatusSettled {
+				status = BillSplitStatusClosed
+			}
+		}
+	}
+	return status, settled, outstanding
+}
+
+func (model *BillSplit) TableName() string {
+	return os.Getenv("DB_PREFIX") + BillSplitTableName
+}
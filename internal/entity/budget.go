@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	BudgetTableName = "budget"
+)
+
+// Budget is what a wallet means to spend on a category each calendar month, in
+// the currency of the wallet. Months are the UTC months statements cover.
+type Budget struct {
+	Id         string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId   string      `gorm:"type:uuid;uniqueIndex:idx_budget_wallet_category" json:"wallet_id"`
+	Wallet     *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	CategoryId string      `gorm:"type:uuid;uniqueIndex:idx_budget_wallet_category" json:"category_id"`
+	Category   *Category   `gorm:"foreignKey:CategoryId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"category,omitempty"`
+	Amount     money.Money `gorm:"embedded;embeddedPrefix:amount_" json:"amount"`
+	CreatedBy  string      `gorm:"type:uuid" json:"created_by"`
+	CreatedAt  *time.Time  `json:"created_at"`
+	UpdatedAt  *time.Time  `json:"updated_at"`
+}
+
+func (model *Budget) TableName() string {
+	return os.Getenv("DB_PREFIX") + BudgetTableName
+}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	CampaignTableName = "campaign"
+)
+
+// What a campaign rewards.
+const (
+	CampaignTriggerPurchase = "purchase" // buying products
+	CampaignTriggerTopUp    = "top_up"   // crediting a wallet, by hand or through the payment gateway
+)
+
+// Periods the per-user cap of a campaign is counted over.
+const (
+	CampaignCapPeriodMonth    = "month"    // the calendar month, in UTC
+	CampaignCapPeriodCampaign = "campaign" // the whole campaign
+)
+
+// Campaign rewards the transactions of Trigger in Currency made between StartsAt
+// and EndsAt with BasisPoints of their amount plus Flat, capped at MaxReward per
+// transaction. Purchases may be narrowed to a category or a product, and FirstOnly
+// rewards a user's first transaction of the trigger only. A user is rewarded at
+// most UserCap per CapPeriod, and the campaign stops once Spent reaches Budget.
+// Rewards are credited after ClearingDays, so that what was refunded meanwhile is
+// not rewarded.
+type Campaign struct {
+	Id           string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name         string      `gorm:"size:64" json:"name" example:"5% back on groceries"`
+	Trigger      string      `gorm:"column:trigger_type;size:16;index" json:"trigger" example:"purchase"`
+	CategoryId   *string     `gorm:"type:uuid" json:"category_id,omitempty"` // purchases filed under it only
+	Category     *Category   `gorm:"foreignKey:CategoryId;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"category,omitempty"`
+	ProductId    *string     `gorm:"type:uuid" json:"product_id,omitempty"` // purchases of it only
+	FirstOnly    bool        `json:"first_only"`
+	Currency     string      `gorm:"size:3;index" json:"currency" example:"IDR"`
+	MinAmount    money.Money `gorm:"embedded;embeddedPrefix:min_" json:"min_amount"`
+	BasisPoints  int64       `json:"basis_points" example:"500"` // hundredths of a percent, 500 is 5%
+	Flat         money.Money `gorm:"embedded;embeddedPrefix:flat_" json:"flat"`
+	MaxReward    money.Money `gorm:"embedded;embeddedPrefix:max_" json:"max_reward"`    // zero for no cap
+	UserCap      money.Money `gorm:"embedded;embeddedPrefix:user_cap_" json:"user_cap"` // zero for no cap
+	CapPeriod    string      `gorm:"size:16;default:month" json:"cap_period" example:"month"`
+	Budget       money.Money `gorm:"embedded;embeddedPrefix:budget_" json:"budget"`
+	Spent        money.Money `gorm:"embedded;embeddedPrefix:spent_" json:"spent"` // rewards pending or credited
+	ClearingDays uint        `json:"clearing_days" example:"14"`
+	StartsAt     time.Time   `json:"starts_at"`
+	EndsAt       time.Time   `gorm:"index" json:"ends_at"`
+	Active       bool        `gorm:"default:true" json:"active"`
+	UpdatedBy    string      `gorm:"type:uuid" json:"updated_by"`
+	CreatedAt    *time.Time  `json:"created_at"`
+	UpdatedAt    *time.Time  `json:"updated_at"`
+}
+
+// Runs tells whether the campaign rewards trigger at the given time.
+func (model *Campaign) Runs(trigger string, at time.Time) bool {
+	return model.Active && model.Trigger == trigger && !at.Before(model.StartsAt) && at.Before(model.EndsAt)
+}
+
+// Matches tells whether the campaign rewards transaction, the trigger and the
+// period being already checked.
+func (model *Campaign) Matches(transaction *Transaction) bool {
+	if model.CategoryId != nil && (transaction.CategoryId == nil || *transaction.CategoryId != *model.CategoryId) {
+		return false
+	}
+	if model.ProductId != nil && (transaction.ProductId == nil || *transaction.ProductId != *model.ProductId) {
+		return false
+	}
+	amount := transaction.Amount.Normalize()
+	return amount.Currency == model.Currency && !amount.LessThan(model.MinAmount)
+}
+
+// Reward is what the campaign gives on amount, before the caps of the user and
+// the budget.
+func (model *Campaign) Reward(amount money.Money) money.Money {
+	reward := amount.Percent(model.BasisPoints, money.DefaultRounding)
+	reward.Units += model.Flat.Units
+	if model.MaxReward.Units > 0 && reward.Units > model.MaxReward.Units {
+		reward.Units = model.MaxReward.Units
+	}
+	return reward
+}
+
+// CapSince is when the period the per-user cap is counted over started at now.
+func (model *Campaign) CapSince(now time.Time) time.Time {
+	if model.CapPeriod == CampaignCapPeriodCampaign {
+		return model.StartsAt
+	}
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (model *Campaign) TableName() string {
+	return os.Getenv("DB_PREFIX") + CampaignTableName
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	CategoryTableName = "category"
+)
+
+const (
+	CategoryKindExpense = "expense" // money going out, only these can be budgeted
+	CategoryKindIncome  = "income"
+)
+
+// Names of the system categories bookings fall back to when none is chosen.
+const (
+	CategoryShopping   = "Shopping"
+	CategoryTopUp      = "Top Up"
+	CategoryTransfer   = "Transfer"
+	CategoryWithdrawal = "Withdrawal"
+	CategoryFees       = "Fees"
+	CategoryRewards    = "Rewards"
+)
+
+// SystemCategories are available to every user, seeded by the migration.
+var SystemCategories = []Category{
+	{Name: "Food & Drinks", Kind: CategoryKindExpense},
+	{Name: "Groceries", Kind: CategoryKindExpense},
+	{Name: "Transport", Kind: CategoryKindExpense},
+	{Name: CategoryShopping, Kind: CategoryKindExpense},
+	{Name: "Bills & Utilities", Kind: CategoryKindExpense},
+	{Name: "Entertainment", Kind: CategoryKindExpense},
+	{Name: "Health", Kind: CategoryKindExpense},
+	{Name: "Education", Kind: CategoryKindExpense},
+	{Name: CategoryTransfer, Kind: CategoryKindExpense},
+	{Name: CategoryWithdrawal, Kind: CategoryKindExpense},
+	{Name: CategoryFees, Kind: CategoryKindExpense},
+	{Name: "Other", Kind: CategoryKindExpense},
+	{Name: CategoryTopUp, Kind: CategoryKindIncome},
+	{Name: "Salary", Kind: CategoryKindIncome},
+	{Name: CategoryRewards, Kind: CategoryKindIncome},
+}
+
+// Category groups transactions for reporting and budgets. System categories have
+// no user, custom ones are only seen and used by the user who made them.
+type Category struct {
+	Id        string     `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	UserId    *string    `gorm:"type:uuid;index" json:"user_id,omitempty"`
+	User      *User      `gorm:"foreignKey:UserId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty"`
+	Name      string     `gorm:"size:64" json:"name" example:"Groceries"`
+	Kind      string     `gorm:"size:16;default:expense" json:"kind" example:"expense"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// IsSystem tells whether the category is one of the SystemCategories.
+func (model *Category) IsSystem() bool {
+	return model.UserId == nil
+}
+
+// VisibleTo tells whether userId may see and use the category.
+func (model *Category) VisibleTo(userId string) bool {
+	return model.IsSystem() || *model.UserId == userId
+}
+
+func (model *Category) TableName() string {
+	return os.Getenv("DB_PREFIX") + CategoryTableName
+}
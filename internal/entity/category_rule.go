@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"strings"
+	"time"
+)
+
+const (
+	CategoryRuleTableName = "category_rule"
+)
+
+// CategoryRule files the transactions of a wallet that meet all of its conditions
+// under a category and tags them, a condition left unset matches anything. Rules run
+// by Priority, lowest first: the first matching rule with a category decides the
+// category and every matching rule adds its tags.
+type CategoryRule struct {
+	Id                   string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId             string      `gorm:"type:uuid;index" json:"wallet_id"`
+	Wallet               *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	Name                 string      `gorm:"size:64" json:"name" example:"Coffee"`
+	Priority             int         `json:"priority"`
+	DescriptionContains  string      `gorm:"size:128" json:"description_contains,omitempty" example:"coffee"` // case insensitive
+	Direction            string      `gorm:"size:3" json:"direction,omitempty" example:"out"`
+	MinAmount            money.Money `gorm:"embedded;embeddedPrefix:min_" json:"min_amount"` // inclusive, in the wallet currency, zero for no minimum
+	MaxAmount            money.Money `gorm:"embedded;embeddedPrefix:max_" json:"max_amount"` // inclusive, in the wallet currency, zero for no maximum
+	ProductId            *string     `gorm:"type:uuid" json:"product_id,omitempty"`
+	CounterpartyWalletId *string     `gorm:"type:uuid" json:"counterparty_wallet_id,omitempty"` // the other wallet of a transfer
+	CategoryId           *string     `gorm:"type:uuid;index" json:"category_id,omitempty"`
+	Category             *Category   `gorm:"foreignKey:CategoryId;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"category,omitempty"`
+	Tags                 Tags        `gorm:"type:text" json:"tags,omitempty" swaggertype:"array,string"`
+	CreatedBy            string      `gorm:"type:uuid" json:"created_by"`
+	CreatedAt            *time.Time  `json:"created_at"`
+	UpdatedAt            *time.Time  `json:"updated_at"`
+}
+
+// Matches tells whether transaction meets every condition of the rule.
+func (model *CategoryRule) Matches(transaction *Transaction) bool {
+	if model.DescriptionContains != "" &&
+		!strings.Contains(strings.ToLower(transaction.Description), strings.ToLower(model.DescriptionContains)) {
+		return false
+	}
+	if model.Direction != "" && model.Direction != transaction.Direction {
+		return false
+	}
+	amount := transaction.Amount.Normalize()
+	if !model.MinAmount.IsZero() && (!model.MinAmount.SameCurrency(amount) || amount.LessThan(model.MinAmount)) {
+		return false
+	}
+	if !model.MaxAmount.IsZero() && (!model.MaxAmount.SameCurrency(amount) || model.MaxAmount.LessThan(amount)) {
+		return false
+	}
+	if model.ProductId != nil && (transaction.ProductId == nil || *transaction.ProductId != *model.ProductId) {
+		return false
+	}
+	if model.CounterpartyWalletId != nil &&
+		(transaction.CounterpartyWalletId == nil || *transaction.CounterpartyWalletId != *model.CounterpartyWalletId) {
+		return false
+	}
+	return true
+}
+
+func (model *CategoryRule) TableName() string {
+	return os.Getenv("DB_PREFIX") + CategoryRuleTableName
+}
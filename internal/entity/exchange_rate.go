@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	ExchangeRateTableName = "exchange_rate"
+)
+
+// ExchangeRate says one unit of BaseCurrency buys Rate units of QuoteCurrency.
+// The opposite direction uses the inverse when no explicit pair exists.
+type ExchangeRate struct {
+	Id            string     `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	BaseCurrency  string     `json:"base_currency" gorm:"size:3;uniqueIndex:idx_exchange_rate_pair" example:"USD"`
+	QuoteCurrency string     `json:"quote_currency" gorm:"size:3;uniqueIndex:idx_exchange_rate_pair" example:"IDR"`
+	Rate          money.Rate `json:"rate" gorm:"type:varchar(64)" swaggertype:"string" example:"15750.5"`
+	UpdatedBy     string     `json:"updated_by" gorm:"type:uuid"`
+	CreatedAt     *time.Time `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at"`
+}
+
+func (model *ExchangeRate) TableName() string {
+	return os.Getenv("DB_PREFIX") + ExchangeRateTableName
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	FeeRuleTableName = "fee_rule"
+)
+
+// Operations a fee is charged on.
+const (
+	FeeOperationTransfer   = "transfer"
+	FeeOperationPurchase   = "purchase"
+	FeeOperationWithdrawal = "withdrawal"
+)
+
+// FeeRule prices an operation on wallets of Currency. The fee is Flat plus
+// BasisPoints of the amount, raised to MinFee and capped at MaxFee when it is
+// set. A rule only applies to amounts from AmountFrom up to AmountTo, so tiered
+// pricing is a rule per range, and a rule for a wallet tier wins over the one
+// for every tier.
+type FeeRule struct {
+	Id          string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Operation   string      `gorm:"size:16;index" json:"operation" example:"transfer"`
+	Tier        string      `gorm:"size:16" json:"tier,omitempty" example:"premium"` // none for every tier
+	Currency    string      `gorm:"size:3;index" json:"currency" example:"IDR"`
+	AmountFrom  money.Money `gorm:"embedded;embeddedPrefix:from_" json:"amount_from"`
+	AmountTo    money.Money `gorm:"embedded;embeddedPrefix:to_" json:"amount_to"` // excluded, zero for no upper bound
+	Flat        money.Money `gorm:"embedded;embeddedPrefix:flat_" json:"flat"`
+	BasisPoints int64       `json:"basis_points" example:"150"` // hundredths of a percent, 150 is 1.5%
+	MinFee      money.Money `gorm:"embedded;embeddedPrefix:min_" json:"min_fee"`
+	MaxFee      money.Money `gorm:"embedded;embeddedPrefix:max_" json:"max_fee"` // zero for no cap
+	Active      bool        `gorm:"default:true" json:"active"`
+	UpdatedBy   string      `gorm:"type:uuid" json:"updated_by"`
+	CreatedAt   *time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time  `json:"updated_at"`
+}
+
+// Covers tells whether the rule prices amount on a wallet of tier.
+func (model *FeeRule) Covers(tier string, amount money.Money) bool {
+	if !model.Active || (model.Tier != "" && model.Tier != tier) {
+		return false
+	}
+	if amount.Units < model.AmountFrom.Units {
+		return false
+	}
+	return model.AmountTo.Units == 0 || amount.Units < model.AmountTo.Units
+}
+
+// Fee is what the rule charges on amount, in the currency of amount.
+func (model *FeeRule) Fee(amount money.Money) money.Money {
+	fee := amount.Percent(model.BasisPoints, money.DefaultRounding)
+	fee.Units += model.Flat.Units
+	if fee.Units < model.MinFee.Units {
+		fee.Units = model.MinFee.Units
+	}
+	if model.MaxFee.Units > 0 && fee.Units > model.MaxFee.Units {
+		fee.Units = model.MaxFee.Units
+	}
+	return fee
+}
+
+func (model *FeeRule) TableName() string {
+	return os.Getenv("DB_PREFIX") + FeeRuleTableName
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	HoldTableName = "hold"
+)
+
+const (
+	HoldStatusAuthorized = "authorized"
+	HoldStatusCaptured   = "captured"
+	HoldStatusVoided     = "voided"
+	HoldStatusExpired    = "expired"
+)
+
+// Hold reserves funds of a wallet for a later capture. An authorized hold lowers
+// the available balance of its wallet until it is captured, voided or expires,
+// the ledger balance only moves once it is captured.
+type Hold struct {
+	Id             string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId       string      `gorm:"type:uuid;index" json:"wallet_id"`
+	Wallet         *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	Amount         money.Money `gorm:"embedded;embeddedPrefix:amount_" json:"amount"` // in the wallet's currency
+	CapturedAmount money.Money `gorm:"embedded;embeddedPrefix:captured_" json:"captured_amount"`
+	Description    string      `json:"description"`
+	Status         string      `gorm:"index;default:authorized" json:"status" example:"authorized"`
+	TransactionId  *string     `gorm:"type:uuid" json:"transaction_id,omitempty"` // expense booked by the capture
+	ExpiresAt      *time.Time  `gorm:"index" json:"expires_at"`
+	CreatedAt      *time.Time  `json:"created_at"`
+	UpdatedAt      *time.Time  `json:"updated_at"`
+}
+
+// IsActive reports whether the hold still reserves funds at now.
+func (model *Hold) IsActive(now time.Time) bool {
+	return model.Status == HoldStatusAuthorized && (model.ExpiresAt == nil || model.ExpiresAt.After(now))
+}
+
+func (model *Hold) TableName() string {
+	return os.Getenv("DB_PREFIX") + HoldTableName
+}
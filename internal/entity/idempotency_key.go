@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	IdempotencyKeyTableName = "idempotency_key"
+)
+
+// IdempotencyKey remembers the outcome of a request sent with an Idempotency-Key
+// header. Fingerprint hashes the method, path and body of the first request so a
+// key reused for a different request can be told apart from a retry. A key
+// without CompletedAt is still being processed by the request that reserved it
+// at ReservedAt, unless that request died and left it behind.
+type IdempotencyKey struct {
+	Id          string     `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	UserId      string     `json:"user_id" gorm:"type:uuid;uniqueIndex:idx_idempotency_key_user"`
+	Key         string     `json:"key" gorm:"column:request_key;size:255;uniqueIndex:idx_idempotency_key_user"`
+	Method      string     `json:"method"`
+	Path        string     `json:"path"`
+	Fingerprint string     `json:"fingerprint" gorm:"size:64"`
+	StatusCode  int        `json:"status_code"`
+	Response    string     `json:"response" gorm:"type:text"`
+	ReservedAt  time.Time  `json:"reserved_at"`
+	CreatedAt   *time.Time `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+func (model *IdempotencyKey) IsCompleted() bool {
+	return model.CompletedAt != nil
+}
+
+// IsAbandoned tells whether a key still in flight was reserved before cutoff, by a
+// request that is taken to have crashed.
+func (model *IdempotencyKey) IsAbandoned(cutoff time.Time) bool {
+	return !model.IsCompleted() && model.ReservedAt.Before(cutoff)
+}
+
+func (model *IdempotencyKey) TableName() string {
+	return os.Getenv("DB_PREFIX") + IdempotencyKeyTableName
+}
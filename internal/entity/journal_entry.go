@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	JournalEntryTableName = "journal_entry"
+	JournalLineTableName  = "journal_line"
+)
+
+// JournalEntry groups the lines of a single money movement. An entry is only
+// posted when the sum of its debits equals the sum of its credits.
+type JournalEntry struct {
+	Id          string        `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Description string        `json:"description"`
+	Lines       []JournalLine `gorm:"foreignKey:EntryId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"lines,omitempty"`
+	PostedAt    *time.Time    `gorm:"autoCreateTime" json:"posted_at"`
+}
+
+type JournalLine struct {
+	Id            string         `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	EntryId       string         `gorm:"type:uuid;index" json:"entry_id"`
+	AccountId     string         `gorm:"type:uuid;index" json:"account_id"`
+	Account       *LedgerAccount `gorm:"foreignKey:AccountId;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;" json:"account,omitempty"`
+	TransactionId *string        `gorm:"type:uuid;index" json:"transaction_id,omitempty"`
+	Debit         money.Money    `gorm:"embedded;embeddedPrefix:debit_" json:"debit"`
+	Credit        money.Money    `gorm:"embedded;embeddedPrefix:credit_" json:"credit"`
+}
+
+func NewJournalEntry(description string) *JournalEntry {
+	return &JournalEntry{
+		Id:          uuid.NewString(),
+		Description: description,
+	}
+}
+
+// Debit adds a debit line; transactionId links the line to the wallet transaction it books, if any.
+func (model *JournalEntry) Debit(accountId string, amount money.Money, transactionId *string) *JournalEntry {
+	model.Lines = append(model.Lines, JournalLine{
+		Id:            uuid.NewString(),
+		EntryId:       model.Id,
+		AccountId:     accountId,
+		TransactionId: transactionId,
+		Debit:         amount,
+		Credit:        money.Zero(amount.Currency),
+	})
+	return model
+}
+
+// Credit adds a credit line; transactionId links the line to the wallet transaction it books, if any.
+func (model *JournalEntry) Credit(accountId string, amount money.Money, transactionId *string) *JournalEntry {
+	model.Lines = append(model.Lines, JournalLine{
+		Id:            uuid.NewString(),
+		EntryId:       model.Id,
+		AccountId:     accountId,
+		TransactionId: transactionId,
+		Debit:         money.Zero(amount.Currency),
+		Credit:        amount,
+	})
+	return model
+}
+
+// IsBalanced reports whether debits equal credits, currency by currency.
+func (model *JournalEntry) IsBalanced() bool {
+	totals := map[string]int64{}
+	var debit int64
+	for _, line := range model.Lines {
+		if line.Debit.IsNegative() || line.Credit.IsNegative() {
+			return false
+		}
+		totals[line.Debit.Normalize().Currency] += line.Debit.Units
+		totals[line.Credit.Normalize().Currency] -= line.Credit.Units
+		debit += line.Debit.Units
+	}
+	for _, total := range totals {
+		if total != 0 {
+			return false
+		}
+	}
+	return len(model.Lines) >= 2 && debit > 0
+}
+
+func (model *JournalEntry) TableName() string {
+	return os.Getenv("DB_PREFIX") + JournalEntryTableName
+}
+
+func (model *JournalLine) TableName() string {
+	return os.Getenv("DB_PREFIX") + JournalLineTableName
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	LedgerAccountTableName = "ledger_account"
+)
+
+const (
+	LedgerAccountTypeWallet   = "wallet"
+	LedgerAccountTypeSystem   = "system"
+	LedgerAccountTypeMerchant = "merchant"
+)
+
+// Well-known codes of the non-wallet accounts every journal entry balances against.
+const (
+	SystemFundingAccountCode = "system:funding"
+	SystemOpeningAccountCode = "system:opening"
+	MerchantSalesAccountCode = "merchant:sales"
+	SystemFXAccountCode      = "system:fx"
+	SystemGatewayAccountCode = "system:gateway"
+	SystemPayoutAccountCode  = "system:payout"
+	SystemRewardsAccountCode = "system:rewards"
+)
+
+// LedgerAccount is a double-entry account. Balance is kept as credits minus debits,
+// so a wallet account balance is what the wallet holder owns and the balances of
+// all accounts always sum to zero.
+type LedgerAccount struct {
+	Id        string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Code      string      `json:"code" gorm:"uniqueIndex" example:"system:funding"`
+	Name      string      `json:"name" example:"Funding source"`
+	Type      string      `json:"type" validate:"eq=wallet|eq=system|eq=merchant"`
+	WalletId  *string     `gorm:"type:uuid;uniqueIndex" json:"wallet_id,omitempty"`
+	Balance   money.Money `gorm:"embedded;embeddedPrefix:balance_" json:"balance"`
+	CreatedAt *time.Time  `json:"created_at"`
+	UpdatedAt *time.Time  `json:"updated_at"`
+}
+
+func WalletAccountCode(walletId string) string {
+	return LedgerAccountTypeWallet + ":" + walletId
+}
+
+// SystemAccountCode is the code of a non-wallet account in one currency, system
+// accounts are split by currency so every account balances in a single currency.
+func SystemAccountCode(code, currency string) string {
+	return code + ":" + currency
+}
+
+func (model *LedgerAccount) TableName() string {
+	return os.Getenv("DB_PREFIX") + LedgerAccountTableName
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	PaymentRequestTableName             = "payment_request"
+	PaymentRequestStatusChangeTableName = "payment_request_status_change"
+)
+
+const (
+	PaymentRequestStatusPending   = "pending"
+	PaymentRequestStatusPaid      = "paid"
+	PaymentRequestStatusDeclined  = "declined"
+	PaymentRequestStatusCancelled = "cancelled"
+	PaymentRequestStatusExpired   = "expired"
+)
+
+// PaymentRequest is a user asking another for money into one of their wallets. It
+// stays pending until the payer pays or declines it, the requester cancels it or
+// it expires, paying it books a transfer from the wallet the payer picks.
+type PaymentRequest struct {
+	Id                    string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	RequesterId           string      `gorm:"type:uuid;index" json:"requester_id"`
+	RequesterName         string      `json:"requester_name" example:"john_doe"` // usernames never change, kept to show without the user
+	PayerId               string      `gorm:"type:uuid;index" json:"payer_id"`
+	PayerName             string      `json:"payer_name" example:"jane_doe"`
+	WalletId              string      `gorm:"type:uuid;index" json:"wallet_id"`                                           // where the money goes
+	Wallet                *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"` // never shown, the payer must not see its balance
+	Amount                money.Money `gorm:"embedded;embeddedPrefix:amount_" json:"amount"`                              // in the wallet's currency
+	Note                  string      `gorm:"size:255" json:"note" example:"Dinner on Friday"`
+	Status                string      `gorm:"index;default:pending" json:"status" example:"pending"`
+	ExpiresAt             time.Time   `gorm:"index" json:"expires_at"`
+	PaidFromWalletId      *string     `gorm:"type:uuid" json:"paid_from_wallet_id,omitempty"`
+	SenderTransactionId   *string     `gorm:"type:uuid" json:"sender_transaction_id,omitempty"`
+	ReceiverTransactionId *string     `gorm:"type:uuid" json:"receiver_transaction_id,omitempty"`
+	SplitId               *string     `gorm:"type:uuid;index" json:"split_id,omitempty"` // set on the shares of a bill split
+	CreatedAt             *time.Time  `json:"created_at"`
+	UpdatedAt             *time.Time  `json:"updated_at"`
+}
+
+// IsPayable reports whether the request can still be paid at now.
+func (model *PaymentRequest) IsPayable(now time.Time) bool {
+	return model.Status == PaymentRequestStatusPending && model.ExpiresAt.After(now)
+}
+
+func (model *PaymentRequest) TableName() string {
+	return os.Getenv("DB_PREFIX") + PaymentRequestTableName
+}
+
+// PaymentRequestStatusChange is the audit trail of the status of a payment request.
+type PaymentRequestStatusChange struct {
+	Id         string          `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	RequestId  string          `gorm:"type:uuid;index" json:"request_id"`
+	Request    *PaymentRequest `gorm:"foreignKey:RequestId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	FromStatus string          `gorm:"size:16" json:"from_status" example:"pending"`
+	ToStatus   string          `gorm:"size:16" json:"to_status" example:"paid"`
+	Reason     string          `json:"reason,omitempty" example:"already paid in cash"`
+	ChangedBy  *string         `gorm:"type:uuid" json:"changed_by"` // nil when the request expired
+	CreatedAt  *time.Time      `json:"created_at"`
+}
+
+func (model *PaymentRequestStatusChange) TableName() string {
+	return os.Getenv("DB_PREFIX") + PaymentRequestStatusChangeTableName
+}
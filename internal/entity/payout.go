@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	PayoutTableName = "payout"
+)
+
+const (
+	PayoutStatusPending   = "pending"   // debited, not handed to the provider yet
+	PayoutStatusSubmitted = "submitted" // the provider is paying it
+	PayoutStatusPaid      = "paid"      // the bank credited the destination
+	PayoutStatusFailed    = "failed"    // never paid, the wallet got the money back
+	PayoutStatusReturned  = "returned"  // paid then sent back by the bank, the wallet got the money back
+)
+
+// Payout withdraws money from a wallet to a bank account. The wallet is debited
+// when it is created, the money waits in the payouts in flight account until the
+// provider pays it, and goes back to the wallet with its fee when the payout
+// fails or is returned. The account it was sent to is copied from its destination.
+type Payout struct {
+	Id                   string             `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId             string             `gorm:"type:uuid;index" json:"wallet_id"`
+	Wallet               *Wallet            `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	UserId               string             `gorm:"type:uuid;index" json:"user_id"` // who withdrew
+	DestinationId        *string            `gorm:"type:uuid;index" json:"destination_id,omitempty"`
+	Destination          *PayoutDestination `gorm:"foreignKey:DestinationId;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"destination,omitempty"`
+	BankCode             string             `gorm:"size:16" json:"bank_code" example:"BCA"`
+	AccountNumber        string             `gorm:"size:34" json:"account_number" example:"1234567890"`
+	HolderName           string             `gorm:"size:128" json:"holder_name" example:"JOHN DOE"`
+	Amount               money.Money        `gorm:"embedded;embeddedPrefix:amount_" json:"amount"` // in the wallet's currency
+	Fee                  money.Money        `gorm:"embedded;embeddedPrefix:fee_" json:"fee"`       // charged on top of Amount, given back with it
+	Status               string             `gorm:"index;default:pending" json:"status" example:"submitted"`
+	Provider             string             `gorm:"size:32" json:"provider" example:"simulator"`
+	ProviderReference    *string            `gorm:"size:64;index" json:"provider_reference,omitempty"`
+	FailureReason        string             `json:"failure_reason,omitempty"`
+	TransactionId        *string            `gorm:"type:uuid" json:"transaction_id,omitempty"`         // the withdrawal
+	RestoreTransactionId *string            `gorm:"type:uuid" json:"restore_transaction_id,omitempty"` // what gave the money back
+	SubmittedAt          *time.Time         `json:"submitted_at,omitempty"`
+	PaidAt               *time.Time         `gorm:"index" json:"paid_at,omitempty"`
+	ClosedAt             *time.Time         `json:"closed_at,omitempty"` // when it failed or was returned
+	CreatedAt            *time.Time         `json:"created_at"`
+	UpdatedAt            *time.Time         `json:"updated_at"`
+}
+
+func (model *Payout) TableName() string {
+	return os.Getenv("DB_PREFIX") + PayoutTableName
+}
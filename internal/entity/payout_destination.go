@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	PayoutDestinationTableName = "payout_destination"
+)
+
+const (
+	PayoutDestinationStatusPending  = "pending"  // the provider could not be asked yet
+	PayoutDestinationStatusVerified = "verified" // payouts may be sent to it
+	PayoutDestinationStatusRejected = "rejected" // the bank does not know the account
+)
+
+// PayoutDestination is a bank account a user saved to withdraw to. It is checked
+// with the payout provider when it is saved, only verified destinations are paid.
+type PayoutDestination struct {
+	Id              string     `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	UserId          string     `gorm:"type:uuid;index" json:"user_id"`
+	Label           string     `gorm:"size:64" json:"label" example:"Salary account"`
+	BankCode        string     `gorm:"size:16" json:"bank_code" example:"BCA"`
+	AccountNumber   string     `gorm:"size:34" json:"account_number" example:"1234567890"`
+	HolderName      string     `gorm:"size:128" json:"holder_name" example:"JOHN DOE"` // as the bank knows it once verified
+	Status          string     `gorm:"size:16;default:pending" json:"status" example:"verified"`
+	RejectionReason string     `json:"rejection_reason,omitempty"`
+	VerifiedAt      *time.Time `json:"verified_at,omitempty"`
+	CreatedAt       *time.Time `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+}
+
+func (model *PayoutDestination) TableName() string {
+	return os.Getenv("DB_PREFIX") + PayoutDestinationTableName
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"os"
+	"product-wallet/pkg/money"
 	"time"
 )
 
@@ -10,14 +11,14 @@ const (
 )
 
 type Product struct {
-	Id          string     `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Name        string     `json:"name"`
-	Price       float64    `json:"price"`
-	Description string     `json:"description"`
-	Quantity    uint       `json:"quantity"`
-	Available   bool       `json:"available"`
-	CreatedAt   *time.Time `json:"created_at"`
-	UpdatedAt   *time.Time `json:"updated_at"`
+	Id          string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        string      `json:"name"`
+	Price       money.Money `gorm:"embedded;embeddedPrefix:price_" json:"price"`
+	Description string      `json:"description"`
+	Quantity    uint        `json:"quantity"`
+	Available   bool        `json:"available"`
+	CreatedAt   *time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time  `json:"updated_at"`
 }
 
 func (model *Product) TableName() string {
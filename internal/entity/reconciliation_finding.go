@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	ReconciliationFindingTableName = "reconciliation_finding"
+)
+
+const (
+	ReconciliationFindingStatusOpen      = "open"      // the balances still disagree
+	ReconciliationFindingStatusCorrected = "corrected" // the wallet was rebuilt from the ledger and its history adjusted
+	ReconciliationFindingStatusResolved  = "resolved"  // the balances agreed again on a later check
+)
+
+// ReconciliationFinding records a wallet whose balance differs from the sum of its
+// transactions or from its ledger account. A wallet has at most one open finding,
+// later checks update it.
+type ReconciliationFinding struct {
+	Id               string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId         string      `gorm:"type:uuid;index" json:"wallet_id"`
+	Wallet           *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	ExpectedBalance  money.Money `gorm:"embedded;embeddedPrefix:expected_" json:"expected_balance"`           // recomputed from the transactions
+	ActualBalance    money.Money `gorm:"embedded;embeddedPrefix:actual_" json:"actual_balance"`               // Wallet.Balance
+	Difference       money.Money `gorm:"embedded;embeddedPrefix:difference_" json:"difference"`               // actual minus expected
+	LedgerBalance    money.Money `gorm:"embedded;embeddedPrefix:ledger_" json:"ledger_balance"`               // the wallet's ledger account, the book of record
+	LedgerDifference money.Money `gorm:"embedded;embeddedPrefix:ledger_difference_" json:"ledger_difference"` // actual minus ledger
+	Status           string      `gorm:"index;default:open" json:"status" example:"open"`
+	AdjustmentId     *string     `gorm:"type:uuid" json:"adjustment_id,omitempty"` // the transaction booked to correct the history
+	DetectedAt       time.Time   `json:"detected_at"`
+	CheckedAt        time.Time   `json:"checked_at"`          // last time the wallet was compared
+	ClosedAt         *time.Time  `json:"closed_at,omitempty"` // when it was corrected or resolved
+}
+
+func (model *ReconciliationFinding) TableName() string {
+	return os.Getenv("DB_PREFIX") + ReconciliationFindingTableName
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	RewardTableName = "reward"
+)
+
+const (
+	RewardStatusPending   = "pending"   // waiting for the clearing period of its campaign
+	RewardStatusCredited  = "credited"  // paid into the wallet
+	RewardStatusCancelled = "cancelled" // its transaction was reversed, before it cleared or since
+)
+
+// Reward is what a campaign gave a user on one of their transactions. It counts
+// against the budget of the campaign from the moment it is granted and is paid
+// into the wallet of that transaction once AvailableAt is reached.
+type Reward struct {
+	Id                  string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CampaignId          string      `gorm:"type:uuid;index" json:"campaign_id"`
+	Campaign            *Campaign   `gorm:"foreignKey:CampaignId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"campaign,omitempty"`
+	UserId              string      `gorm:"type:uuid;index" json:"user_id"`
+	WalletId            string      `gorm:"type:uuid;index" json:"wallet_id"`
+	Wallet              *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	SourceTransactionId string      `gorm:"type:uuid;index" json:"source_transaction_id"` // what was rewarded
+	Amount              money.Money `gorm:"embedded;embeddedPrefix:amount_" json:"amount"`
+	Status              string      `gorm:"size:16;index;default:pending" json:"status" example:"pending"`
+	AvailableAt         time.Time   `gorm:"index" json:"available_at"`
+	TransactionId       *string     `gorm:"type:uuid" json:"transaction_id,omitempty"` // the credit of the reward
+	CreditedAt          *time.Time  `json:"credited_at,omitempty"`
+	CancelledAt         *time.Time  `json:"cancelled_at,omitempty"`
+	CreatedAt           *time.Time  `json:"created_at"`
+	UpdatedAt           *time.Time  `json:"updated_at"`
+}
+
+func (model *Reward) TableName() string {
+	return os.Getenv("DB_PREFIX") + RewardTableName
+}
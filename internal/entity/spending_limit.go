@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	SpendingLimitTableName = "spending_limit"
+)
+
+const (
+	SpendingLimitPerTransaction = "per_transaction"
+	SpendingLimitDaily          = "daily"
+	SpendingLimitWeekly         = "weekly"
+	SpendingLimitMonthly        = "monthly"
+)
+
+// SpendingPeriods are the limits of a wallet with the rolling window its outflows
+// are summed over, the per transaction cap has none.
+var SpendingPeriods = []struct {
+	Period string
+	Window time.Duration
+}{
+	{SpendingLimitPerTransaction, 0},
+	{SpendingLimitDaily, 24 * time.Hour},
+	{SpendingLimitWeekly, 7 * 24 * time.Hour},
+	{SpendingLimitMonthly, 30 * 24 * time.Hour},
+}
+
+// SpendingLimit caps the outflows of a wallet, in the wallet's currency. A zero
+// limit is not set on the wallet and falls back to the configured default.
+type SpendingLimit struct {
+	Id             string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId       string      `gorm:"type:uuid;uniqueIndex" json:"wallet_id"`
+	Wallet         *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	PerTransaction money.Money `gorm:"embedded;embeddedPrefix:per_transaction_" json:"per_transaction"`
+	Daily          money.Money `gorm:"embedded;embeddedPrefix:daily_" json:"daily"`
+	Weekly         money.Money `gorm:"embedded;embeddedPrefix:weekly_" json:"weekly"`
+	Monthly        money.Money `gorm:"embedded;embeddedPrefix:monthly_" json:"monthly"`
+	UpdatedAt      *time.Time  `json:"updated_at"`
+}
+
+// Limit returns the limit set for period, zero when it is not set.
+func (model *SpendingLimit) Limit(period string) money.Money {
+	switch period {
+	case SpendingLimitPerTransaction:
+		return model.PerTransaction
+	case SpendingLimitDaily:
+		return model.Daily
+	case SpendingLimitWeekly:
+		return model.Weekly
+	case SpendingLimitMonthly:
+		return model.Monthly
+	}
+	return money.Money{}
+}
+
+func (model *SpendingLimit) TableName() string {
+	return os.Getenv("DB_PREFIX") + SpendingLimitTableName
+}
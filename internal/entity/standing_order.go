@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	StandingOrderTableName    = "standing_order"
+	StandingOrderRunTableName = "standing_order_run"
+)
+
+const (
+	StandingOrderStatusActive    = "active"
+	StandingOrderStatusPaused    = "paused"
+	StandingOrderStatusCancelled = "cancelled"
+	StandingOrderStatusCompleted = "completed"
+)
+
+const (
+	StandingOrderRunSucceeded = "succeeded"
+	StandingOrderRunFailed    = "failed"
+)
+
+// StandingOrder is a transfer repeated on a schedule, see schedule.Parse for the
+// supported specs. The scheduler picks it up once NextRunAt has passed.
+type StandingOrder struct {
+	Id          string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId    string      `gorm:"type:uuid;index" json:"wallet_id"`
+	Wallet      *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	ReceiverId  string      `gorm:"type:uuid" json:"receiver_id"`
+	Receiver    *Wallet     `gorm:"foreignKey:ReceiverId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"receiver,omitempty"`
+	Amount      money.Money `gorm:"embedded;embeddedPrefix:amount_" json:"amount"` // in the sender or the receiver currency, like a transfer
+	Description string      `json:"description"`
+	Schedule    string      `json:"schedule" example:"monthly"`
+	StartAt     time.Time   `json:"start_at"`
+	EndAt       *time.Time  `json:"end_at,omitempty"`
+	Status      string      `gorm:"index;default:active" json:"status" example:"active"`
+	NextRunAt   *time.Time  `gorm:"index" json:"next_run_at"`
+	Attempts    int         `json:"attempts"` // failed attempts of the current run
+	LastRunAt   *time.Time  `json:"last_run_at"`
+	CreatedBy   string      `gorm:"type:uuid" json:"created_by"` // runs transfer on behalf of this user
+	CreatedAt   *time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time  `json:"updated_at"`
+}
+
+func (model *StandingOrder) TableName() string {
+	return os.Getenv("DB_PREFIX") + StandingOrderTableName
+}
+
+// StandingOrderRun records one execution attempt of a standing order.
+type StandingOrderRun struct {
+	Id              string     `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	StandingOrderId string     `gorm:"type:uuid;index" json:"standing_order_id"`
+	Status          string     `json:"status" example:"succeeded"`
+	Attempt         int        `json:"attempt"`
+	TransactionId   *string    `gorm:"type:uuid" json:"transaction_id,omitempty"` // sender side of the transfer
+	Error           string     `json:"error,omitempty"`
+	RunAt           *time.Time `gorm:"autoCreateTime" json:"run_at"`
+}
+
+func (model *StandingOrderRun) TableName() string {
+	return os.Getenv("DB_PREFIX") + StandingOrderRunTableName
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	StatementTableName     = "statement"
+	StatementLineTableName = "statement_line"
+)
+
+// StatementPeriodLayout names the calendar month, in UTC, a statement covers.
+const StatementPeriodLayout = "2006-01"
+
+// Statement is the account of a wallet over a closed month. It is generated once
+// and kept as generated, later bookings only show up when it is regenerated.
+type Statement struct {
+	Id               string          `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId         string          `gorm:"type:uuid;uniqueIndex:idx_statement_wallet_period" json:"wallet_id"`
+	Wallet           *Wallet         `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	Period           string          `gorm:"size:7;uniqueIndex:idx_statement_wallet_period" json:"period" example:"2026-09"`
+	PeriodStart      time.Time       `json:"period_start"`
+	PeriodEnd        time.Time       `json:"period_end"` // exclusive
+	OpeningBalance   money.Money     `gorm:"embedded;embeddedPrefix:opening_" json:"opening_balance"`
+	TotalCredits     money.Money     `gorm:"embedded;embeddedPrefix:credits_" json:"total_credits"`
+	TotalDebits      money.Money     `gorm:"embedded;embeddedPrefix:debits_" json:"total_debits"`
+	ClosingBalance   money.Money     `gorm:"embedded;embeddedPrefix:closing_" json:"closing_balance"`
+	TransactionCount int             `json:"transaction_count"`
+	Version          int             `gorm:"default:1" json:"version"` // bumped on every regeneration
+	Lines            []StatementLine `gorm:"foreignKey:StatementId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"lines,omitempty"`
+	GeneratedAt      time.Time       `json:"generated_at"`
+	CreatedAt        *time.Time      `json:"created_at"`
+}
+
+func (model *Statement) TableName() string {
+	return os.Getenv("DB_PREFIX") + StatementTableName
+}
+
+// StatementLine is a transaction as it stood when its statement was generated.
+type StatementLine struct {
+	Id              string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	StatementId     string      `gorm:"type:uuid;index" json:"statement_id"`
+	Position        int         `json:"position"`
+	TransactionId   string      `gorm:"type:uuid" json:"transaction_id"`
+	TransactionTime time.Time   `json:"transaction_time"`
+	Type            string      `json:"type"`
+	Description     string      `json:"description"`
+	Amount          money.Money `gorm:"embedded;embeddedPrefix:amount_" json:"amount"` // negative for debits
+	Balance         money.Money `gorm:"embedded;embeddedPrefix:balance_" json:"balance"`
+}
+
+func (model *StatementLine) TableName() string {
+	return os.Getenv("DB_PREFIX") + StatementLineTableName
+}
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Tags are free labels on a transaction, stored comma separated.
+type Tags []string
+
+// Add returns the tags with more appended, blank and repeated ones left out. Tags
+// differing only in case are the same tag.
+func (t Tags) Add(more ...string) Tags {
+	result := Tags{}
+	seen := map[string]bool{}
+	for _, tag := range append(append([]string{}, t...), more...) {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+func (t Tags) Value() (driver.Value, error) {
+	if len(t) == 0 {
+		return nil, nil
+	}
+	return strings.Join(t, ","), nil
+}
+
+func (t *Tags) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case string:
+		*t = Tags{}.Add(strings.Split(v, ",")...)
+		return nil
+	case []byte:
+		*t = Tags{}.Add(strings.Split(string(v), ",")...)
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into tags", src)
+	}
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	TopUpTableName = "top_up"
+)
+
+const (
+	TopUpStatusPending   = "pending"
+	TopUpStatusSucceeded = "succeeded"
+	TopUpStatusFailed    = "failed"
+	TopUpStatusExpired   = "expired"
+)
+
+// TopUp is money paid into a wallet through the payment provider. It is pending
+// until the provider reports the outcome of its checkout with a webhook, only a
+// succeeded top up credits the wallet.
+type TopUp struct {
+	Id                string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId          string      `gorm:"type:uuid;index" json:"wallet_id"`
+	Wallet            *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	UserId            string      `gorm:"type:uuid;index" json:"user_id"`                // who started it
+	Amount            money.Money `gorm:"embedded;embeddedPrefix:amount_" json:"amount"` // in the wallet's currency
+	Status            string      `gorm:"index;default:pending" json:"status" example:"pending"`
+	Provider          string      `gorm:"size:32" json:"provider" example:"stub"`
+	CheckoutReference *string     `gorm:"size:64;index" json:"checkout_reference,omitempty" example:"chk_6f1c2b0e9d8a4c3b"`
+	CheckoutURL       string      `json:"checkout_url,omitempty"` // where the user pays
+	FailureReason     string      `json:"failure_reason,omitempty"`
+	ExpiresAt         time.Time   `gorm:"index" json:"expires_at"`
+	SettledAt         *time.Time  `json:"settled_at,omitempty"`
+	TransactionId     *string     `gorm:"type:uuid" json:"transaction_id,omitempty"` // income booked once it succeeded
+	CreatedAt         *time.Time  `json:"created_at"`
+	UpdatedAt         *time.Time  `json:"updated_at"`
+}
+
+func (model *TopUp) TableName() string {
+	return os.Getenv("DB_PREFIX") + TopUpTableName
+}
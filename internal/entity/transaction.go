@@ -2,6 +2,7 @@ package entity
 
 import (
 	"os"
+	"product-wallet/pkg/money"
 	"time"
 )
 
@@ -9,16 +10,72 @@ const (
 	TransactionTableName = "transaction"
 )
 
+const (
+	TransactionStatusCompleted         = "completed"
+	TransactionStatusPartiallyRefunded = "partially_refunded"
+	TransactionStatusReversed          = "reversed"
+)
+
+const (
+	TransactionDirectionIn  = "in"  // money reaching the wallet
+	TransactionDirectionOut = "out" // money leaving the wallet
+)
+
 type Transaction struct {
-	Id              string     `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Type            string     `json:"type" validate:"eq=income|eq=expense|eq=transfer"`
-	Amount          float64    `json:"amount"`
-	Description     string     `json:"description"`
-	WalletId        string     `gorm:"type:uuid" json:"wallet_id"`
-	Wallet          *Wallet    `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
-	ProductId       *string    `gorm:"type:uuid" json:"product_id,omitempty"`
-	Product         *Product   `gorm:"foreignKey:ProductId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;default:null" json:"product,omitempty"`
-	TransactionTime *time.Time `gorm:"autoCreateTime" json:"transaction_time"`
+	Id                   string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Type                 string      `json:"type" validate:"eq=income|eq=expense|eq=transfer|eq=reversal|eq=adjustment|eq=withdrawal|eq=fee|eq=reward"`
+	Direction            string      `gorm:"size:3" json:"direction" example:"out"`
+	Amount               money.Money `gorm:"embedded;embeddedPrefix:amount_" json:"amount"`                                // booked in the wallet's currency
+	OriginalAmount       money.Money `gorm:"embedded;embeddedPrefix:original_" json:"original_amount"`                     // amount leaving the source of the money
+	ConvertedAmount      money.Money `gorm:"embedded;embeddedPrefix:converted_" json:"converted_amount"`                   // amount reaching its destination
+	ExchangeRate         money.Rate  `gorm:"type:varchar(64)" json:"exchange_rate" swaggertype:"string" example:"15750.5"` // from OriginalAmount to ConvertedAmount
+	Description          string      `json:"description"`
+	WalletId             string      `gorm:"type:uuid" json:"wallet_id"`
+	Wallet               *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	Status               string      `gorm:"default:completed" json:"status" example:"completed"`
+	RefundedAmount       money.Money `gorm:"embedded;embeddedPrefix:refunded_" json:"refunded_amount"` // in the currency of Amount
+	ReversalOfId         *string     `gorm:"type:uuid;index" json:"reversal_of_id,omitempty"`          // set on the compensating transaction of a reversal or refund
+	InitiatedBy          *string     `gorm:"type:uuid;index" json:"initiated_by,omitempty"`            // the user who made the transaction, none for system bookings
+	CategoryId           *string     `gorm:"type:uuid;index" json:"category_id,omitempty"`
+	Category             *Category   `gorm:"foreignKey:CategoryId;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"category,omitempty"`
+	Tags                 Tags        `gorm:"type:text" json:"tags,omitempty" swaggertype:"array,string"`
+	CounterpartyWalletId *string     `gorm:"type:uuid;index" json:"counterparty_wallet_id,omitempty"` // the other wallet of a transfer
+	ChargedOnId          *string     `gorm:"type:uuid;index" json:"charged_on_id,omitempty"`          // set on a fee, the transaction it was charged on
+	ProductId            *string     `gorm:"type:uuid" json:"product_id,omitempty"`
+	Product              *Product    `gorm:"foreignKey:ProductId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;default:null" json:"product,omitempty"`
+	ProductQuantity      uint        `json:"product_quantity,omitempty"`
+	RefundedQuantity     uint        `json:"refunded_quantity,omitempty"`
+	TransactionTime      *time.Time  `gorm:"autoCreateTime" json:"transaction_time"`
+}
+
+// ReversedDirection is the direction of a transaction compensating one going direction.
+func ReversedDirection(direction string) string {
+	if direction == TransactionDirectionOut {
+		return TransactionDirectionIn
+	}
+	return TransactionDirectionOut
+}
+
+// SignedAmount is Amount, negative when the money left the wallet.
+func (model *Transaction) SignedAmount() money.Money {
+	if model.Direction == TransactionDirectionOut {
+		return model.Amount.Normalize().Neg()
+	}
+	return model.Amount.Normalize()
+}
+
+// RemainingAmount is the part of Amount not refunded yet.
+func (model *Transaction) RemainingAmount() money.Money {
+	amount := model.Amount.Normalize()
+	refunded, err := model.RefundedAmount.WithCurrency(amount.Currency)
+	if err != nil {
+		return money.Zero(amount.Currency)
+	}
+	remaining, err := amount.Sub(refunded)
+	if err != nil {
+		return money.Zero(amount.Currency)
+	}
+	return remaining
 }
 
 func (model *Transaction) TableName() string {
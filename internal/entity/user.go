@@ -2,16 +2,37 @@ package entity
 
 import (
 	"os"
+	"strings"
 )
 
 const (
 	UserTableName = "user"
 )
 
+const (
+	UserRoleUser  = "user"
+	UserRoleAdmin = "admin"
+)
+
 type User struct {
-	Id       string `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Username string `json:"username" example:"john_doe"`
-	Password string `json:"password" example:"$2a$12$eixZaYVK1fsbw1ZfbX3OXe.PZyWJQ0Zf10hErsTQ6FVRHiA2vwLHu"` // Example of bcrypt-hashed password
+	Id              string  `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Username        string  `json:"username" example:"john_doe"`
+	Password        string  `json:"password" example:"$2a$12$eixZaYVK1fsbw1ZfbX3OXe.PZyWJQ0Zf10hErsTQ6FVRHiA2vwLHu"` // Example of bcrypt-hashed password
+	Role            string  `json:"role" gorm:"default:user" example:"user"`                                         // admins are promoted directly in the database
+	DefaultWalletId *string `gorm:"type:uuid" json:"default_wallet_id,omitempty"`                                    // where transfers to the username go when no wallet is named
+}
+
+// MaskName hides most of a name shown to other users, keeping its first and last letter.
+func MaskName(name string) string {
+	runes := []rune(name)
+	switch len(runes) {
+	case 0:
+		return ""
+	case 1, 2:
+		return string(runes[0]) + strings.Repeat("*", len(runes)-1)
+	default:
+		return string(runes[0]) + strings.Repeat("*", len(runes)-2) + string(runes[len(runes)-1])
+	}
 }
 
 func (model *User) TableName() string {
@@ -2,30 +2,94 @@ package entity
 
 import (
 	"os"
+	"product-wallet/pkg/money"
 	"time"
 )
 
 const (
-	WalletTableName = "wallet"
+	WalletTableName             = "wallet"
+	WalletStatusChangeTableName = "wallet_status_change"
+)
+
+const (
+	WalletStatusActive    = "active"
+	WalletStatusFrozen    = "frozen"    // credits allowed, debits blocked
+	WalletStatusSuspended = "suspended" // all movement blocked
+	WalletStatusClosed    = "closed"    // emptied and out of use for good
+)
+
+// Tiers of a wallet, fees may differ between them.
+const (
+	WalletTierStandard = "standard"
+	WalletTierPremium  = "premium"
+	WalletTierBusiness = "business"
 )
 
 type Wallet struct {
-	Id              string     `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Name            string     `json:"name" example:"personal"`
-	UserId          string     `bson:"user_id" json:"user_id" validate:"required,uuid" gorm:"type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
-	User            *User      `bson:"user" json:"user" gorm:"foreignKey:UserId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	Balance         float64    `gorm:"default:0" json:"balance"`
-	LastTransaction *time.Time `gorm:"autoUpdateTime" json:"last_transaction"`
+	Id              string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name            string      `json:"name" example:"personal"`
+	Currency        string      `gorm:"size:3" json:"currency" example:"IDR"`
+	UserId          string      `bson:"user_id" json:"user_id" validate:"required,uuid" gorm:"type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	User            *User       `bson:"user" json:"user" gorm:"foreignKey:UserId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Balance         money.Money `gorm:"embedded;embeddedPrefix:balance_" json:"balance"` // projection of the wallet ledger account, see LedgerAccount
+	Status          string      `gorm:"size:16;default:active" json:"status" example:"active"`
+	Tier            string      `gorm:"size:16;default:standard" json:"tier" example:"standard"` // set by admins
+	ClosedAt        *time.Time  `json:"closed_at,omitempty"`
+	LastTransaction *time.Time  `gorm:"autoUpdateTime" json:"last_transaction"`
+}
+
+// StatusCode is the status of the wallet, wallets created before lifecycle states are active.
+func (model *Wallet) StatusCode() string {
+	if model.Status == "" {
+		return WalletStatusActive
+	}
+	return model.Status
 }
 
-func (model *Wallet) Increase(amount float64) {
-	model.Balance += amount
+// TierCode is the tier of the wallet, wallets created before tiers are standard.
+func (model *Wallet) TierCode() string {
+	if model.Tier == "" {
+		return WalletTierStandard
+	}
+	return model.Tier
 }
 
-func (model *Wallet) Decrease(amount float64) {
-	model.Balance -= amount
+// CanDebit tells whether money may leave the wallet.
+func (model *Wallet) CanDebit() bool {
+	return model.StatusCode() == WalletStatusActive
+}
+
+// CanCredit tells whether money may reach the wallet.
+func (model *Wallet) CanCredit() bool {
+	status := model.StatusCode()
+	return status == WalletStatusActive || status == WalletStatusFrozen
+}
+
+// CurrencyCode is the currency the wallet holds, wallets created before
+// multi-currency support hold the default currency.
+func (model *Wallet) CurrencyCode() string {
+	if model.Currency != "" {
+		return model.Currency
+	}
+	return model.Balance.Normalize().Currency
 }
 
 func (model *Wallet) TableName() string {
 	return os.Getenv("DB_PREFIX") + WalletTableName
 }
+
+// WalletStatusChange is the audit trail of the status of a wallet.
+type WalletStatusChange struct {
+	Id         string     `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId   string     `gorm:"type:uuid;index" json:"wallet_id"`
+	Wallet     *Wallet    `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	FromStatus string     `gorm:"size:16" json:"from_status" example:"active"`
+	ToStatus   string     `gorm:"size:16" json:"to_status" example:"frozen"`
+	Reason     string     `json:"reason" example:"chargeback investigation"`
+	ChangedBy  string     `gorm:"type:uuid" json:"changed_by"` // the user who made the change
+	CreatedAt  *time.Time `json:"created_at"`
+}
+
+func (model *WalletStatusChange) TableName() string {
+	return os.Getenv("DB_PREFIX") + WalletStatusChangeTableName
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"os"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	WalletMemberTableName = "wallet_member"
+)
+
+const (
+	WalletRoleOwner   = "owner"   // manages the wallet and its members
+	WalletRoleSpender = "spender" // buys and transfers, within the allowance when one is set
+	WalletRoleViewer  = "viewer"  // only reads
+)
+
+// walletRoleRanks orders the roles, each one may do everything the ones below it may.
+var walletRoleRanks = map[string]int{
+	WalletRoleViewer:  1,
+	WalletRoleSpender: 2,
+	WalletRoleOwner:   3,
+}
+
+// WalletAllowanceWindow is the rolling window the allowance of a member is spent over.
+const WalletAllowanceWindow = 30 * 24 * time.Hour
+
+// WalletMember gives a user a role on a wallet. Wallet.UserId is the user who
+// opened the wallet and is its first owner.
+type WalletMember struct {
+	Id        string      `json:"id" gorm:"primaryKey;type:uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WalletId  string      `gorm:"type:uuid;uniqueIndex:idx_wallet_member" json:"wallet_id"`
+	Wallet    *Wallet     `gorm:"foreignKey:WalletId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"wallet,omitempty"`
+	UserId    string      `gorm:"type:uuid;uniqueIndex:idx_wallet_member;index" json:"user_id"`
+	User      *User       `gorm:"foreignKey:UserId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty"`
+	Role      string      `gorm:"size:16" json:"role" example:"spender"`
+	Allowance money.Money `gorm:"embedded;embeddedPrefix:allowance_" json:"allowance"` // what a spender may spend per WalletAllowanceWindow, zero for no cap
+	InvitedBy *string     `gorm:"type:uuid" json:"invited_by,omitempty"`
+	CreatedAt *time.Time  `json:"created_at"`
+	UpdatedAt *time.Time  `json:"updated_at"`
+}
+
+// IsWalletRole tells whether role is one of the wallet roles.
+func IsWalletRole(role string) bool {
+	_, ok := walletRoleRanks[role]
+	return ok
+}
+
+// Has tells whether the member may act with role.
+func (model *WalletMember) Has(role string) bool {
+	return walletRoleRanks[model.Role] >= walletRoleRanks[role]
+}
+
+func (model *WalletMember) TableName() string {
+	return os.Getenv("DB_PREFIX") + WalletMemberTableName
+}
@@ -1,5 +0,0 @@
-package externalapi
-
-type ExampleSvcExternal interface {
-	Post() (interface{}, int, error)
-}
@@ -0,0 +1,59 @@
+package externalapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	WebhookPaymentSucceeded = "payment.succeeded"
+	WebhookPaymentFailed    = "payment.failed"
+	WebhookPaymentExpired   = "payment.expired"
+)
+
+// ErrInvalidSignature is returned for a webhook that was not signed by the provider.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// PaymentProvider takes payments from outside the wallet. The user pays a checkout
+// on the provider's side and the provider reports the outcome with a signed webhook.
+type PaymentProvider interface {
+	Name() string
+	CreateCheckout(ctx context.Context, req CheckoutReq) (*Checkout, error)
+	// ParseWebhook checks the signature of a webhook and decodes its event
+	ParseWebhook(payload []byte, signature string) (*WebhookEvent, error)
+}
+
+type CheckoutReq struct {
+	OrderId     string // ours, the provider keeps it with the checkout
+	Amount      money.Money
+	Description string
+	ExpiresAt   time.Time
+}
+
+type Checkout struct {
+	Reference string // the provider's, every webhook about the checkout carries it
+	URL       string // where the user pays
+}
+
+// WebhookEvent is what the provider reports about a checkout. A redelivered event
+// keeps its Id.
+type WebhookEvent struct {
+	Id        string      `json:"id"`
+	Type      string      `json:"type"`
+	Reference string      `json:"reference"`
+	Amount    money.Money `json:"amount"`
+	Reason    string      `json:"reason,omitempty"` // why the payment failed
+}
+
+// SignWebhook is the signature of a webhook payload, the hex encoded HMAC-SHA256
+// of the payload with the secret shared with the provider.
+func SignWebhook(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,57 @@
+package externalapi
+
+import (
+	"context"
+	"errors"
+	"product-wallet/pkg/money"
+)
+
+const (
+	PayoutStatusSubmitted = "submitted"
+	PayoutStatusPaid      = "paid"
+	PayoutStatusFailed    = "failed"
+	PayoutStatusReturned  = "returned"
+)
+
+// ErrPayoutRejected is wrapped by the error of a payout the provider refused, any other
+// error leaves unknown whether the provider took the payout.
+var ErrPayoutRejected = errors.New("payout rejected")
+
+// PayoutProvider sends money out of the wallet to bank accounts. Payouts settle
+// asynchronously, their status is polled until they are paid or failed, and a
+// paid payout may still be returned by the bank for a while.
+type PayoutProvider interface {
+	Name() string
+	VerifyAccount(ctx context.Context, account BankAccount) (*AccountVerification, error)
+	// SubmitPayout is idempotent on OrderId, submitting a payout again returns its reference
+	SubmitPayout(ctx context.Context, req PayoutReq) (*PayoutSubmission, error)
+	PayoutStatus(ctx context.Context, reference string) (*PayoutResult, error)
+}
+
+type BankAccount struct {
+	BankCode      string
+	AccountNumber string
+	HolderName    string
+}
+
+type AccountVerification struct {
+	Valid      bool
+	HolderName string // the name on the account at the bank
+	Reason     string // why the account is not valid
+}
+
+type PayoutReq struct {
+	OrderId     string // ours, at most one payout is made per order
+	Amount      money.Money
+	Account     BankAccount
+	Description string
+}
+
+type PayoutSubmission struct {
+	Reference string
+}
+
+type PayoutResult struct {
+	Status string
+	Reason string // why the payout failed or was returned
+}
@@ -0,0 +1,104 @@
+package externalapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-wallet/config"
+	"strings"
+	"sync"
+	"time"
+)
+
+// simulatedOutcomes decide what the simulated bank does with a payout, by the last
+// digits of the account number. Every other account is paid.
+var simulatedOutcomes = map[string]string{
+	"13": PayoutStatusFailed,
+	"99": PayoutStatusReturned,
+}
+
+// SimulatedPayoutProvider stands in for a payout provider locally. Accounts ending
+// in 0000 do not exist, payouts to accounts ending in 13 fail and those to accounts
+// ending in 99 are returned after being paid. A payout settles SimulatorDelay after
+// the simulator first saw it, and is returned as long again after that.
+type SimulatedPayoutProvider struct {
+	config *config.Config
+	mu     sync.Mutex
+	seen   map[string]time.Time
+}
+
+func NewSimulatedPayoutProvider(config *config.Config) PayoutProvider {
+	return &SimulatedPayoutProvider{
+		config: config,
+		seen:   map[string]time.Time{},
+	}
+}
+
+func (p *SimulatedPayoutProvider) Name() string {
+	return "simulator"
+}
+
+func (p *SimulatedPayoutProvider) VerifyAccount(ctx context.Context, account BankAccount) (*AccountVerification, error) {
+	number := account.AccountNumber
+	if len(number) < 6 || len(number) > 20 || strings.Trim(number, "0123456789") != "" {
+		return &AccountVerification{Reason: "invalid account number"}, nil
+	}
+	if strings.HasSuffix(number, "0000") {
+		return &AccountVerification{Reason: "account not found at " + account.BankCode}, nil
+	}
+	return &AccountVerification{
+		Valid:      true,
+		HolderName: strings.ToUpper(strings.TrimSpace(account.HolderName)),
+	}, nil
+}
+
+// SubmitPayout derives the reference from the order and the outcome the payout
+// will have, so submitting again returns the same reference.
+func (p *SimulatedPayoutProvider) SubmitPayout(ctx context.Context, req PayoutReq) (*PayoutSubmission, error) {
+	if !req.Amount.IsPositive() {
+		return nil, fmt.Errorf("%w: payout amount must be greater than zero", ErrPayoutRejected)
+	}
+	outcome := PayoutStatusPaid
+	for suffix, status := range simulatedOutcomes {
+		if strings.HasSuffix(req.Account.AccountNumber, suffix) {
+			outcome = status
+		}
+	}
+	reference := "sim_" + outcome + "_" + strings.ReplaceAll(req.OrderId, "-", "")
+	p.since(reference)
+	return &PayoutSubmission{
+		Reference: reference,
+	}, nil
+}
+
+// PayoutStatus reads the outcome back from the reference. The simulator keeps no
+// state but when it first saw a payout, after a restart payouts settle afresh.
+func (p *SimulatedPayoutProvider) PayoutStatus(ctx context.Context, reference string) (*PayoutResult, error) {
+	parts := strings.SplitN(reference, "_", 3)
+	if len(parts) != 3 || parts[0] != "sim" {
+		return nil, errors.New("unknown payout reference " + reference)
+	}
+	elapsed := time.Since(p.since(reference))
+	delay := p.config.PayoutConfig.SimulatorDelay
+	switch {
+	case elapsed < delay:
+		return &PayoutResult{Status: PayoutStatusSubmitted}, nil
+	case parts[1] == PayoutStatusFailed:
+		return &PayoutResult{Status: PayoutStatusFailed, Reason: "rejected by the receiving bank"}, nil
+	case parts[1] == PayoutStatusReturned && elapsed >= 2*delay:
+		return &PayoutResult{Status: PayoutStatusReturned, Reason: "returned by the receiving bank, account closed"}, nil
+	}
+	return &PayoutResult{Status: PayoutStatusPaid}, nil
+}
+
+// since is when the simulator first saw the payout of reference.
+func (p *SimulatedPayoutProvider) since(reference string) time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	first, ok := p.seen[reference]
+	if !ok {
+		first = time.Now()
+		p.seen[reference] = first
+	}
+	return first
+}
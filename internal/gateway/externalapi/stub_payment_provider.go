@@ -0,0 +1,54 @@
+package externalapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"product-wallet/config"
+	"strings"
+)
+
+// StubPaymentProvider stands in for a payment provider locally. Its checkouts are
+// never paid on their own, posting a webhook signed with the configured secret
+// settles them.
+type StubPaymentProvider struct {
+	config *config.Config
+}
+
+func NewStubPaymentProvider(config *config.Config) PaymentProvider {
+	return &StubPaymentProvider{
+		config: config,
+	}
+}
+
+func (p *StubPaymentProvider) Name() string {
+	return "stub"
+}
+
+func (p *StubPaymentProvider) CreateCheckout(ctx context.Context, req CheckoutReq) (*Checkout, error) {
+	if !req.Amount.IsPositive() {
+		return nil, errors.New("checkout amount must be greater than zero")
+	}
+	reference := "chk_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	return &Checkout{
+		Reference: reference,
+		URL:       strings.TrimRight(p.config.PaymentGatewayConfig.CheckoutURL, "/") + "/" + reference,
+	}, nil
+}
+
+func (p *StubPaymentProvider) ParseWebhook(payload []byte, signature string) (*WebhookEvent, error) {
+	expected := SignWebhook(p.config.PaymentGatewayConfig.WebhookSecret, payload)
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return nil, ErrInvalidSignature
+	}
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	if event.Id == "" || event.Type == "" || event.Reference == "" {
+		return nil, errors.New("webhook event is missing its id, type or reference")
+	}
+	return &event, nil
+}
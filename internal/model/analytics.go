@@ -0,0 +1,104 @@
+package model
+
+import (
+	"product-wallet/pkg/money"
+	"time"
+)
+
+// Periods the cash flow can be bucketed by, a week starts on Monday.
+const (
+	AnalyticsPeriodDay   = "day"
+	AnalyticsPeriodWeek  = "week"
+	AnalyticsPeriodMonth = "month"
+)
+
+// AnalyticsReq is the range [From, To) the analytics of a user cover, over
+// WalletId alone or every wallet the user is a member of when it is empty.
+type AnalyticsReq struct {
+	UserId   string    `swaggerignore:"true"`
+	WalletId string    `swaggerignore:"true"`
+	From     time.Time `swaggerignore:"true"`
+	To       time.Time `swaggerignore:"true"`
+}
+
+type GetCashFlowReq struct {
+	AnalyticsReq
+	Period string `validate:"oneof=day week month" swaggerignore:"true"`
+}
+
+// CashFlowPeriod is what came in and went out in a period, net of refunds. Transfers
+// are kept apart from the income and the expenses they would otherwise inflate.
+type CashFlowPeriod struct {
+	Period      string      `json:"period" example:"2026-10-01"` // first day of the period
+	Currency    string      `json:"currency" example:"IDR"`
+	Income      money.Money `json:"income"`
+	Expense     money.Money `json:"expense"`
+	TransferIn  money.Money `json:"transfer_in"`
+	TransferOut money.Money `json:"transfer_out"`
+	Net         money.Money `json:"net"`
+}
+
+type GetCashFlowRes struct {
+	From    time.Time        `json:"from"`
+	To      time.Time        `json:"to"`
+	Period  string           `json:"period" example:"month"`
+	Periods []CashFlowPeriod `json:"periods"`
+}
+
+type GetTopProductsReq struct {
+	AnalyticsReq
+	Limit int `validate:"min=1,max=100" swaggerignore:"true"`
+}
+
+// ProductSales is what was bought of a product, net of what was refunded.
+type ProductSales struct {
+	ProductId string      `json:"product_id"`
+	Name      string      `json:"name"`
+	Quantity  int64       `json:"quantity" example:"3"`
+	Purchases int64       `json:"purchases" example:"2"`
+	Amount    money.Money `json:"amount"`
+}
+
+type GetTopProductsRes struct {
+	From     time.Time      `json:"from"`
+	To       time.Time      `json:"to"`
+	Products []ProductSales `json:"products"`
+}
+
+type GetCategorySpendingReq struct {
+	AnalyticsReq
+}
+
+// CategorySpending is what was spent, transferred, withdrawn or paid in fees under
+// a category, net of refunds. The uncategorized spending has no CategoryId.
+type CategorySpending struct {
+	CategoryId *string     `json:"category_id"`
+	Name       string      `json:"name" example:"Groceries"`
+	Count      int64       `json:"count" example:"4"`
+	Amount     money.Money `json:"amount"`
+}
+
+type GetCategorySpendingRes struct {
+	From       time.Time          `json:"from"`
+	To         time.Time          `json:"to"`
+	Categories []CategorySpending `json:"categories"`
+}
+
+type GetWalletCashFlowReq struct {
+	AnalyticsReq
+}
+
+// WalletCashFlow is every movement of a wallet in the range, Net being how much its
+// balance changed. Wallets that did not move are left out.
+type WalletCashFlow struct {
+	WalletId string      `json:"wallet_id"`
+	Inflow   money.Money `json:"inflow"`
+	Outflow  money.Money `json:"outflow"`
+	Net      money.Money `json:"net"`
+}
+
+type GetWalletCashFlowRes struct {
+	From    time.Time        `json:"from"`
+	To      time.Time        `json:"to"`
+	Wallets []WalletCashFlow `json:"wallets"`
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+// SplitParticipantReq is one user sharing a bill. Amount is only given in exact
+// splits and Percent only in percent splits.
+type SplitParticipantReq struct {
+	Username string       `json:"username" validate:"required" example:"jane_doe"`
+	Amount   *money.Money `json:"amount,omitempty"`
+	Percent  string       `json:"percent,omitempty" validate:"omitempty,numeric" example:"33.34"` // up to two decimals
+}
+
+// CreateBillSplitReq splits TransactionId, an expense, or else Amount among the
+// participants. The creator may list themselves to carry a share of their own.
+// Rounding leftovers of equal and percent splits go one minor unit at a time to
+// the participants in the order they are listed.
+type CreateBillSplitReq struct {
+	UserId        string                `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	WalletId      string                `json:"wallet_id,omitempty" validate:"required_without=TransactionId,omitempty,uuid"` // defaults to the wallet of the transaction
+	TransactionId *string               `json:"transaction_id,omitempty" validate:"omitempty,uuid"`
+	Amount        *money.Money          `json:"amount,omitempty" validate:"excluded_with=TransactionId"` // of an ad hoc split, exact splits default to the sum of their shares
+	Description   string                `json:"description" validate:"max=255" example:"Dinner on Friday"`
+	Method        string                `json:"method" validate:"required,oneof=equal exact percent" example:"equal"`
+	Participants  []SplitParticipantReq `json:"participants" validate:"required,min=1,max=50,dive"`
+	ExpiresAt     *time.Time            `json:"expires_at,omitempty"` // of the payment requests, defaults to their configured lifetime
+}
+
+func (req CreateBillSplitReq) ToEntity(walletId string, amount, ownShare money.Money) *entity.BillSplit {
+	return &entity.BillSplit{
+		Id:            uuid.NewString(),
+		CreatedBy:     req.UserId,
+		WalletId:      walletId,
+		TransactionId: req.TransactionId,
+		Description:   req.Description,
+		Method:        req.Method,
+		Amount:        amount,
+		OwnShare:      ownShare,
+	}
+}
+
+// ToPaymentRequestReq asks participant for share of the split.
+func (req CreateBillSplitReq) ToPaymentRequestReq(
+	split entity.BillSplit, participant SplitParticipantReq, share money.Money,
+) *CreatePaymentRequestReq {
+	return &CreatePaymentRequestReq{
+		UserId:        req.UserId,
+		WalletId:      split.WalletId,
+		PayerUsername: participant.Username,
+		Amount:        share,
+		Note:          split.Description,
+		ExpiresAt:     req.ExpiresAt,
+		SplitId:       &split.Id,
+	}
+}
+
+// BillSplitRes is a split with its payment requests and how far it is settled.
+type BillSplitRes struct {
+	entity.BillSplit
+	Status      string      `json:"status" example:"open"` // open, settled or closed
+	Settled     money.Money `json:"settled"`               // own share and paid shares
+	Outstanding money.Money `json:"outstanding"`           // pending shares
+}
+
+func NewBillSplitRes(split entity.BillSplit) BillSplitRes {
+	status, settled, outstanding := split.Progress()
+	return BillSplitRes{
+		BillSplit:   split,
+		Status:      status,
+		Settled:     settled,
+		Outstanding: outstanding,
+	}
+}
+
+type CreateBillSplitRes struct {
+	BillSplitRes
+}
+
+// GetAllBillSplitReq lists the splits the user created, the ones they owe a
+// share of show up among their incoming payment requests.
+type GetAllBillSplitReq struct {
+	UserId string
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllBillSplitRes struct {
+	PaginationData[entity.BillSplit]
+}
+
+type GetBillSplitByIDReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type GetBillSplitByIDRes struct {
+	BillSplitRes
+}
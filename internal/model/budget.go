@@ -0,0 +1,88 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+type CreateBudgetReq struct {
+	WalletId   string      `json:"-" swaggerignore:"true"`
+	UserId     string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	CategoryId string      `json:"category_id" validate:"required,uuid"`
+	Amount     money.Money `json:"amount" validate:"required"` // spent over a calendar month, in the wallet currency
+}
+
+func (req CreateBudgetReq) ToEntity(amount money.Money) *entity.Budget {
+	return &entity.Budget{
+		Id:         uuid.NewString(),
+		WalletId:   req.WalletId,
+		CategoryId: req.CategoryId,
+		Amount:     amount,
+		CreatedBy:  req.UserId,
+	}
+}
+
+type CreateBudgetRes struct {
+	entity.Budget
+}
+
+type UpdateBudgetReq struct {
+	WalletId string      `json:"-" swaggerignore:"true"`
+	ID       string      `json:"-" swaggerignore:"true"`
+	UserId   string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Amount   money.Money `json:"amount" validate:"required"`
+}
+type UpdateBudgetRes struct {
+	entity.Budget
+}
+
+type DeleteBudgetReq struct {
+	WalletId string `swaggerignore:"true"`
+	ID       string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+}
+type DeleteBudgetRes struct {
+	ID string `json:"id"`
+}
+
+type GetAllBudgetReq struct {
+	WalletId string
+	UserId   string
+	Page     PaginationParam
+	Filter   FilterParams
+	Sort     OrderParam
+}
+type GetAllBudgetRes struct {
+	PaginationData[entity.Budget]
+}
+
+// GetBudgetStatusReq reports the budgets of a wallet over Period, the current month when empty.
+type GetBudgetStatusReq struct {
+	WalletId string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+	Period   string `swaggerignore:"true"`
+}
+
+// BudgetStatus is what was spent against one budget over the period.
+type BudgetStatus struct {
+	BudgetId    string          `json:"budget_id"`
+	Category    entity.Category `json:"category"`
+	Budget      money.Money     `json:"budget"`
+	Spent       money.Money     `json:"spent"`
+	Remaining   money.Money     `json:"remaining"`    // zero once the budget is exceeded
+	UsedPercent int64           `json:"used_percent"` // rounded down, above 100 when exceeded
+	Exceeded    bool            `json:"exceeded"`
+}
+
+type GetBudgetStatusRes struct {
+	WalletId    string         `json:"wallet_id"`
+	Period      string         `json:"period" example:"2026-10"`
+	PeriodStart time.Time      `json:"period_start"`
+	PeriodEnd   time.Time      `json:"period_end"` // exclusive
+	Budgets     []BudgetStatus `json:"budgets"`
+	TotalBudget money.Money    `json:"total_budget"`
+	TotalSpent  money.Money    `json:"total_spent"` // spent in the budgeted categories
+	Unbudgeted  money.Money    `json:"unbudgeted"`  // spent in every other category, or in none
+}
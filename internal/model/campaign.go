@@ -0,0 +1,114 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+// BaseCampaignReq describes a campaign, the amounts are in Currency.
+type BaseCampaignReq struct {
+	UserId       string       `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Name         string       `json:"name" validate:"required,max=64" example:"5% back on groceries"`
+	Trigger      string       `json:"trigger" validate:"required,oneof=purchase top_up" example:"purchase"`
+	CategoryId   *string      `json:"category_id,omitempty" validate:"omitempty,uuid"` // a system category, purchases only
+	ProductId    *string      `json:"product_id,omitempty" validate:"omitempty,uuid"`  // purchases only
+	FirstOnly    bool         `json:"first_only"`                                      // reward a user's first transaction of the trigger only
+	Currency     string       `json:"currency" validate:"required,len=3" example:"IDR"`
+	MinAmount    *money.Money `json:"min_amount,omitempty"`
+	BasisPoints  int64        `json:"basis_points" validate:"min=0,max=10000" example:"500"` // hundredths of a percent
+	Flat         *money.Money `json:"flat,omitempty"`
+	MaxReward    *money.Money `json:"max_reward,omitempty"` // per transaction, no cap when left out
+	UserCap      *money.Money `json:"user_cap,omitempty"`   // per user and cap period, no cap when left out
+	CapPeriod    string       `json:"cap_period,omitempty" validate:"omitempty,oneof=month campaign" example:"month"`
+	Budget       money.Money  `json:"budget" validate:"required"`
+	ClearingDays uint         `json:"clearing_days" validate:"max=365" example:"14"` // zero credits rewards right away
+	StartsAt     time.Time    `json:"starts_at" validate:"required"`
+	EndsAt       time.Time    `json:"ends_at" validate:"required,gtfield=StartsAt"`
+	Active       *bool        `json:"active,omitempty"` // defaults to true
+}
+
+// ToEntity builds the campaign with its amounts in currency, the first amount
+// that is not in it is returned as an error.
+func (req BaseCampaignReq) ToEntity(id, currency string) (*entity.Campaign, error) {
+	campaign := &entity.Campaign{
+		Id:           id,
+		Name:         req.Name,
+		Trigger:      req.Trigger,
+		CategoryId:   req.CategoryId,
+		ProductId:    req.ProductId,
+		FirstOnly:    req.FirstOnly,
+		Currency:     currency,
+		BasisPoints:  req.BasisPoints,
+		CapPeriod:    req.CapPeriod,
+		Spent:        money.Zero(currency),
+		ClearingDays: req.ClearingDays,
+		StartsAt:     req.StartsAt,
+		EndsAt:       req.EndsAt,
+		Active:       req.Active == nil || *req.Active,
+		UpdatedBy:    req.UserId,
+	}
+	if campaign.CapPeriod == "" {
+		campaign.CapPeriod = entity.CampaignCapPeriodMonth
+	}
+	budget := req.Budget
+	for _, field := range []struct {
+		from *money.Money
+		to   *money.Money
+	}{
+		{req.MinAmount, &campaign.MinAmount},
+		{req.Flat, &campaign.Flat},
+		{req.MaxReward, &campaign.MaxReward},
+		{req.UserCap, &campaign.UserCap},
+		{&budget, &campaign.Budget},
+	} {
+		*field.to = money.Zero(currency)
+		if field.from == nil {
+			continue
+		}
+		amount, err := field.from.WithCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+		*field.to = amount.Normalize()
+	}
+	return campaign, nil
+}
+
+type CreateCampaignReq struct {
+	BaseCampaignReq
+}
+
+func (req CreateCampaignReq) ToEntity(currency string) (*entity.Campaign, error) {
+	return req.BaseCampaignReq.ToEntity(uuid.NewString(), currency)
+}
+
+type CreateCampaignRes struct {
+	entity.Campaign
+}
+
+// UpdateCampaignReq replaces a campaign, what it already spent is kept.
+type UpdateCampaignReq struct {
+	ID string `json:"-" swaggerignore:"true"`
+	BaseCampaignReq
+}
+type UpdateCampaignRes struct {
+	entity.Campaign
+}
+
+type GetAllCampaignReq struct {
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllCampaignRes struct {
+	PaginationData[entity.Campaign]
+}
+
+type GetCampaignByIDReq struct {
+	ID string `swaggerignore:"true"`
+}
+type GetCampaignByIDRes struct {
+	entity.Campaign
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"strings"
+)
+
+type BaseCategoryReq struct {
+	Name   string `json:"name" validate:"required,max=64" example:"Groceries"`
+	Kind   string `json:"kind,omitempty" validate:"omitempty,oneof=expense income" example:"expense"` // defaults to expense
+	UserId string `json:"-" validate:"required,uuid" swaggerignore:"true"`
+}
+
+type CreateCategoryReq struct {
+	BaseCategoryReq
+}
+
+func (req BaseCategoryReq) ToEntity() *entity.Category {
+	kind := req.Kind
+	if kind == "" {
+		kind = entity.CategoryKindExpense
+	}
+	return &entity.Category{
+		Id:     uuid.NewString(),
+		UserId: &req.UserId,
+		Name:   strings.TrimSpace(req.Name),
+		Kind:   kind,
+	}
+}
+
+type CreateCategoryRes struct {
+	entity.Category
+}
+
+type UpdateCategoryReq struct {
+	BaseCategoryReq
+	ID string `swaggerignore:"true"`
+}
+type UpdateCategoryRes struct {
+	entity.Category
+}
+
+type DeleteCategoryReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type DeleteCategoryRes struct {
+	ID string `json:"id"`
+}
+
+// GetAllCategoryReq lists the system categories along with the custom ones of UserId.
+type GetAllCategoryReq struct {
+	UserId string
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllCategoryRes struct {
+	PaginationData[entity.Category]
+}
+
+type GetCategoryByIDReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type GetCategoryByIDRes struct {
+	entity.Category
+}
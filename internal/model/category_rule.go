@@ -0,0 +1,89 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"strings"
+)
+
+// BaseCategoryRuleReq sets the conditions of a rule, those left out match anything,
+// and what it does to the transactions matching all of them.
+type BaseCategoryRuleReq struct {
+	WalletId             string      `json:"-" swaggerignore:"true"`
+	UserId               string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Name                 string      `json:"name" validate:"required,max=64" example:"Coffee"`
+	Priority             int         `json:"priority"` // lowest runs first
+	DescriptionContains  string      `json:"description_contains,omitempty" validate:"max=128" example:"coffee"`
+	Direction            string      `json:"direction,omitempty" validate:"omitempty,oneof=in out" example:"out"`
+	MinAmount            money.Money `json:"min_amount"` // in the wallet currency
+	MaxAmount            money.Money `json:"max_amount"` // in the wallet currency
+	ProductId            *string     `json:"product_id,omitempty" validate:"omitempty,uuid"`
+	CounterpartyWalletId *string     `json:"counterparty_wallet_id,omitempty" validate:"omitempty,uuid"`
+	CategoryId           *string     `json:"category_id,omitempty" validate:"omitempty,uuid"`
+	Tags                 []string    `json:"tags,omitempty" validate:"max=10,dive,required,max=32,excludesall=0x2C"`
+}
+
+func (req BaseCategoryRuleReq) ToEntity() *entity.CategoryRule {
+	return &entity.CategoryRule{
+		Id:                   uuid.NewString(),
+		WalletId:             req.WalletId,
+		Name:                 strings.TrimSpace(req.Name),
+		Priority:             req.Priority,
+		DescriptionContains:  strings.TrimSpace(req.DescriptionContains),
+		Direction:            req.Direction,
+		MinAmount:            req.MinAmount,
+		MaxAmount:            req.MaxAmount,
+		ProductId:            req.ProductId,
+		CounterpartyWalletId: req.CounterpartyWalletId,
+		CategoryId:           req.CategoryId,
+		Tags:                 entity.Tags{}.Add(req.Tags...),
+		CreatedBy:            req.UserId,
+	}
+}
+
+type CreateCategoryRuleReq struct {
+	BaseCategoryRuleReq
+}
+type CreateCategoryRuleRes struct {
+	entity.CategoryRule
+}
+
+type UpdateCategoryRuleReq struct {
+	BaseCategoryRuleReq
+	ID string `json:"-" swaggerignore:"true"`
+}
+type UpdateCategoryRuleRes struct {
+	entity.CategoryRule
+}
+
+type DeleteCategoryRuleReq struct {
+	WalletId string `swaggerignore:"true"`
+	ID       string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+}
+type DeleteCategoryRuleRes struct {
+	ID string `json:"id"`
+}
+
+type GetAllCategoryRuleReq struct {
+	WalletId string
+	UserId   string
+	Page     PaginationParam
+	Filter   FilterParams
+	Sort     OrderParam
+}
+type GetAllCategoryRuleRes struct {
+	PaginationData[entity.CategoryRule]
+}
+
+// ApplyCategoryRulesReq runs the rules of a wallet over the transactions it already booked.
+type ApplyCategoryRulesReq struct {
+	WalletId string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+}
+type ApplyCategoryRulesRes struct {
+	WalletId string `json:"wallet_id"`
+	Checked  int64  `json:"checked"` // transactions the rules ran over
+	Updated  int64  `json:"updated"` // transactions filed under another category or tagged
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"strings"
+)
+
+type BaseExchangeRateReq struct {
+	BaseCurrency  string     `json:"base_currency" validate:"required,currency" example:"USD"`
+	QuoteCurrency string     `json:"quote_currency" validate:"required,currency,nefield=BaseCurrency" example:"IDR"`
+	Rate          money.Rate `json:"rate" validate:"required,gt=0" swaggertype:"string" example:"15750.5"`
+	UpdatedBy     string     `json:"-" swaggerignore:"true"`
+}
+
+type CreateExchangeRateReq struct {
+	BaseExchangeRateReq
+}
+
+func (req BaseExchangeRateReq) ToEntity() *entity.ExchangeRate {
+	return &entity.ExchangeRate{
+		Id:            uuid.NewString(),
+		BaseCurrency:  strings.ToUpper(req.BaseCurrency),
+		QuoteCurrency: strings.ToUpper(req.QuoteCurrency),
+		Rate:          req.Rate,
+		UpdatedBy:     req.UpdatedBy,
+	}
+}
+
+type CreateExchangeRateRes struct {
+	entity.ExchangeRate
+}
+
+type UpdateExchangeRateReq struct {
+	BaseExchangeRateReq
+	ID string `swaggerignore:"true"`
+}
+type UpdateExchangeRateRes struct {
+	entity.ExchangeRate
+}
+
+type DeleteExchangeRateReq struct {
+	ID string `swaggerignore:"true"`
+}
+type DeleteExchangeRateRes struct {
+	ID string `swaggerignore:"true"`
+}
+
+type GetAllExchangeRateReq struct {
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllExchangeRateRes struct {
+	PaginationData[entity.ExchangeRate]
+}
+
+type GetExchangeRateByIDReq struct {
+	ID string `swaggerignore:"true"`
+}
+
+type GetExchangeRateByIDRes struct {
+	entity.ExchangeRate
+}
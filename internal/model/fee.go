@@ -0,0 +1,163 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+)
+
+// BaseFeeRuleReq prices an operation, the amounts are in Currency. Leaving Tier
+// out makes the rule apply to every tier.
+type BaseFeeRuleReq struct {
+	UserId      string       `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Operation   string       `json:"operation" validate:"required,oneof=transfer purchase withdrawal" example:"transfer"`
+	Tier        string       `json:"tier,omitempty" validate:"omitempty,oneof=standard premium business" example:"premium"`
+	Currency    string       `json:"currency" validate:"required,len=3" example:"IDR"`
+	AmountFrom  *money.Money `json:"amount_from,omitempty"` // defaults to zero
+	AmountTo    *money.Money `json:"amount_to,omitempty"`   // excluded, no upper bound when left out
+	Flat        *money.Money `json:"flat,omitempty"`
+	BasisPoints int64        `json:"basis_points" validate:"min=0,max=10000" example:"150"` // hundredths of a percent
+	MinFee      *money.Money `json:"min_fee,omitempty"`
+	MaxFee      *money.Money `json:"max_fee,omitempty"` // no cap when left out
+	Active      *bool        `json:"active,omitempty"`  // defaults to true
+}
+
+// ToEntity builds the rule with its amounts in currency, the first amount that
+// is not in it is returned as an error.
+func (req BaseFeeRuleReq) ToEntity(id, currency string) (*entity.FeeRule, error) {
+	rule := &entity.FeeRule{
+		Id:          id,
+		Operation:   req.Operation,
+		Tier:        req.Tier,
+		Currency:    currency,
+		BasisPoints: req.BasisPoints,
+		Active:      req.Active == nil || *req.Active,
+		UpdatedBy:   req.UserId,
+	}
+	for _, field := range []struct {
+		from *money.Money
+		to   *money.Money
+	}{
+		{req.AmountFrom, &rule.AmountFrom},
+		{req.AmountTo, &rule.AmountTo},
+		{req.Flat, &rule.Flat},
+		{req.MinFee, &rule.MinFee},
+		{req.MaxFee, &rule.MaxFee},
+	} {
+		*field.to = money.Zero(currency)
+		if field.from == nil {
+			continue
+		}
+		amount, err := field.from.WithCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+		*field.to = amount.Normalize()
+	}
+	return rule, nil
+}
+
+type CreateFeeRuleReq struct {
+	BaseFeeRuleReq
+}
+
+func (req CreateFeeRuleReq) ToEntity(currency string) (*entity.FeeRule, error) {
+	return req.BaseFeeRuleReq.ToEntity(uuid.NewString(), currency)
+}
+
+type CreateFeeRuleRes struct {
+	entity.FeeRule
+}
+
+// UpdateFeeRuleReq replaces a rule.
+type UpdateFeeRuleReq struct {
+	ID string `json:"-" swaggerignore:"true"`
+	BaseFeeRuleReq
+}
+type UpdateFeeRuleRes struct {
+	entity.FeeRule
+}
+
+type DeleteFeeRuleReq struct {
+	ID string `swaggerignore:"true"`
+}
+type DeleteFeeRuleRes struct {
+	ID string `json:"id"`
+}
+
+type GetAllFeeRuleReq struct {
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllFeeRuleRes struct {
+	PaginationData[entity.FeeRule]
+}
+
+type GetFeeRuleByIDReq struct {
+	ID string `swaggerignore:"true"`
+}
+type GetFeeRuleByIDRes struct {
+	entity.FeeRule
+}
+
+// FeeQuote is what an operation would cost on top of its amount, the fee is zero
+// when no rule prices it.
+type FeeQuote struct {
+	Operation     string      `json:"operation" example:"transfer"`
+	Amount        money.Money `json:"amount"` // what the operation moves, in the wallet's currency
+	Fee           money.Money `json:"fee"`
+	Total         money.Money `json:"total"` // what would leave the wallet
+	RuleId        *string     `json:"rule_id,omitempty"`
+	HouseWalletId string      `json:"-"` // where the fee is paid into
+}
+
+// QuoteFeeReq asks what an operation of Amount out of WalletId would cost.
+type QuoteFeeReq struct {
+	UserId    string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	WalletId  string      `json:"wallet_id" validate:"required,uuid"`
+	Operation string      `json:"operation" validate:"required,oneof=transfer purchase withdrawal" example:"transfer"`
+	Amount    money.Money `json:"amount" validate:"required"` // in the currency of the wallet
+}
+type QuoteFeeRes struct {
+	FeeQuote
+}
+
+// NewFeeTransactions are the two sides of the fee charged on the transaction
+// charged, leaving its wallet and reaching the house wallet.
+func NewFeeTransactions(
+	quote FeeQuote, charged entity.Transaction, credit money.Money, rate money.Rate,
+) (payer, house *entity.Transaction) {
+	description := "Fee for: " + charged.Description
+	payer = &entity.Transaction{
+		Id:                   uuid.NewString(),
+		WalletId:             charged.WalletId,
+		Type:                 "fee",
+		Direction:            entity.TransactionDirectionOut,
+		Status:               entity.TransactionStatusCompleted,
+		Amount:               quote.Fee,
+		OriginalAmount:       quote.Fee,
+		ConvertedAmount:      credit,
+		ExchangeRate:         rate,
+		Description:          description,
+		InitiatedBy:          charged.InitiatedBy,
+		CounterpartyWalletId: &quote.HouseWalletId,
+		ChargedOnId:          &charged.Id,
+	}
+	house = &entity.Transaction{
+		Id:                   uuid.NewString(),
+		WalletId:             quote.HouseWalletId,
+		Type:                 "fee",
+		Direction:            entity.TransactionDirectionIn,
+		Status:               entity.TransactionStatusCompleted,
+		Amount:               credit,
+		OriginalAmount:       quote.Fee,
+		ConvertedAmount:      credit,
+		ExchangeRate:         rate,
+		Description:          description,
+		InitiatedBy:          charged.InitiatedBy,
+		CounterpartyWalletId: &charged.WalletId,
+		ChargedOnId:          &charged.Id,
+	}
+	return payer, house
+}
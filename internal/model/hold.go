@@ -0,0 +1,91 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+type AuthorizeHoldReq struct {
+	UserId      string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	WalletId    string      `json:"wallet_id" validate:"required,uuid"`
+	Amount      money.Money `json:"amount" validate:"required"`
+	Description string      `json:"description" example:"Order #1001"`
+	ExpiresAt   *time.Time  `json:"expires_at,omitempty"` // defaults to the configured hold lifetime
+}
+
+func (req AuthorizeHoldReq) ToEntity(expiresAt time.Time) *entity.Hold {
+	return &entity.Hold{
+		Id:             uuid.NewString(),
+		WalletId:       req.WalletId,
+		Amount:         req.Amount,
+		CapturedAmount: money.Zero(req.Amount.Currency),
+		Description:    req.Description,
+		Status:         entity.HoldStatusAuthorized,
+		ExpiresAt:      &expiresAt,
+	}
+}
+
+type AuthorizeHoldRes struct {
+	entity.Hold
+}
+
+// CaptureHoldReq captures Amount of the hold, or all of it when Amount is omitted.
+// What is not captured is released.
+type CaptureHoldReq struct {
+	ID     string       `json:"-" swaggerignore:"true"`
+	UserId string       `json:"-" swaggerignore:"true"`
+	Amount *money.Money `json:"amount,omitempty"`
+}
+
+// NewCaptureTransaction is the expense userId books by capturing amount of hold.
+func NewCaptureTransaction(hold entity.Hold, userId string, amount money.Money) *entity.Transaction {
+	return &entity.Transaction{
+		Id:              uuid.NewString(),
+		Type:            "expense",
+		Direction:       entity.TransactionDirectionOut,
+		Status:          entity.TransactionStatusCompleted,
+		Amount:          amount,
+		OriginalAmount:  amount,
+		ConvertedAmount: amount,
+		ExchangeRate:    money.OneRate(),
+		Description:     "Capture of hold: " + hold.Description,
+		WalletId:        hold.WalletId,
+		InitiatedBy:     &userId,
+	}
+}
+
+type CaptureHoldRes struct {
+	Hold           entity.Hold         `json:"hold"`
+	Transaction    entity.Transaction  `json:"transaction"`
+	FeeTransaction *entity.Transaction `json:"fee_transaction,omitempty"` // the fee charged on top, if any
+	Rewards        []entity.Reward     `json:"rewards,omitempty"`         // granted by the campaigns it qualified for
+}
+
+type VoidHoldReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type VoidHoldRes struct {
+	entity.Hold
+}
+
+type GetAllHoldReq struct {
+	UserId string
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllHoldRes struct {
+	PaginationData[entity.Hold]
+}
+
+type GetHoldByIDReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+
+type GetHoldByIDRes struct {
+	entity.Hold
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type BeginIdempotencyReq struct {
+	UserId string `validate:"required"`
+	Key    string `validate:"required,max=255"`
+	Method string `validate:"required"`
+	Path   string `validate:"required"`
+	Body   []byte
+}
+
+// Fingerprint identifies the request a key was first used for.
+func (req BeginIdempotencyReq) Fingerprint() string {
+	hash := sha256.New()
+	hash.Write([]byte(req.Method + " " + req.Path + "\n"))
+	hash.Write(req.Body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (req BeginIdempotencyReq) ToEntity() *entity.IdempotencyKey {
+	return &entity.IdempotencyKey{
+		Id:          uuid.NewString(),
+		UserId:      req.UserId,
+		Key:         req.Key,
+		Method:      req.Method,
+		Path:        req.Path,
+		Fingerprint: req.Fingerprint(),
+		ReservedAt:  time.Now(),
+	}
+}
+
+// BeginIdempotencyRes holds the reserved key, Replay tells the stored response
+// must be sent back instead of running the request again.
+type BeginIdempotencyRes struct {
+	entity.IdempotencyKey
+	Replay bool
+}
+
+type CompleteIdempotencyReq struct {
+	ID         string
+	StatusCode int
+	Response   []byte
+}
+
+type ReleaseIdempotencyReq struct {
+	ID string
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"product-wallet/internal/entity"
+)
+
+type GetAllLedgerAccountReq struct {
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllLedgerAccountRes struct {
+	PaginationData[entity.LedgerAccount]
+}
+
+type GetAllJournalEntryReq struct {
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllJournalEntryRes struct {
+	PaginationData[entity.JournalEntry]
+}
+
+type GetJournalEntryByIDReq struct {
+	ID string `swaggerignore:"true"`
+}
+
+type GetJournalEntryByIDRes struct {
+	entity.JournalEntry
+}
@@ -21,3 +21,12 @@ type PaginationData[T any] struct {
 	TotalData        int64 `json:"total_rows"`         // The total number of data
 	Data             []*T  `json:"data"`               // The actual data
 }
+
+// NewEmptyPaginationData is the page returned when a query is known to match nothing.
+func NewEmptyPaginationData[T any](page PaginationParam) PaginationData[T] {
+	return PaginationData[T]{
+		Page:     page.Page,
+		PageSize: page.PageSize,
+		Data:     []*T{},
+	}
+}
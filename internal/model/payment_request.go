@@ -0,0 +1,133 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+const (
+	PaymentRequestIncoming = "incoming"
+	PaymentRequestOutgoing = "outgoing"
+)
+
+type CreatePaymentRequestReq struct {
+	UserId        string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	WalletId      string      `json:"wallet_id" validate:"required,uuid"` // where the money goes, requires the spender role on it
+	PayerUsername string      `json:"payer_username" validate:"required" example:"jane_doe"`
+	Amount        money.Money `json:"amount" validate:"required"` // in the currency of the wallet
+	Note          string      `json:"note" validate:"max=255" example:"Dinner on Friday"`
+	ExpiresAt     *time.Time  `json:"expires_at,omitempty"`   // defaults to the configured request lifetime
+	SplitId       *string     `json:"-" swaggerignore:"true"` // the bill split the request is a share of
+}
+
+func (req CreatePaymentRequestReq) ToEntity(requester, payer entity.User, expiresAt time.Time) *entity.PaymentRequest {
+	return &entity.PaymentRequest{
+		Id:            uuid.NewString(),
+		RequesterId:   requester.Id,
+		RequesterName: requester.Username,
+		PayerId:       payer.Id,
+		PayerName:     payer.Username,
+		WalletId:      req.WalletId,
+		Amount:        req.Amount,
+		Note:          req.Note,
+		Status:        entity.PaymentRequestStatusPending,
+		ExpiresAt:     expiresAt,
+		SplitId:       req.SplitId,
+	}
+}
+
+type CreatePaymentRequestRes struct {
+	entity.PaymentRequest
+}
+
+// PayPaymentRequestReq pays a request by a transfer out of WalletId, a wallet of
+// the payer's on which they hold the spender role.
+type PayPaymentRequestReq struct {
+	ID         string  `json:"-" swaggerignore:"true"`
+	UserId     string  `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	WalletId   string  `json:"wallet_id" validate:"required,uuid"`
+	CategoryId *string `json:"category_id,omitempty" validate:"omitempty,uuid"` // of the payer's side, like a transfer
+}
+
+func (req PayPaymentRequestReq) ToTransferReq(request entity.PaymentRequest) *TransferTransactionReq {
+	return &TransferTransactionReq{
+		UserId:     req.UserId,
+		SenderId:   req.WalletId,
+		ReceiverId: request.WalletId,
+		Amount:     request.Amount,
+		CategoryId: req.CategoryId,
+	}
+}
+
+type PayPaymentRequestRes struct {
+	Request  entity.PaymentRequest  `json:"request"`
+	Transfer TransferTransactionRes `json:"transfer"`
+}
+
+// DeclinePaymentRequestReq is the payer turning a request down, the reason is
+// kept in its status history.
+type DeclinePaymentRequestReq struct {
+	ID     string `json:"-" swaggerignore:"true"`
+	UserId string `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Reason string `json:"reason,omitempty" validate:"max=255" example:"already paid in cash"`
+}
+type DeclinePaymentRequestRes struct {
+	entity.PaymentRequest
+}
+
+// CancelPaymentRequestReq is the requester withdrawing a request.
+type CancelPaymentRequestReq struct {
+	ID     string `json:"-" swaggerignore:"true"`
+	UserId string `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Reason string `json:"reason,omitempty" validate:"max=255" example:"asked the wrong person"`
+}
+type CancelPaymentRequestRes struct {
+	entity.PaymentRequest
+}
+
+func NewPaymentRequestStatusChange(
+	request entity.PaymentRequest, to, reason string, changedBy *string,
+) *entity.PaymentRequestStatusChange {
+	return &entity.PaymentRequestStatusChange{
+		Id:         uuid.NewString(),
+		RequestId:  request.Id,
+		FromStatus: request.Status,
+		ToStatus:   to,
+		Reason:     reason,
+		ChangedBy:  changedBy,
+	}
+}
+
+// GetAllPaymentRequestReq lists the requests the user is asked to pay, or with
+// Direction outgoing the ones they made.
+type GetAllPaymentRequestReq struct {
+	UserId    string `validate:"required,uuid"`
+	Direction string `validate:"oneof=incoming outgoing"`
+	Page      PaginationParam
+	Filter    FilterParams
+	Sort      OrderParam
+}
+type GetAllPaymentRequestRes struct {
+	PaginationData[entity.PaymentRequest]
+}
+
+type GetPaymentRequestByIDReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type GetPaymentRequestByIDRes struct {
+	entity.PaymentRequest
+}
+
+type GetAllPaymentRequestStatusChangeReq struct {
+	RequestId string
+	UserId    string
+	Page      PaginationParam
+	Filter    FilterParams
+	Sort      OrderParam
+}
+type GetAllPaymentRequestStatusChangeRes struct {
+	PaginationData[entity.PaymentRequestStatusChange]
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+)
+
+type CreatePayoutReq struct {
+	UserId        string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	WalletId      string      `json:"wallet_id" validate:"required,uuid"`      // requires the spender role on it
+	DestinationId string      `json:"destination_id" validate:"required,uuid"` // a verified destination of yours
+	Amount        money.Money `json:"amount" validate:"required"`              // in the currency of the wallet
+}
+
+func (req CreatePayoutReq) ToEntity(destination entity.PayoutDestination, provider string) *entity.Payout {
+	return &entity.Payout{
+		Id:            uuid.NewString(),
+		WalletId:      req.WalletId,
+		UserId:        req.UserId,
+		DestinationId: &destination.Id,
+		BankCode:      destination.BankCode,
+		AccountNumber: destination.AccountNumber,
+		HolderName:    destination.HolderName,
+		Amount:        req.Amount,
+		Status:        entity.PayoutStatusPending,
+		Provider:      provider,
+	}
+}
+
+type CreatePayoutRes struct {
+	entity.Payout
+}
+
+type GetAllPayoutReq struct {
+	UserId string
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllPayoutRes struct {
+	PaginationData[entity.Payout]
+}
+
+type GetPayoutByIDReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type GetPayoutByIDRes struct {
+	entity.Payout
+}
+
+// NewPayoutTransaction is the withdrawal of payout from its wallet.
+func NewPayoutTransaction(payout entity.Payout) *entity.Transaction {
+	return &entity.Transaction{
+		Id:              uuid.NewString(),
+		WalletId:        payout.WalletId,
+		Type:            "withdrawal",
+		Direction:       entity.TransactionDirectionOut,
+		Status:          entity.TransactionStatusCompleted,
+		Amount:          payout.Amount,
+		OriginalAmount:  payout.Amount,
+		ConvertedAmount: payout.Amount,
+		ExchangeRate:    money.OneRate(),
+		Description:     "Withdrawal to " + payout.BankCode + " " + payout.AccountNumber,
+		InitiatedBy:     &payout.UserId,
+	}
+}
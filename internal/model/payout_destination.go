@@ -0,0 +1,67 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"strings"
+)
+
+type CreatePayoutDestinationReq struct {
+	UserId        string `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Label         string `json:"label" validate:"max=64" example:"Salary account"`
+	BankCode      string `json:"bank_code" validate:"required,max=16,alphanum" example:"BCA"`
+	AccountNumber string `json:"account_number" validate:"required,max=34,numeric" example:"1234567890"`
+	HolderName    string `json:"holder_name" validate:"required,max=128" example:"John Doe"`
+}
+
+func (req CreatePayoutDestinationReq) ToEntity() *entity.PayoutDestination {
+	return &entity.PayoutDestination{
+		Id:            uuid.NewString(),
+		UserId:        req.UserId,
+		Label:         req.Label,
+		BankCode:      strings.ToUpper(req.BankCode),
+		AccountNumber: req.AccountNumber,
+		HolderName:    req.HolderName,
+		Status:        entity.PayoutDestinationStatusPending,
+	}
+}
+
+type CreatePayoutDestinationRes struct {
+	entity.PayoutDestination
+}
+
+// VerifyPayoutDestinationReq asks the provider again about a destination it could
+// not be asked about when it was saved.
+type VerifyPayoutDestinationReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type VerifyPayoutDestinationRes struct {
+	entity.PayoutDestination
+}
+
+type DeletePayoutDestinationReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type DeletePayoutDestinationRes struct {
+	ID string `json:"id"`
+}
+
+type GetAllPayoutDestinationReq struct {
+	UserId string
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllPayoutDestinationRes struct {
+	PaginationData[entity.PayoutDestination]
+}
+
+type GetPayoutDestinationByIDReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type GetPayoutDestinationByIDRes struct {
+	entity.PayoutDestination
+}
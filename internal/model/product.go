@@ -3,14 +3,15 @@ package model
 import (
 	"github.com/google/uuid"
 	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
 )
 
 type BaseProductReq struct {
-	Name        string  `json:"name" validate:"required"`
-	Price       float64 `json:"price" validate:"required"`
-	Description string  `json:"description"`
-	Quantity    uint    `json:"quantity" validate:"required"`
-	Available   bool    `json:"available"`
+	Name        string      `json:"name" validate:"required"`
+	Price       money.Money `json:"price" validate:"required,gt=0"`
+	Description string      `json:"description"`
+	Quantity    uint        `json:"quantity" validate:"required"`
+	Available   bool        `json:"available"`
 }
 
 type CreateProductReq struct {
@@ -27,7 +28,7 @@ func (req BaseProductReq) ToEntity() *entity.Product {
 	return &entity.Product{
 		Id:          uuid.NewString(),
 		Name:        req.Name,
-		Price:       req.Price,
+		Price:       req.Price.Normalize(),
 		Description: req.Description,
 		Quantity:    req.Quantity,
 		Available:   req.Available,
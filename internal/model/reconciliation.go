@@ -0,0 +1,87 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+// NewReconciliationFinding records that wallet holds actual while its transactions add
+// up to expected and its ledger account to ledger.
+func NewReconciliationFinding(walletId string, expected, actual, ledger money.Money, checkedAt time.Time) *entity.ReconciliationFinding {
+	return &entity.ReconciliationFinding{
+		Id:               uuid.NewString(),
+		WalletId:         walletId,
+		ExpectedBalance:  expected,
+		ActualBalance:    actual,
+		Difference:       money.New(actual.Units-expected.Units, actual.Currency),
+		LedgerBalance:    ledger,
+		LedgerDifference: money.New(actual.Units-ledger.Units, actual.Currency),
+		Status:           entity.ReconciliationFindingStatusOpen,
+		DetectedAt:       checkedAt,
+		CheckedAt:        checkedAt,
+	}
+}
+
+// HistoryDifference is what the transactions of the finding's wallet miss to add up
+// to its ledger balance.
+func HistoryDifference(finding entity.ReconciliationFinding) money.Money {
+	return money.New(finding.LedgerBalance.Units-finding.ExpectedBalance.Units, finding.LedgerBalance.Currency)
+}
+
+// ToAdjustmentEntity books the history difference of a finding as a transaction of its
+// wallet, after which the transactions add up to the ledger balance again. It only
+// completes the transaction history, no money moves as the ledger is left as it is.
+func ToAdjustmentEntity(finding entity.ReconciliationFinding) *entity.Transaction {
+	difference := HistoryDifference(finding)
+	direction := entity.TransactionDirectionIn
+	if difference.IsNegative() {
+		direction = entity.TransactionDirectionOut
+	}
+	return &entity.Transaction{
+		Id:          uuid.NewString(),
+		Type:        "adjustment",
+		Direction:   direction,
+		Status:      entity.TransactionStatusCompleted,
+		Amount:      difference.Abs(),
+		Description: "Reconciliation adjustment",
+		WalletId:    finding.WalletId,
+	}
+}
+
+// RunReconciliationReq checks every wallet, AutoCorrect corrects each mismatch found.
+type RunReconciliationReq struct {
+	AutoCorrect bool `json:"auto_correct"`
+}
+type RunReconciliationRes struct {
+	Checked    int `json:"checked"`    // wallets compared
+	Mismatched int `json:"mismatched"` // wallets whose balance differs from their transactions or their ledger account
+	Corrected  int `json:"corrected"`  // mismatches corrected
+}
+
+type GetAllReconciliationFindingReq struct {
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllReconciliationFindingRes struct {
+	PaginationData[entity.ReconciliationFinding]
+}
+
+type GetReconciliationFindingByIDReq struct {
+	ID string `swaggerignore:"true"`
+}
+type GetReconciliationFindingByIDRes struct {
+	entity.ReconciliationFinding
+}
+
+// CorrectReconciliationFindingReq checks the wallet of an open finding again and corrects
+// what still differs.
+type CorrectReconciliationFindingReq struct {
+	ID string `swaggerignore:"true"`
+}
+type CorrectReconciliationFindingRes struct {
+	Finding    entity.ReconciliationFinding `json:"finding"`
+	Adjustment *entity.Transaction          `json:"adjustment,omitempty"` // absent when the history already matched the ledger
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+type GetAllRewardReq struct {
+	UserId string
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllRewardRes struct {
+	PaginationData[entity.Reward]
+}
+
+type GetRewardByIDReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type GetRewardByIDRes struct {
+	entity.Reward
+}
+
+// NewReward is what campaign grants userId on the transaction source,
+// available once the clearing period of the campaign passed from now.
+func NewReward(campaign entity.Campaign, userId string, source entity.Transaction, amount money.Money, now time.Time) *entity.Reward {
+	return &entity.Reward{
+		Id:                  uuid.NewString(),
+		CampaignId:          campaign.Id,
+		UserId:              userId,
+		WalletId:            source.WalletId,
+		SourceTransactionId: source.Id,
+		Amount:              amount,
+		Status:              entity.RewardStatusPending,
+		AvailableAt:         now.AddDate(0, 0, int(campaign.ClearingDays)),
+	}
+}
+
+// NewRewardTransaction is the income a reward is paid into its wallet with.
+func NewRewardTransaction(reward entity.Reward, campaignName string) *entity.Transaction {
+	return &entity.Transaction{
+		Id:              uuid.NewString(),
+		WalletId:        reward.WalletId,
+		Type:            "reward",
+		Direction:       entity.TransactionDirectionIn,
+		Status:          entity.TransactionStatusCompleted,
+		Amount:          reward.Amount,
+		OriginalAmount:  reward.Amount,
+		ConvertedAmount: reward.Amount,
+		ExchangeRate:    money.OneRate(),
+		Description:     "Reward: " + campaignName,
+	}
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+)
+
+// SpendingAllowance is one limit of a wallet and what is left of it.
+type SpendingAllowance struct {
+	Period    string       `json:"period" example:"daily"`
+	Limit     *money.Money `json:"limit"`     // null when the wallet has no such limit
+	Default   bool         `json:"default"`   // the limit is the configured default, not set on the wallet
+	Used      money.Money  `json:"used"`      // outflows over the rolling window, zero for the per transaction cap
+	Remaining *money.Money `json:"remaining"` // null when the wallet has no such limit
+}
+
+type GetSpendingLimitReq struct {
+	WalletId string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+}
+type GetSpendingLimitRes struct {
+	WalletId string              `json:"wallet_id"`
+	Limits   []SpendingAllowance `json:"limits"`
+}
+
+// UpdateSpendingLimitReq replaces the limits of a wallet, in the wallet's currency.
+// A limit left out falls back to the configured default.
+type UpdateSpendingLimitReq struct {
+	WalletId       string       `json:"-" swaggerignore:"true"`
+	UserId         string       `json:"-" swaggerignore:"true"`
+	PerTransaction *money.Money `json:"per_transaction,omitempty"`
+	Daily          *money.Money `json:"daily,omitempty"`
+	Weekly         *money.Money `json:"weekly,omitempty"`
+	Monthly        *money.Money `json:"monthly,omitempty"`
+}
+
+// Limits lists the limits of the request by period.
+func (req UpdateSpendingLimitReq) Limits() map[string]*money.Money {
+	return map[string]*money.Money{
+		entity.SpendingLimitPerTransaction: req.PerTransaction,
+		entity.SpendingLimitDaily:          req.Daily,
+		entity.SpendingLimitWeekly:         req.Weekly,
+		entity.SpendingLimitMonthly:        req.Monthly,
+	}
+}
+
+func (req UpdateSpendingLimitReq) ToEntity(id string, currency string) *entity.SpendingLimit {
+	if id == "" {
+		id = uuid.NewString()
+	}
+	amount := func(limit *money.Money) money.Money {
+		if limit == nil {
+			return money.Zero(currency)
+		}
+		return *limit
+	}
+	return &entity.SpendingLimit{
+		Id:             id,
+		WalletId:       req.WalletId,
+		PerTransaction: amount(req.PerTransaction),
+		Daily:          amount(req.Daily),
+		Weekly:         amount(req.Weekly),
+		Monthly:        amount(req.Monthly),
+	}
+}
+
+type UpdateSpendingLimitRes struct {
+	GetSpendingLimitRes
+}
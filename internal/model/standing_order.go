@@ -0,0 +1,123 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+type CreateStandingOrderReq struct {
+	WalletId    string      `json:"-" swaggerignore:"true"`
+	UserId      string      `json:"-" validate:"required,uuid" swaggerignore:"true"` // runs transfer on behalf of this user
+	ReceiverId  string      `json:"receiver_id" validate:"required,uuid"`
+	Amount      money.Money `json:"amount" validate:"required"` // in the sender or the receiver currency, the other side is converted on each run
+	Description string      `json:"description" example:"Rent"`
+	Schedule    string      `json:"schedule" validate:"required" example:"monthly"` // daily, weekly, monthly or a cron expression like "0 9 1 * *"
+	StartAt     *time.Time  `json:"start_at,omitempty"`                             // defaults to now, daily, weekly and monthly repeat from it
+	EndAt       *time.Time  `json:"end_at,omitempty"`                               // no run is made after it
+}
+
+func (req CreateStandingOrderReq) ToEntity(startAt time.Time) *entity.StandingOrder {
+	return &entity.StandingOrder{
+		Id:          uuid.NewString(),
+		WalletId:    req.WalletId,
+		ReceiverId:  req.ReceiverId,
+		Amount:      req.Amount,
+		Description: req.Description,
+		Schedule:    req.Schedule,
+		StartAt:     startAt,
+		EndAt:       req.EndAt,
+		Status:      entity.StandingOrderStatusActive,
+		CreatedBy:   req.UserId,
+	}
+}
+
+type CreateStandingOrderRes struct {
+	entity.StandingOrder
+}
+
+type GetAllStandingOrderReq struct {
+	WalletId string
+	UserId   string
+	Page     PaginationParam
+	Filter   FilterParams
+	Sort     OrderParam
+}
+type GetAllStandingOrderRes struct {
+	PaginationData[entity.StandingOrder]
+}
+
+type GetStandingOrderByIDReq struct {
+	WalletId string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+	ID       string `swaggerignore:"true"`
+}
+type GetStandingOrderByIDRes struct {
+	entity.StandingOrder
+}
+
+type GetAllStandingOrderRunReq struct {
+	WalletId string
+	UserId   string
+	ID       string
+	Page     PaginationParam
+	Filter   FilterParams
+	Sort     OrderParam
+}
+type GetAllStandingOrderRunRes struct {
+	PaginationData[entity.StandingOrderRun]
+}
+
+type PauseStandingOrderReq struct {
+	WalletId string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+	ID       string `swaggerignore:"true"`
+}
+type PauseStandingOrderRes struct {
+	entity.StandingOrder
+}
+
+// ResumeStandingOrderReq reactivates a paused standing order, the runs missed while paused are skipped.
+type ResumeStandingOrderReq struct {
+	WalletId string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+	ID       string `swaggerignore:"true"`
+}
+type ResumeStandingOrderRes struct {
+	entity.StandingOrder
+}
+
+type CancelStandingOrderReq struct {
+	WalletId string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+	ID       string `swaggerignore:"true"`
+}
+type CancelStandingOrderRes struct {
+	entity.StandingOrder
+}
+
+// ToStandingOrderTransferReq is the transfer a run of order makes.
+func ToStandingOrderTransferReq(order entity.StandingOrder) *TransferTransactionReq {
+	return &TransferTransactionReq{
+		SenderId:   order.WalletId,
+		ReceiverId: order.ReceiverId,
+		Amount:     order.Amount,
+		UserId:     order.CreatedBy,
+	}
+}
+
+func NewStandingOrderRun(order entity.StandingOrder, attempt int, transactionId *string, err string) *entity.StandingOrderRun {
+	status := entity.StandingOrderRunSucceeded
+	if err != "" {
+		status = entity.StandingOrderRunFailed
+	}
+	return &entity.StandingOrderRun{
+		Id:              uuid.NewString(),
+		StandingOrderId: order.Id,
+		Status:          status,
+		Attempt:         attempt,
+		TransactionId:   transactionId,
+		Error:           err,
+	}
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type GenerateStatementReq struct {
+	WalletId string `json:"-" swaggerignore:"true"`
+	UserId   string `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Period   string `json:"period" validate:"required" example:"2026-09"` // a closed calendar month, YYYY-MM in UTC
+}
+
+func (req GenerateStatementReq) ToEntity(start time.Time) *entity.Statement {
+	return &entity.Statement{
+		Id:          uuid.NewString(),
+		WalletId:    req.WalletId,
+		Period:      req.Period,
+		PeriodStart: start,
+		PeriodEnd:   start.AddDate(0, 1, 0),
+		Version:     1,
+	}
+}
+
+type GenerateStatementRes struct {
+	entity.Statement
+}
+
+type GetAllStatementReq struct {
+	WalletId string
+	UserId   string
+	Page     PaginationParam
+	Filter   FilterParams
+	Sort     OrderParam
+}
+type GetAllStatementRes struct {
+	PaginationData[entity.Statement]
+}
+
+type GetStatementByIDReq struct {
+	WalletId string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+	ID       string `swaggerignore:"true"`
+}
+type GetStatementByIDRes struct {
+	entity.Statement
+}
+
+// RegenerateStatementReq rebuilds a statement from the transactions as they stand now.
+type RegenerateStatementReq struct {
+	WalletId string `swaggerignore:"true"`
+	UserId   string `swaggerignore:"true"`
+	ID       string `swaggerignore:"true"`
+}
+type RegenerateStatementRes struct {
+	entity.Statement
+}
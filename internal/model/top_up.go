@@ -0,0 +1,80 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
+)
+
+type CreateTopUpReq struct {
+	UserId   string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	WalletId string      `json:"wallet_id" validate:"required,uuid"` // requires the spender role on it
+	Amount   money.Money `json:"amount" validate:"required"`         // in the currency of the wallet
+}
+
+func (req CreateTopUpReq) ToEntity(provider string, expiresAt time.Time) *entity.TopUp {
+	return &entity.TopUp{
+		Id:        uuid.NewString(),
+		WalletId:  req.WalletId,
+		UserId:    req.UserId,
+		Amount:    req.Amount,
+		Status:    entity.TopUpStatusPending,
+		Provider:  provider,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// CreateTopUpRes is the pending top up, the user pays it at its checkout URL.
+type CreateTopUpRes struct {
+	entity.TopUp
+}
+
+type GetAllTopUpReq struct {
+	UserId string
+	Page   PaginationParam
+	Filter FilterParams
+	Sort   OrderParam
+}
+type GetAllTopUpRes struct {
+	PaginationData[entity.TopUp]
+}
+
+type GetTopUpByIDReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
+}
+type GetTopUpByIDRes struct {
+	entity.TopUp
+}
+
+// TopUpWebhookReq is a webhook as the provider delivered it, the payload is kept
+// raw so its signature can be checked.
+type TopUpWebhookReq struct {
+	Payload   []byte
+	Signature string
+}
+type TopUpWebhookRes struct {
+	entity.TopUp
+}
+
+// NewTopUpTransaction is the income of a succeeded top up.
+func NewTopUpTransaction(topUp entity.TopUp) *entity.Transaction {
+	description := "Top up via " + topUp.Provider
+	if topUp.CheckoutReference != nil {
+		description += ": " + *topUp.CheckoutReference
+	}
+	return &entity.Transaction{
+		Id:              uuid.NewString(),
+		WalletId:        topUp.WalletId,
+		Type:            "income",
+		Direction:       entity.TransactionDirectionIn,
+		Status:          entity.TransactionStatusCompleted,
+		Amount:          topUp.Amount,
+		OriginalAmount:  topUp.Amount,
+		ConvertedAmount: topUp.Amount,
+		ExchangeRate:    money.OneRate(),
+		Description:     description,
+		InitiatedBy:     &topUp.UserId,
+	}
+}
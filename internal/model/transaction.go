@@ -3,13 +3,16 @@ package model
 import (
 	"github.com/google/uuid"
 	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
 	"product-wallet/pkg/utils/converter"
 )
 
 type BaseTransactionReq struct {
+	UserId          string  `json:"-" validate:"required,uuid" swaggerignore:"true"`
 	WalletId        string  `json:"wallet_id" validate:"required,uuid"`
 	ProductId       *string `json:"product_id,omitempty" validate:"required,uuid"`
-	ProductQuantity *uint   `json:"product_quantity,omitempty" validate:"required,number"`
+	ProductQuantity *uint   `json:"product_quantity,omitempty" validate:"required,number,gt=0"`
+	CategoryId      *string `json:"category_id,omitempty" validate:"omitempty,uuid"` // left to the wallet rules, then Shopping
 }
 
 type CreateTransactionReq struct {
@@ -18,10 +21,15 @@ type CreateTransactionReq struct {
 
 func (req BaseTransactionReq) ToEntity() *entity.Transaction {
 	return &entity.Transaction{
-		Id:        uuid.NewString(),
-		ProductId: req.ProductId,
-		WalletId:  req.WalletId,
-		Type:      "expense",
+		Id:              uuid.NewString(),
+		ProductId:       req.ProductId,
+		ProductQuantity: *req.ProductQuantity,
+		WalletId:        req.WalletId,
+		Type:            "expense",
+		Direction:       entity.TransactionDirectionOut,
+		Status:          entity.TransactionStatusCompleted,
+		InitiatedBy:     &req.UserId,
+		CategoryId:      req.CategoryId,
 	}
 }
 
@@ -40,28 +48,10 @@ func (req BaseTransactionReq) ToEntity() *entity.Transaction {
 //	}
 //}
 
-func (req BaseTransactionReq) ToProductEntity(product *entity.Product) *entity.Product {
-	var (
-		quantity uint
-	)
-	quantity = product.Quantity - *req.ProductQuantity
-	if quantity == 0 {
-		product.Available = false
-	} else {
-		product.Available = true
-	}
-	return &entity.Product{
-		Id:          product.Id,
-		Name:        product.Name,
-		Price:       product.Price,
-		Description: product.Description,
-		Quantity:    product.Quantity - *req.ProductQuantity,
-		Available:   product.Available,
-	}
-}
-
 type CreateTransactionRes struct {
 	entity.Transaction
+	FeeTransaction *entity.Transaction `json:"fee_transaction,omitempty"` // the fee charged on top, if any
+	Rewards        []entity.Reward     `json:"rewards,omitempty"`         // granted by the campaigns it qualified for
 }
 
 type UpdateTransactionReq struct {
@@ -72,14 +62,29 @@ type UpdateTransactionRes struct {
 	entity.Transaction
 }
 
-type DeleteTransactionReq struct {
-	ID string `swaggerignore:"true"`
+type ReverseTransactionReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
 }
-type DeleteTransactionRes struct {
-	ID string `swaggerignore:"true"`
+type ReverseTransactionRes struct {
+	Original      entity.Transaction   `json:"original"`
+	Compensations []entity.Transaction `json:"compensations"`
+}
+
+// RefundTransactionReq refunds part of a purchase, either a number of the items
+// bought, which go back in stock, or an amount in the wallet currency.
+type RefundTransactionReq struct {
+	ID       string       `json:"-" swaggerignore:"true"`
+	UserId   string       `json:"-" swaggerignore:"true"`
+	Quantity *uint        `json:"quantity,omitempty" validate:"omitempty,gt=0" example:"1"`
+	Amount   *money.Money `json:"amount,omitempty"`
+}
+type RefundTransactionRes struct {
+	ReverseTransactionRes
 }
 
 type GetAllTransactionReq struct {
+	UserId string
 	Page   PaginationParam
 	Filter FilterParams
 	Sort   OrderParam
@@ -89,7 +94,8 @@ type GetAllTransactionRes struct {
 }
 
 type GetTransactionByIDReq struct {
-	ID string `swaggerignore:"true"`
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
 }
 
 type GetTransactionByIDRes struct {
@@ -97,49 +103,136 @@ type GetTransactionByIDRes struct {
 }
 
 type CreditTransactionReq struct {
-	WalletId string  `json:"wallet_id" validate:"required"`
-	Amount   float64 `json:"amount" validate:"required"`
+	UserId     string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	WalletId   string      `json:"wallet_id" validate:"required"`
+	Amount     money.Money `json:"amount" validate:"required"`                      // converted into the wallet currency when it differs
+	CategoryId *string     `json:"category_id,omitempty" validate:"omitempty,uuid"` // left to the wallet rules, then Top Up
 }
 type CreditTransactionRes struct {
 	entity.Transaction
 }
 
-func (req CreditTransactionReq) ToEntity() *entity.Transaction {
+// ToEntity books the credit of req.Amount as credited, its value in the wallet's currency.
+func (req CreditTransactionReq) ToEntity(credited money.Money, rate money.Rate) *entity.Transaction {
 	return &entity.Transaction{
-		Id:          uuid.NewString(),
-		WalletId:    req.WalletId,
-		Type:        "income",
-		Amount:      req.Amount,
-		Description: "Credit of " + converter.ToString(req.Amount),
+		Id:              uuid.NewString(),
+		WalletId:        req.WalletId,
+		Type:            "income",
+		Direction:       entity.TransactionDirectionIn,
+		Status:          entity.TransactionStatusCompleted,
+		Amount:          credited,
+		OriginalAmount:  req.Amount,
+		ConvertedAmount: credited,
+		ExchangeRate:    rate,
+		Description:     "Credit of " + converter.ToString(req.Amount),
+		InitiatedBy:     &req.UserId,
+		CategoryId:      req.CategoryId,
 	}
 }
 
+// TransferTransactionReq pays into the wallet ReceiverId, or into a wallet of the
+// user going by ReceiverUsername: the one named ReceiverWallet, else their default.
 type TransferTransactionReq struct {
-	SenderId   string  `json:"wallet_id" validate:"required"`
-	ReceiverId string  `json:"receiver_id" validate:"required"`
-	Amount     float64 `json:"amount" validate:"required"`
+	UserId           string      `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	SenderId         string      `json:"wallet_id" validate:"required"`
+	ReceiverId       string      `json:"receiver_id,omitempty" validate:"required_without=ReceiverUsername,excluded_with=ReceiverUsername"`
+	ReceiverUsername string      `json:"receiver_username,omitempty" example:"jane_doe"`
+	ReceiverWallet   string      `json:"receiver_wallet,omitempty" validate:"excluded_without=ReceiverUsername" example:"savings"`
+	Amount           money.Money `json:"amount" validate:"required"`                      // in the sender or the receiver currency, the other side is converted
+	CategoryId       *string     `json:"category_id,omitempty" validate:"omitempty,uuid"` // of the sender side, left to the wallet rules, then Transfer
 }
 type TransferTransactionRes struct {
-	SenderTransaction   entity.Transaction `json:"sender_transaction"`
-	ReceiverTransaction entity.Transaction `json:"receiver_transaction"`
+	SenderTransaction   entity.Transaction  `json:"sender_transaction"`
+	ReceiverTransaction entity.Transaction  `json:"receiver_transaction"`
+	FeeTransaction      *entity.Transaction `json:"fee_transaction,omitempty"` // the fee charged to the sender, if any
+}
+
+// PreviewTransferRes is who a transfer would pay and how much, for the payer to
+// confirm before sending it to ReceiverId.
+type PreviewTransferRes struct {
+	ReceiverId         string      `json:"receiver_id"`
+	ReceiverName       string      `json:"receiver_name" example:"j******e"` // masked username of the user the wallet belongs to
+	ReceiverWalletName string      `json:"receiver_wallet_name" example:"savings"`
+	Debit              money.Money `json:"debit"`  // what would leave the sender wallet
+	Credit             money.Money `json:"credit"` // what would reach the receiver wallet
+	ExchangeRate       money.Rate  `json:"exchange_rate" swaggertype:"string" example:"15750.5"`
+	Fee                money.Money `json:"fee"`   // charged to the sender on top of Debit
+	Total              money.Money `json:"total"` // Debit and Fee together
+}
+
+// TransferParty names one end of a transfer in descriptions, by its user and wallet.
+func TransferParty(username, walletName string) string {
+	if username == "" {
+		return walletName
+	}
+	return username + " (" + walletName + ")"
 }
 
-func (req TransferTransactionReq) ToSenderEntity(receiverName, senderWalletID string) *entity.Transaction {
+// ToSenderEntity books debit, what leaves the sender wallet, credit being what reaches the receiver at rate.
+func (req TransferTransactionReq) ToSenderEntity(
+	receiver, senderWalletID string, debit, credit money.Money, rate money.Rate,
+) *entity.Transaction {
 	return &entity.Transaction{
-		Id:          uuid.NewString(),
-		Type:        "transfer",
-		Amount:      req.Amount,
-		Description: "Transfer to: " + receiverName,
-		WalletId:    senderWalletID,
+		Id:                   uuid.NewString(),
+		Type:                 "transfer",
+		Direction:            entity.TransactionDirectionOut,
+		Status:               entity.TransactionStatusCompleted,
+		Amount:               debit,
+		OriginalAmount:       debit,
+		ConvertedAmount:      credit,
+		ExchangeRate:         rate,
+		Description:          "Transfer to: " + receiver,
+		WalletId:             senderWalletID,
+		CounterpartyWalletId: &req.ReceiverId,
+		InitiatedBy:          &req.UserId,
+		CategoryId:           req.CategoryId,
 	}
 }
 
-func (req TransferTransactionReq) ToReceiverEntity(senderName, receiverWalletID string) *entity.Transaction {
+func (req TransferTransactionReq) ToReceiverEntity(
+	sender, receiverWalletID string, debit, credit money.Money, rate money.Rate,
+) *entity.Transaction {
 	return &entity.Transaction{
-		Id:          uuid.NewString(),
-		Type:        "transfer",
-		Amount:      req.Amount,
-		Description: "Transfer from: " + senderName,
-		WalletId:    receiverWalletID,
+		Id:                   uuid.NewString(),
+		Type:                 "transfer",
+		Direction:            entity.TransactionDirectionIn,
+		Status:               entity.TransactionStatusCompleted,
+		Amount:               credit,
+		OriginalAmount:       debit,
+		ConvertedAmount:      credit,
+		ExchangeRate:         rate,
+		Description:          "Transfer from: " + sender,
+		WalletId:             receiverWalletID,
+		CounterpartyWalletId: &req.SenderId,
+		InitiatedBy:          &req.UserId,
 	}
 }
+
+// ToReversalEntity books the compensating transaction of original for amount.
+func (req ReverseTransactionReq) ToReversalEntity(original entity.Transaction, amount money.Money) *entity.Transaction {
+	return &entity.Transaction{
+		Id:                   uuid.NewString(),
+		Type:                 "reversal",
+		Direction:            entity.ReversedDirection(original.Direction),
+		Status:               entity.TransactionStatusCompleted,
+		Amount:               amount,
+		ReversalOfId:         &original.Id,
+		ProductId:            original.ProductId,
+		Description:          "Reversal of: " + original.Description,
+		WalletId:             original.WalletId,
+		InitiatedBy:          &req.UserId,
+		CategoryId:           original.CategoryId,
+		Tags:                 original.Tags,
+		CounterpartyWalletId: original.CounterpartyWalletId,
+	}
+}
+
+// CategorizeTransactionReq files a transaction under another category.
+type CategorizeTransactionReq struct {
+	ID         string `json:"-" swaggerignore:"true"`
+	UserId     string `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	CategoryId string `json:"category_id" validate:"required,uuid"`
+}
+type CategorizeTransactionRes struct {
+	entity.Transaction
+}
@@ -19,6 +19,7 @@ func (req BaseUserReq) ToEntity(password string) *entity.User {
 		Id:       uuid.NewString(),
 		Username: req.Username,
 		Password: password,
+		Role:     entity.UserRoleUser,
 	}
 }
 
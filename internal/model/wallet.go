@@ -3,12 +3,15 @@ package model
 import (
 	"github.com/google/uuid"
 	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/statement"
 	"time"
 )
 
 type BaseWalletReq struct {
-	Name   string `json:"name" example:"personal"`
-	UserId string `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Name     string `json:"name" example:"personal"`
+	Currency string `json:"currency,omitempty" validate:"omitempty,currency" example:"IDR"` // fixed at creation, defaults to the configured currency
+	UserId   string `json:"-" validate:"required,uuid" swaggerignore:"true"`
 }
 
 type CreateWalletReq struct {
@@ -16,10 +19,14 @@ type CreateWalletReq struct {
 }
 
 func (req BaseWalletReq) ToEntity() *entity.Wallet {
+	balance := money.Zero(req.Currency)
 	return &entity.Wallet{
-		Id:     uuid.NewString(),
-		Name:   req.Name,
-		UserId: req.UserId,
+		Id:       uuid.NewString(),
+		Name:     req.Name,
+		Currency: balance.Currency,
+		UserId:   req.UserId,
+		Balance:  balance,
+		Status:   entity.WalletStatusActive,
 	}
 }
 
@@ -35,14 +42,70 @@ type UpdateWalletRes struct {
 	entity.Wallet
 }
 
-type DeleteWalletReq struct {
-	ID string `swaggerignore:"true"`
+// CloseWalletReq closes a wallet of its owner for good. A wallet holding money is
+// closed only once it is swept, SweepTo names the wallet its balance goes to.
+type CloseWalletReq struct {
+	ID      string `swaggerignore:"true"`
+	UserId  string `validate:"required,uuid" swaggerignore:"true"`
+	SweepTo string `validate:"omitempty,uuid" swaggerignore:"true"`
 }
-type DeleteWalletRes struct {
-	ID string `swaggerignore:"true"`
+type CloseWalletRes struct {
+	Wallet entity.Wallet           `json:"wallet"`
+	Sweep  *TransferTransactionRes `json:"sweep,omitempty"` // the transfer of the remaining balance
+}
+
+// SetDefaultWalletReq makes a wallet the one transfers to its user's username go to.
+type SetDefaultWalletReq struct {
+	ID     string `swaggerignore:"true"`
+	UserId string `validate:"required,uuid" swaggerignore:"true"`
+}
+type SetDefaultWalletRes struct {
+	entity.Wallet
+}
+
+// ChangeWalletStatusReq freezes, suspends or unfreezes a wallet, the reason is kept in its status history.
+type ChangeWalletStatusReq struct {
+	ID        string `json:"-" swaggerignore:"true"`
+	Reason    string `json:"reason" validate:"required" example:"chargeback investigation"`
+	ChangedBy string `json:"-" validate:"required,uuid" swaggerignore:"true"`
+}
+type ChangeWalletStatusRes struct {
+	entity.Wallet
+}
+
+// SetWalletTierReq moves a wallet to another tier, which changes the fees it pays.
+type SetWalletTierReq struct {
+	ID   string `json:"-" swaggerignore:"true"`
+	Tier string `json:"tier" validate:"required,oneof=standard premium business" example:"premium"`
+}
+type SetWalletTierRes struct {
+	entity.Wallet
+}
+
+func NewWalletStatusChange(wallet entity.Wallet, to, reason, changedBy string) *entity.WalletStatusChange {
+	return &entity.WalletStatusChange{
+		Id:         uuid.NewString(),
+		WalletId:   wallet.Id,
+		FromStatus: wallet.StatusCode(),
+		ToStatus:   to,
+		Reason:     reason,
+		ChangedBy:  changedBy,
+	}
+}
+
+type GetAllWalletStatusChangeReq struct {
+	WalletId string
+	UserId   string
+	Page     PaginationParam
+	Filter   FilterParams
+	Sort     OrderParam
+}
+type GetAllWalletStatusChangeRes struct {
+	PaginationData[entity.WalletStatusChange]
 }
 
 type GetAllWalletReq struct {
+	UserId string
 	Page   PaginationParam
 	Filter FilterParams
 	Sort   OrderParam
@@ -52,17 +115,21 @@ type GetAllWalletRes struct {
 }
 
 type GetWalletByIDReq struct {
-	ID string `swaggerignore:"true"`
+	ID     string `swaggerignore:"true"`
+	UserId string `swaggerignore:"true"`
 }
 
 type GetWalletByIDRes struct {
 	entity.Wallet
+	LedgerBalance    money.Money `json:"ledger_balance"`    // booked on the ledger
+	AvailableBalance money.Money `json:"available_balance"` // ledger balance minus active holds
 }
 
 type GetWalletByTransactionReq struct {
-	ID   string    `swaggerignore:"true"`
-	From time.Time `swaggerignore:"true"`
-	To   time.Time `swaggerignore:"true"`
+	ID     string    `swaggerignore:"true"`
+	UserId string    `swaggerignore:"true"`
+	From   time.Time `swaggerignore:"true"`
+	To     time.Time `swaggerignore:"true"`
 }
 
 type GetWalletByTransactionRes struct {
@@ -70,6 +137,80 @@ type GetWalletByTransactionRes struct {
 	Transaction []entity.Transaction `json:"transaction"`
 }
 
+type ExportStatementReq struct {
+	ID     string           `swaggerignore:"true"`
+	UserId string           `swaggerignore:"true"`
+	From   time.Time        `swaggerignore:"true"`
+	To     time.Time        `swaggerignore:"true"`
+	Format statement.Format `swaggerignore:"true"`
+}
+
 func NewGetWalletByTransactionRes(wallet entity.Wallet, transaction []entity.Transaction) *GetWalletByTransactionRes {
 	return &GetWalletByTransactionRes{Wallet: wallet, Transaction: transaction}
 }
+
+func (req BaseWalletReq) ToOwnerEntity(walletId string) *entity.WalletMember {
+	return &entity.WalletMember{
+		Id:       uuid.NewString(),
+		WalletId: walletId,
+		UserId:   req.UserId,
+		Role:     entity.WalletRoleOwner,
+	}
+}
+
+// AddWalletMemberReq lets an owner share a wallet with another user, found by username.
+type AddWalletMemberReq struct {
+	WalletId  string       `json:"-" swaggerignore:"true"`
+	InvitedBy string       `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Username  string       `json:"username" validate:"required" example:"jane_doe"`
+	Role      string       `json:"role" validate:"required,oneof=owner spender viewer" example:"spender"`
+	Allowance *money.Money `json:"allowance,omitempty"` // what a spender may spend over 30 days, in the wallet currency
+}
+
+func (req AddWalletMemberReq) ToEntity(userId string, allowance money.Money) *entity.WalletMember {
+	return &entity.WalletMember{
+		Id:        uuid.NewString(),
+		WalletId:  req.WalletId,
+		UserId:    userId,
+		Role:      req.Role,
+		Allowance: allowance,
+		InvitedBy: &req.InvitedBy,
+	}
+}
+
+type AddWalletMemberRes struct {
+	entity.WalletMember
+}
+
+// UpdateWalletMemberReq changes the role or the allowance of a member, a zero allowance lifts it.
+type UpdateWalletMemberReq struct {
+	WalletId  string       `json:"-" swaggerignore:"true"`
+	ID        string       `json:"-" swaggerignore:"true"`
+	UserId    string       `json:"-" validate:"required,uuid" swaggerignore:"true"`
+	Role      string       `json:"role,omitempty" validate:"omitempty,oneof=owner spender viewer" example:"viewer"`
+	Allowance *money.Money `json:"allowance,omitempty"`
+}
+type UpdateWalletMemberRes struct {
+	entity.WalletMember
+}
+
+// RemoveWalletMemberReq takes a member off a wallet, members may also remove themselves.
+type RemoveWalletMemberReq struct {
+	WalletId string `swaggerignore:"true"`
+	ID       string `swaggerignore:"true"`
+	UserId   string `validate:"required,uuid" swaggerignore:"true"`
+}
+type RemoveWalletMemberRes struct {
+	ID string `json:"id"`
+}
+
+type GetAllWalletMemberReq struct {
+	WalletId string
+	UserId   string
+	Page     PaginationParam
+	Filter   FilterParams
+	Sort     OrderParam
+}
+type GetAllWalletMemberRes struct {
+	PaginationData[entity.WalletMember]
+}
@@ -0,0 +1,9 @@
+package repository
+
+import (
+	"product-wallet/internal/entity"
+)
+
+type BillSplitRepository interface {
+	CommonQuery[entity.BillSplit]
+}
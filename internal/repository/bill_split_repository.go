@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"product-wallet/internal/entity"
+)
+
+type BillSplitSQLRepo struct {
+	Repository[entity.BillSplit]
+}
+
+func NewBillSplitSQLRepository() BillSplitRepository {
+	return &BillSplitSQLRepo{}
+}
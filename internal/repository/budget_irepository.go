@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+)
+
+type BudgetRepository interface {
+	CommonQuery[entity.Budget]
+	FindByWalletAndCategory(ctx context.Context, tx *gorm.DB, walletId string, categoryId string) (*entity.Budget, error)
+	FindByWalletId(ctx context.Context, tx *gorm.DB, walletId string) ([]entity.Budget, error)
+}
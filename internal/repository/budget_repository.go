@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+)
+
+type BudgetSQLRepo struct {
+	Repository[entity.Budget]
+}
+
+func NewBudgetSQLRepository() BudgetRepository {
+	return &BudgetSQLRepo{}
+}
+
+func (r *BudgetSQLRepo) FindByWalletAndCategory(ctx context.Context, tx *gorm.DB, walletId string, categoryId string) (*entity.Budget, error) {
+	var data entity.Budget
+	if err := tx.WithContext(ctx).Where("wallet_id = ? AND category_id = ?", walletId, categoryId).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find budget", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// FindByWalletId returns the budgets of a wallet with their category.
+func (r *BudgetSQLRepo) FindByWalletId(ctx context.Context, tx *gorm.DB, walletId string) ([]entity.Budget, error) {
+	var data []entity.Budget
+	if err := tx.WithContext(ctx).Preload("Category").Where("wallet_id = ?", walletId).
+		Order("created_at asc").Find(&data).Error; err != nil {
+		slog.Error("failed to find budgets", "error", err)
+		return nil, err
+	}
+	return data, nil
+}
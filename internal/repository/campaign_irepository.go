@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type CampaignRepository interface {
+	CommonQuery[entity.Campaign]
+	FindRunning(ctx context.Context, tx *gorm.DB, trigger, currency string, at time.Time) (*[]entity.Campaign, error)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type CampaignSQLRepo struct {
+	Repository[entity.Campaign]
+}
+
+func NewCampaignSQLRepository() CampaignRepository {
+	return &CampaignSQLRepo{}
+}
+
+// FindRunning returns, in id order and without locking them, the active campaigns
+// rewarding trigger in currency that run at the given time.
+func (r *CampaignSQLRepo) FindRunning(
+	ctx context.Context, tx *gorm.DB, trigger, currency string, at time.Time,
+) (*[]entity.Campaign, error) {
+	var data []entity.Campaign
+	if err := tx.WithContext(ctx).
+		Where("trigger_type = ? AND currency = ? AND active = ?", trigger, currency, true).
+		Where("starts_at <= ? AND ends_at > ?", at, at).
+		Order("id asc").
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find running campaigns", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
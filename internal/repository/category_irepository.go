@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+)
+
+type CategoryRepository interface {
+	CommonQuery[entity.Category]
+	// FindByName matches case insensitively among the categories of userId, or the system ones when it is nil
+	FindByName(ctx context.Context, tx *gorm.DB, userId *string, name string) (*entity.Category, error)
+	FindVisibleByPagination(
+		ctx context.Context, tx *gorm.DB, userId string, page model.PaginationParam, order model.OrderParam,
+		filter model.FilterParams,
+	) (*model.PaginationData[entity.Category], error)
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+)
+
+type CategorySQLRepo struct {
+	Repository[entity.Category]
+}
+
+func NewCategorySQLRepository() CategoryRepository {
+	return &CategorySQLRepo{}
+}
+
+func (r *CategorySQLRepo) FindByName(ctx context.Context, tx *gorm.DB, userId *string, name string) (*entity.Category, error) {
+	var data entity.Category
+	query := tx.WithContext(ctx).Where("lower(name) = lower(?)", name)
+	if userId == nil {
+		query = query.Where("user_id IS NULL")
+	} else {
+		query = query.Where("user_id = ?", *userId)
+	}
+	if err := query.First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find category", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// FindVisibleByPagination pages through the system categories and those of userId.
+func (r *CategorySQLRepo) FindVisibleByPagination(
+	ctx context.Context, tx *gorm.DB, userId string, page model.PaginationParam, order model.OrderParam,
+	filter model.FilterParams,
+) (*model.PaginationData[entity.Category], error) {
+	return r.FindByPagination(ctx, tx.Where("user_id IS NULL OR user_id = ?", userId), page, order, filter)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+)
+
+type CategoryRuleRepository interface {
+	CommonQuery[entity.CategoryRule]
+	FindByWalletId(ctx context.Context, tx *gorm.DB, walletId string) ([]entity.CategoryRule, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+)
+
+type CategoryRuleSQLRepo struct {
+	Repository[entity.CategoryRule]
+}
+
+func NewCategoryRuleSQLRepository() CategoryRuleRepository {
+	return &CategoryRuleSQLRepo{}
+}
+
+// FindByWalletId returns the rules of a wallet in the order they run.
+func (r *CategoryRuleSQLRepo) FindByWalletId(ctx context.Context, tx *gorm.DB, walletId string) ([]entity.CategoryRule, error) {
+	var data []entity.CategoryRule
+	if err := tx.WithContext(ctx).Where("wallet_id = ?", walletId).
+		Order("priority asc, created_at asc, id asc").Find(&data).Error; err != nil {
+		slog.Error("failed to find category rules", "error", err)
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+)
+
+type ExchangeRateRepository interface {
+	CommonQuery[entity.ExchangeRate]
+	FindPair(ctx context.Context, tx *gorm.DB, base, quote string) (*entity.ExchangeRate, error)
+}
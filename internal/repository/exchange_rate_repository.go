@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+)
+
+type ExchangeRateSQLRepo struct {
+	Repository[entity.ExchangeRate]
+}
+
+func NewExchangeRateSQLRepository() ExchangeRateRepository {
+	return &ExchangeRateSQLRepo{}
+}
+
+func (r *ExchangeRateSQLRepo) FindPair(ctx context.Context, tx *gorm.DB, base, quote string) (*entity.ExchangeRate, error) {
+	var data entity.ExchangeRate
+	if err := tx.WithContext(ctx).Where("base_currency = ? AND quote_currency = ?", base, quote).
+		First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find exchange rate", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
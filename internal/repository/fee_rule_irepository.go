@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+)
+
+type FeeRuleRepository interface {
+	CommonQuery[entity.FeeRule]
+	FindActive(ctx context.Context, tx *gorm.DB, operation, currency string) (*[]entity.FeeRule, error)
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+)
+
+type FeeRuleSQLRepo struct {
+	Repository[entity.FeeRule]
+}
+
+func NewFeeRuleSQLRepository() FeeRuleRepository {
+	return &FeeRuleSQLRepo{}
+}
+
+// FindActive returns the active rules pricing operation on wallets of currency,
+// the ones for a tier first and then by the smallest amount they cover, largest first.
+func (r *FeeRuleSQLRepo) FindActive(ctx context.Context, tx *gorm.DB, operation, currency string) (*[]entity.FeeRule, error) {
+	var data []entity.FeeRule
+	if err := tx.WithContext(ctx).
+		Where("operation = ? AND currency = ? AND active = ?", operation, currency, true).
+		Order("tier DESC").Order("from_units DESC").Order("created_at DESC").
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find fee rules", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
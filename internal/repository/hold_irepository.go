@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type HoldRepository interface {
+	CommonQuery[entity.Hold]
+	SumActiveTx(ctx context.Context, tx *gorm.DB, walletId string, now time.Time, excludeIds ...string) (int64, error)
+	ExpireTx(ctx context.Context, tx *gorm.DB, now time.Time) (int64, error)
+}
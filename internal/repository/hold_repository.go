@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type HoldSQLRepo struct {
+	Repository[entity.Hold]
+}
+
+func NewHoldSQLRepository() HoldRepository {
+	return &HoldSQLRepo{}
+}
+
+// SumActiveTx returns the minor units still reserved on a wallet by authorized holds,
+// leaving out the holds of excludeIds.
+func (r *HoldSQLRepo) SumActiveTx(
+	ctx context.Context, tx *gorm.DB, walletId string, now time.Time, excludeIds ...string,
+) (int64, error) {
+	var total int64
+	query := tx.WithContext(ctx).Model(&entity.Hold{}).
+		Select("COALESCE(SUM(amount_units), 0)").
+		Where("wallet_id = ? AND status = ?", walletId, entity.HoldStatusAuthorized).
+		Where("expires_at IS NULL OR expires_at > ?", now)
+	if len(excludeIds) > 0 {
+		query = query.Where("id NOT IN ?", excludeIds)
+	}
+	if err := query.Scan(&total).Error; err != nil {
+		slog.Error("failed to sum active holds", "error", err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// ExpireTx marks the authorized holds past their expiry as expired.
+func (r *HoldSQLRepo) ExpireTx(ctx context.Context, tx *gorm.DB, now time.Time) (int64, error) {
+	result := tx.WithContext(ctx).Model(&entity.Hold{}).
+		Where("status = ? AND expires_at <= ?", entity.HoldStatusAuthorized, now).
+		Update("status", entity.HoldStatusExpired)
+	if result.Error != nil {
+		slog.Error("failed to expire holds", "error", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type IdempotencyKeyRepository interface {
+	CommonQuery[entity.IdempotencyKey]
+	FindByKey(ctx context.Context, tx *gorm.DB, userId, key string) (*entity.IdempotencyKey, error)
+	InsertTx(ctx context.Context, tx *gorm.DB, data *entity.IdempotencyKey) error
+	TakeOverTx(ctx context.Context, tx *gorm.DB, id string, cutoff, now time.Time) (bool, error)
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type IdempotencyKeySQLRepo struct {
+	Repository[entity.IdempotencyKey]
+}
+
+func NewIdempotencyKeySQLRepository() IdempotencyKeyRepository {
+	return &IdempotencyKeySQLRepo{}
+}
+
+func (r *IdempotencyKeySQLRepo) FindByKey(ctx context.Context, tx *gorm.DB, userId, key string) (*entity.IdempotencyKey, error) {
+	var data entity.IdempotencyKey
+	if err := tx.WithContext(ctx).Where("user_id = ? AND request_key = ?", userId, key).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find idempotency key", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// InsertTx is a plain insert, unlike the upsert of CreateTx, so a key already taken
+// by a concurrent request fails on the unique index with every driver.
+func (r *IdempotencyKeySQLRepo) InsertTx(ctx context.Context, tx *gorm.DB, data *entity.IdempotencyKey) error {
+	if err := tx.WithContext(ctx).Create(data).Error; err != nil {
+		slog.Error("failed to insert idempotency key", "error", err)
+		return err
+	}
+	return nil
+}
+
+// TakeOverTx reserves again a key left in flight since before cutoff, false when
+// it was completed or taken over by another request in the meantime.
+func (r *IdempotencyKeySQLRepo) TakeOverTx(
+	ctx context.Context, tx *gorm.DB, id string, cutoff, now time.Time,
+) (bool, error) {
+	result := tx.WithContext(ctx).Model(&entity.IdempotencyKey{}).
+		Where("id = ? AND completed_at IS NULL AND reserved_at < ?", id, cutoff).
+		Update("reserved_at", now)
+	if result.Error != nil {
+		slog.Error("failed to take over idempotency key", "error", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
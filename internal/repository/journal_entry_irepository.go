@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+)
+
+type JournalEntryRepository interface {
+	CommonQuery[entity.JournalEntry]
+	CreateWithLinesTx(ctx context.Context, tx *gorm.DB, data *entity.JournalEntry) error
+	FindByTransactionId(ctx context.Context, tx *gorm.DB, transactionId string) (*entity.JournalEntry, error)
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log/slog"
+	"product-wallet/internal/entity"
+)
+
+type JournalEntrySQLRepo struct {
+	Repository[entity.JournalEntry]
+}
+
+func NewJournalEntrySQLRepository() JournalEntryRepository {
+	return &JournalEntrySQLRepo{}
+}
+
+func (r *JournalEntrySQLRepo) CreateWithLinesTx(ctx context.Context, tx *gorm.DB, data *entity.JournalEntry) error {
+	if err := tx.WithContext(ctx).Omit(clause.Associations).Create(data).Error; err != nil {
+		slog.Error("failed to create journal entry", "error", err)
+		return err
+	}
+	if err := tx.WithContext(ctx).Omit(clause.Associations).Create(&data.Lines).Error; err != nil {
+		slog.Error("failed to create journal lines", "error", err)
+		return err
+	}
+	return nil
+}
+
+// FindByTransactionId returns the entry that booked a wallet transaction, with its lines and their accounts.
+func (r *JournalEntrySQLRepo) FindByTransactionId(
+	ctx context.Context, tx *gorm.DB, transactionId string,
+) (*entity.JournalEntry, error) {
+	var data entity.JournalEntry
+	lines := tx.Model(&entity.JournalLine{}).Select("entry_id").Where("transaction_id = ?", transactionId)
+	if err := tx.WithContext(ctx).Preload("Lines.Account").Where("id IN (?)", lines).
+		First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find journal entry by transaction", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+)
+
+type LedgerAccountRepository interface {
+	CommonQuery[entity.LedgerAccount]
+	FindByCode(ctx context.Context, tx *gorm.DB, code string) (*entity.LedgerAccount, error)
+	ApplyTx(ctx context.Context, tx *gorm.DB, id string, debit, credit money.Money) error
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+)
+
+type LedgerAccountSQLRepo struct {
+	Repository[entity.LedgerAccount]
+}
+
+func NewLedgerAccountSQLRepository() LedgerAccountRepository {
+	return &LedgerAccountSQLRepo{}
+}
+
+func (r *LedgerAccountSQLRepo) FindByCode(ctx context.Context, tx *gorm.DB, code string) (*entity.LedgerAccount, error) {
+	var data entity.LedgerAccount
+	if err := tx.WithContext(ctx).Where("code = ?", code).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find ledger account by code", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// ApplyTx moves the account balance by credit minus debit in a single statement,
+// so concurrent postings never overwrite each other.
+func (r *LedgerAccountSQLRepo) ApplyTx(ctx context.Context, tx *gorm.DB, id string, debit, credit money.Money) error {
+	if err := tx.WithContext(ctx).Model(&entity.LedgerAccount{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"balance_units":    gorm.Expr("balance_units + ? - ?", credit.Units, debit.Units),
+			"balance_currency": credit.Normalize().Currency,
+		}).Error; err != nil {
+		slog.Error("failed to apply ledger account balance", "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type PaymentRequestRepository interface {
+	CommonQuery[entity.PaymentRequest]
+	FindExpiredForUpdate(ctx context.Context, tx *gorm.DB, now time.Time, limit int) (*[]entity.PaymentRequest, error)
+	FindPaidByTransactionsForUpdate(ctx context.Context, tx *gorm.DB, transactionIds []string) (*[]entity.PaymentRequest, error)
+	FindPendingBySplitTransactionForUpdate(ctx context.Context, tx *gorm.DB, transactionId string) (*[]entity.PaymentRequest, error)
+}
+
+type PaymentRequestStatusChangeRepository interface {
+	CommonQuery[entity.PaymentRequestStatusChange]
+}
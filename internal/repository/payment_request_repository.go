@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type PaymentRequestSQLRepo struct {
+	Repository[entity.PaymentRequest]
+}
+
+func NewPaymentRequestSQLRepository() PaymentRequestRepository {
+	return &PaymentRequestSQLRepo{}
+}
+
+// FindExpiredForUpdate locks up to limit pending requests past their expiry, the oldest first.
+func (r *PaymentRequestSQLRepo) FindExpiredForUpdate(
+	ctx context.Context, tx *gorm.DB, now time.Time, limit int,
+) (*[]entity.PaymentRequest, error) {
+	var data []entity.PaymentRequest
+	if err := forUpdate[entity.PaymentRequest](tx.WithContext(ctx)).
+		Where("status = ? AND expires_at <= ?", entity.PaymentRequestStatusPending, now).
+		Order("expires_at").Limit(limit).
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find expired payment requests", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// FindPaidByTransactionsForUpdate locks the paid requests settled by one of the transfer
+// transactions, in id order.
+func (r *PaymentRequestSQLRepo) FindPaidByTransactionsForUpdate(
+	ctx context.Context, tx *gorm.DB, transactionIds []string,
+) (*[]entity.PaymentRequest, error) {
+	var data []entity.PaymentRequest
+	if err := forUpdate[entity.PaymentRequest](tx.WithContext(ctx)).
+		Where("status = ?", entity.PaymentRequestStatusPaid).
+		Where("sender_transaction_id IN ? OR receiver_transaction_id IN ?", transactionIds, transactionIds).
+		Order("id").
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find payment requests of transactions", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// FindPendingBySplitTransactionForUpdate locks the pending shares of the bill splits of
+// an expense, in id order.
+func (r *PaymentRequestSQLRepo) FindPendingBySplitTransactionForUpdate(
+	ctx context.Context, tx *gorm.DB, transactionId string,
+) (*[]entity.PaymentRequest, error) {
+	var data []entity.PaymentRequest
+	splits := tx.Session(&gorm.Session{NewDB: true}).Model(&entity.BillSplit{}).Select("id").Where("transaction_id = ?", transactionId)
+	if err := forUpdate[entity.PaymentRequest](tx.WithContext(ctx)).
+		Where("status = ? AND split_id IN (?)", entity.PaymentRequestStatusPending, splits).
+		Order("id").
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find payment requests of bill splits", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+type PaymentRequestStatusChangeSQLRepo struct {
+	Repository[entity.PaymentRequestStatusChange]
+}
+
+func NewPaymentRequestStatusChangeSQLRepository() PaymentRequestStatusChangeRepository {
+	return &PaymentRequestStatusChangeSQLRepo{}
+}
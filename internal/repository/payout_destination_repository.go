@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"product-wallet/internal/entity"
+)
+
+type PayoutDestinationSQLRepo struct {
+	Repository[entity.PayoutDestination]
+}
+
+func NewPayoutDestinationSQLRepository() PayoutDestinationRepository {
+	return &PayoutDestinationSQLRepo{}
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type PayoutRepository interface {
+	CommonQuery[entity.Payout]
+	FindToSync(
+		ctx context.Context, tx *gorm.DB, after string, pendingBefore, paidSince time.Time, limit int,
+	) (*[]entity.Payout, error)
+}
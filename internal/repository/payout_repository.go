@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type PayoutSQLRepo struct {
+	Repository[entity.Payout]
+}
+
+func NewPayoutSQLRepository() PayoutRepository {
+	return &PayoutSQLRepo{}
+}
+
+// FindToSync returns up to limit payouts whose outcome may still change at the
+// provider, in id order from after: the ones submitted, the ones left pending
+// since before pendingBefore and the ones paid since paidSince.
+func (r *PayoutSQLRepo) FindToSync(
+	ctx context.Context, tx *gorm.DB, after string, pendingBefore, paidSince time.Time, limit int,
+) (*[]entity.Payout, error) {
+	var data []entity.Payout
+	if err := tx.WithContext(ctx).
+		Where("id > ?", after).
+		Where(
+			tx.Where("status = ?", entity.PayoutStatusSubmitted).
+				Or("status = ? AND created_at < ?", entity.PayoutStatusPending, pendingBefore).
+				Or("status = ? AND paid_at >= ?", entity.PayoutStatusPaid, paidSince),
+		).
+		Order("id").Limit(limit).
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find payouts to sync", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
@@ -1,9 +1,13 @@
 package repository
 
 import (
+	"context"
+	"gorm.io/gorm"
 	"product-wallet/internal/entity"
 )
 
 type ProductRepository interface {
 	CommonQuery[entity.Product]
+	DecrementStockTx(ctx context.Context, tx *gorm.DB, id string, quantity uint) (bool, error)
+	IncrementStockTx(ctx context.Context, tx *gorm.DB, id string, quantity uint) error
 }
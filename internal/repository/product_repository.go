@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
 	"product-wallet/internal/entity"
 )
 
@@ -11,3 +14,39 @@ type ProductSQLRepo struct {
 func NewProductSQLRepository() ProductRepository {
 	return &ProductSQLRepo{}
 }
+
+// DecrementStockTx takes quantity out of the stock in a single conditional update and
+// reports false when not enough is left, so concurrent purchases can never oversell.
+// The product stops being available once its stock reaches zero.
+func (r *ProductSQLRepo) DecrementStockTx(ctx context.Context, tx *gorm.DB, id string, quantity uint) (bool, error) {
+	result := tx.WithContext(ctx).Model(&entity.Product{}).
+		Where("id = ? AND available = ? AND quantity >= ?", id, true, quantity).
+		Update("quantity", gorm.Expr("quantity - ?", quantity))
+	if result.Error != nil {
+		slog.Error("failed to decrement product stock", "error", result.Error)
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	if err := tx.WithContext(ctx).Model(&entity.Product{}).
+		Where("id = ? AND quantity = ?", id, 0).
+		Update("available", false).Error; err != nil {
+		slog.Error("failed to update product availability", "error", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// IncrementStockTx puts quantity back in stock, making the product available again.
+func (r *ProductSQLRepo) IncrementStockTx(ctx context.Context, tx *gorm.DB, id string, quantity uint) error {
+	if err := tx.WithContext(ctx).Model(&entity.Product{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"quantity":  gorm.Expr("quantity + ?", quantity),
+			"available": true,
+		}).Error; err != nil {
+		slog.Error("failed to increment product stock", "error", err)
+		return err
+	}
+	return nil
+}
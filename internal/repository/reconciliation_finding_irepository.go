@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+)
+
+type ReconciliationFindingRepository interface {
+	CommonQuery[entity.ReconciliationFinding]
+	FindOpenByWalletId(ctx context.Context, tx *gorm.DB, walletId string) (*entity.ReconciliationFinding, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+)
+
+type ReconciliationFindingSQLRepo struct {
+	Repository[entity.ReconciliationFinding]
+}
+
+func NewReconciliationFindingSQLRepository() ReconciliationFindingRepository {
+	return &ReconciliationFindingSQLRepo{}
+}
+
+func (r *ReconciliationFindingSQLRepo) FindOpenByWalletId(
+	ctx context.Context, tx *gorm.DB, walletId string,
+) (*entity.ReconciliationFinding, error) {
+	var data entity.ReconciliationFinding
+	if err := tx.WithContext(ctx).Where("wallet_id = ? AND status = ?", walletId, entity.ReconciliationFindingStatusOpen).
+		First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find open reconciliation finding", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
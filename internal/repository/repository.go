@@ -27,6 +27,8 @@ type CommonQuery[T any] interface {
 		filter model.FilterParams,
 	) (*model.PaginationData[T], error)
 	FindByID(ctx context.Context, tx *gorm.DB, id string) (*T, error)
+	FindByIDForUpdate(ctx context.Context, tx *gorm.DB, id string) (*T, error)
+	FindByIDsForUpdate(ctx context.Context, tx *gorm.DB, ids []string) (*[]T, error)
 	FindByFilter(
 		ctx context.Context, tx *gorm.DB, filter model.FilterParams, order model.OrderParam,
 	) (*T, error)
@@ -139,6 +141,44 @@ func (r *Repository[T]) FindByID(ctx context.Context, tx *gorm.DB, id string) (*
 	return &data, nil
 }
 
+// FindByIDForUpdate reads a row and locks it until tx ends, so the values read can
+// be checked and written back without a concurrent transaction slipping in between.
+func (r *Repository[T]) FindByIDForUpdate(ctx context.Context, tx *gorm.DB, id string) (*T, error) {
+	var data T
+	if err := forUpdate[T](tx.WithContext(ctx)).Where("id = ?", id).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find by id for update", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// FindByIDsForUpdate locks several rows at once, always in id order, so two
+// transactions locking the same rows cannot deadlock each other.
+func (r *Repository[T]) FindByIDsForUpdate(ctx context.Context, tx *gorm.DB, ids []string) (*[]T, error) {
+	var data []T
+	if err := forUpdate[T](tx.WithContext(ctx)).Where("id IN ?", ids).Order("id asc").
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find by ids for update", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// forUpdate adds a row lock to query. SQL Server has no FOR UPDATE and takes
+// the lock as a table hint instead.
+func forUpdate[T any](query *gorm.DB) *gorm.DB {
+	if query.Dialector.Name() != "sqlserver" {
+		return query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	if table, ok := any(new(T)).(interface{ TableName() string }); ok {
+		return query.Table(table.TableName() + " WITH (UPDLOCK, ROWLOCK)")
+	}
+	return query
+}
+
 func (r *Repository[T]) FindByFilter(
 	ctx context.Context, tx *gorm.DB, filter model.FilterParams, order model.OrderParam,
 ) (*T, error) {
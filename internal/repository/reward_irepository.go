@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type RewardRepository interface {
+	CommonQuery[entity.Reward]
+	SumByUserTx(ctx context.Context, tx *gorm.DB, campaignId, userId string, since time.Time) (int64, error)
+	FindDue(ctx context.Context, tx *gorm.DB, after string, at time.Time, limit int) (*[]entity.Reward, error)
+	FindCreditedBySourceTx(ctx context.Context, tx *gorm.DB, sourceTransactionId string) (*[]entity.Reward, error)
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type RewardSQLRepo struct {
+	Repository[entity.Reward]
+}
+
+func NewRewardSQLRepository() RewardRepository {
+	return &RewardSQLRepo{}
+}
+
+// SumByUserTx returns the minor units a campaign granted a user since the given
+// time, pending or credited.
+func (r *RewardSQLRepo) SumByUserTx(
+	ctx context.Context, tx *gorm.DB, campaignId, userId string, since time.Time,
+) (int64, error) {
+	var total int64
+	if err := tx.WithContext(ctx).Model(&entity.Reward{}).
+		Select("COALESCE(SUM(amount_units), 0)").
+		Where("campaign_id = ? AND user_id = ? AND status <> ?", campaignId, userId, entity.RewardStatusCancelled).
+		Where("created_at >= ?", since).
+		Scan(&total).Error; err != nil {
+		slog.Error("failed to sum user rewards", "error", err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// FindDue returns up to limit pending rewards available at the given time, in id
+// order from after.
+func (r *RewardSQLRepo) FindDue(
+	ctx context.Context, tx *gorm.DB, after string, at time.Time, limit int,
+) (*[]entity.Reward, error) {
+	var data []entity.Reward
+	if err := tx.WithContext(ctx).
+		Where("id > ? AND status = ? AND available_at <= ?", after, entity.RewardStatusPending, at).
+		Order("id").Limit(limit).
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find due rewards", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// FindCreditedBySourceTx returns the rewards a transaction earned that were paid out,
+// in id order.
+func (r *RewardSQLRepo) FindCreditedBySourceTx(
+	ctx context.Context, tx *gorm.DB, sourceTransactionId string,
+) (*[]entity.Reward, error) {
+	var data []entity.Reward
+	if err := tx.WithContext(ctx).
+		Where("source_transaction_id = ? AND status = ?", sourceTransactionId, entity.RewardStatusCredited).
+		Order("id").
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find credited rewards", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
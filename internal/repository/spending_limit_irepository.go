@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+)
+
+type SpendingLimitRepository interface {
+	CommonQuery[entity.SpendingLimit]
+	FindByWalletId(ctx context.Context, tx *gorm.DB, walletId string) (*entity.SpendingLimit, error)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+)
+
+type SpendingLimitSQLRepo struct {
+	Repository[entity.SpendingLimit]
+}
+
+func NewSpendingLimitSQLRepository() SpendingLimitRepository {
+	return &SpendingLimitSQLRepo{}
+}
+
+func (r *SpendingLimitSQLRepo) FindByWalletId(ctx context.Context, tx *gorm.DB, walletId string) (*entity.SpendingLimit, error) {
+	var data entity.SpendingLimit
+	if err := tx.WithContext(ctx).Where("wallet_id = ?", walletId).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find spending limit", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
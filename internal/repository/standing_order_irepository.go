@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type StandingOrderRepository interface {
+	CommonQuery[entity.StandingOrder]
+	FindDue(ctx context.Context, tx *gorm.DB, now time.Time, limit int) (*[]entity.StandingOrder, error)
+	CancelByWalletTx(ctx context.Context, tx *gorm.DB, walletId string) (int64, error)
+}
+
+type StandingOrderRunRepository interface {
+	CommonQuery[entity.StandingOrderRun]
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type StandingOrderSQLRepo struct {
+	Repository[entity.StandingOrder]
+}
+
+func NewStandingOrderSQLRepository() StandingOrderRepository {
+	return &StandingOrderSQLRepo{}
+}
+
+// FindDue returns up to limit active standing orders whose next run has passed, the most overdue first.
+func (r *StandingOrderSQLRepo) FindDue(ctx context.Context, tx *gorm.DB, now time.Time, limit int) (*[]entity.StandingOrder, error) {
+	var data []entity.StandingOrder
+	if err := tx.WithContext(ctx).
+		Where("status = ? AND next_run_at <= ?", entity.StandingOrderStatusActive, now).
+		Order("next_run_at").Limit(limit).
+		Find(&data).Error; err != nil {
+		slog.Error("failed to find due standing orders", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// CancelByWalletTx cancels the live standing orders paying out of or into a wallet.
+func (r *StandingOrderSQLRepo) CancelByWalletTx(ctx context.Context, tx *gorm.DB, walletId string) (int64, error) {
+	result := tx.WithContext(ctx).Model(&entity.StandingOrder{}).
+		Where("wallet_id = ? OR receiver_id = ?", walletId, walletId).
+		Where("status IN ?", []string{entity.StandingOrderStatusActive, entity.StandingOrderStatusPaused}).
+		Updates(map[string]interface{}{
+			"status":      entity.StandingOrderStatusCancelled,
+			"next_run_at": nil,
+		})
+	if result.Error != nil {
+		slog.Error("failed to cancel standing orders", "error", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+type StandingOrderRunSQLRepo struct {
+	Repository[entity.StandingOrderRun]
+}
+
+func NewStandingOrderRunSQLRepository() StandingOrderRunRepository {
+	return &StandingOrderRunSQLRepo{}
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+)
+
+type StatementRepository interface {
+	CommonQuery[entity.Statement]
+	FindByPeriod(ctx context.Context, tx *gorm.DB, walletId, period string) (*entity.Statement, error)
+	ReplaceLinesTx(ctx context.Context, tx *gorm.DB, statementId string, lines []entity.StatementLine) error
+	FindWalletsWithout(ctx context.Context, tx *gorm.DB, period, afterId string, limit int) ([]string, error)
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+)
+
+type StatementSQLRepo struct {
+	Repository[entity.Statement]
+}
+
+func NewStatementSQLRepository() StatementRepository {
+	return &StatementSQLRepo{}
+}
+
+func (r *StatementSQLRepo) FindByPeriod(ctx context.Context, tx *gorm.DB, walletId, period string) (*entity.Statement, error) {
+	var data entity.Statement
+	if err := tx.WithContext(ctx).Where("wallet_id = ? AND period = ?", walletId, period).
+		First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find statement", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// ReplaceLinesTx swaps the lines of a statement for lines.
+func (r *StatementSQLRepo) ReplaceLinesTx(
+	ctx context.Context, tx *gorm.DB, statementId string, lines []entity.StatementLine,
+) error {
+	if err := tx.WithContext(ctx).Where("statement_id = ?", statementId).
+		Delete(&entity.StatementLine{}).Error; err != nil {
+		slog.Error("failed to delete statement lines", "error", err)
+		return err
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	if err := tx.WithContext(ctx).CreateInBatches(lines, 500).Error; err != nil {
+		slog.Error("failed to create statement lines", "error", err)
+		return err
+	}
+	return nil
+}
+
+// FindWalletsWithout returns up to limit ids, in order, of wallets after afterId that
+// have no statement for period.
+func (r *StatementSQLRepo) FindWalletsWithout(
+	ctx context.Context, tx *gorm.DB, period, afterId string, limit int,
+) ([]string, error) {
+	var ids []string
+	wallets := (&entity.Wallet{}).TableName()
+	statements := (&entity.Statement{}).TableName()
+	if err := tx.WithContext(ctx).Table(wallets).
+		Joins("LEFT JOIN "+statements+" ON "+statements+".wallet_id = "+wallets+".id AND "+statements+".period = ?", period).
+		Where(statements+".id IS NULL AND "+wallets+".id > ?", afterId).
+		Order(wallets+".id").Limit(limit).
+		Pluck(wallets+".id", &ids).Error; err != nil {
+		slog.Error("failed to find wallets without statement", "error", err)
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type TopUpRepository interface {
+	CommonQuery[entity.TopUp]
+	FindByReferenceForUpdate(ctx context.Context, tx *gorm.DB, provider, reference string) (*entity.TopUp, error)
+	ExpireTx(ctx context.Context, tx *gorm.DB, now time.Time) (int64, error)
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"time"
+)
+
+type TopUpSQLRepo struct {
+	Repository[entity.TopUp]
+}
+
+func NewTopUpSQLRepository() TopUpRepository {
+	return &TopUpSQLRepo{}
+}
+
+// FindByReferenceForUpdate locks the top up paid by a checkout of provider.
+func (r *TopUpSQLRepo) FindByReferenceForUpdate(
+	ctx context.Context, tx *gorm.DB, provider, reference string,
+) (*entity.TopUp, error) {
+	var data entity.TopUp
+	if err := forUpdate[entity.TopUp](tx.WithContext(ctx)).
+		Where("provider = ? AND checkout_reference = ?", provider, reference).
+		First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find top up by reference", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// ExpireTx marks the pending top ups past their expiry as expired.
+func (r *TopUpSQLRepo) ExpireTx(ctx context.Context, tx *gorm.DB, now time.Time) (int64, error) {
+	result := tx.WithContext(ctx).Model(&entity.TopUp{}).
+		Where("status = ? AND expires_at <= ?", entity.TopUpStatusPending, now).
+		Update("status", entity.TopUpStatusExpired)
+	if result.Error != nil {
+		slog.Error("failed to expire top ups", "error", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -1,9 +1,36 @@
 package repository
 
 import (
+	"context"
+	"gorm.io/gorm"
 	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"time"
 )
 
 type TransactionRepository interface {
 	CommonQuery[entity.Transaction]
+	SumOutflowTx(ctx context.Context, tx *gorm.DB, walletId string, since time.Time) (int64, error)
+	SumMemberOutflowTx(ctx context.Context, tx *gorm.DB, walletId string, userId string, since time.Time) (int64, error)
+	SumOutflowByCategoryTx(ctx context.Context, tx *gorm.DB, walletId string, from, to time.Time) (map[string]int64, error)
+	SumBalanceTx(ctx context.Context, tx *gorm.DB, walletId string, before time.Time) (int64, error)
+	SumWalletBalanceTx(ctx context.Context, tx *gorm.DB, walletId string) (int64, error)
+	StreamByWallet(
+		ctx context.Context, tx *gorm.DB, walletId string, from, to time.Time, fn func(*entity.Transaction) error,
+	) error
+	FindByWalletInBatches(
+		ctx context.Context, tx *gorm.DB, walletId string, size int, fn func([]entity.Transaction) error,
+	) error
+	UpdateFilingTx(ctx context.Context, tx *gorm.DB, data *entity.Transaction) error
+	CountByInitiatorTx(ctx context.Context, tx *gorm.DB, userId, transactionType, excludeId string) (int64, error)
+	SumByPeriodTx(
+		ctx context.Context, tx *gorm.DB, walletIds []string, period string, from, to time.Time,
+	) ([]model.CashFlowPeriod, error)
+	SumByProductTx(
+		ctx context.Context, tx *gorm.DB, walletIds []string, from, to time.Time, limit int,
+	) ([]model.ProductSales, error)
+	SumSpendingByCategoryTx(ctx context.Context, tx *gorm.DB, walletIds []string, from, to time.Time) (
+		[]model.CategorySpending, error,
+	)
+	SumByWalletTx(ctx context.Context, tx *gorm.DB, walletIds []string, from, to time.Time) ([]model.WalletCashFlow, error)
 }
@@ -1,7 +1,14 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"gorm.io/gorm"
+	"log/slog"
 	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/money"
+	"time"
 )
 
 type TransactionSQLRepo struct {
@@ -11,3 +18,350 @@ type TransactionSQLRepo struct {
 func NewTransactionSQLRepository() TransactionRepository {
 	return &TransactionSQLRepo{}
 }
+
+// signedAmountSum adds up amounts, counting those going out of the wallet negatively.
+const signedAmountSum = "COALESCE(SUM(CASE WHEN direction = ? THEN -amount_units ELSE amount_units END), 0)"
+
+// netAmount is the amount of a transaction less what was refunded of it.
+const netAmount = "amount_units - COALESCE(refunded_units, 0)"
+
+// outflowSum adds up what left a wallet net of what was refunded of it.
+const outflowSum = "COALESCE(SUM(" + netAmount + "), 0)"
+
+// outflowTypes are the transaction types counting as spending when going out of a wallet.
+var outflowTypes = []string{"expense", "transfer", "withdrawal", "fee"}
+
+// periodStarts are per dialect the expressions giving, as YYYY-MM-DD, the first day
+// of the period a transaction falls in. Periods follow the time zone of the database.
+var periodStarts = map[string]map[string]string{
+	"postgres": {
+		model.AnalyticsPeriodDay:   "TO_CHAR(transaction_time, 'YYYY-MM-DD')",
+		model.AnalyticsPeriodWeek:  "TO_CHAR(DATE_TRUNC('week', transaction_time), 'YYYY-MM-DD')",
+		model.AnalyticsPeriodMonth: "TO_CHAR(transaction_time, 'YYYY-MM-01')",
+	},
+	"mysql": {
+		model.AnalyticsPeriodDay:   "DATE_FORMAT(transaction_time, '%Y-%m-%d')",
+		model.AnalyticsPeriodWeek:  "DATE_FORMAT(DATE_SUB(transaction_time, INTERVAL WEEKDAY(transaction_time) DAY), '%Y-%m-%d')",
+		model.AnalyticsPeriodMonth: "DATE_FORMAT(transaction_time, '%Y-%m-01')",
+	},
+	"sqlserver": {
+		model.AnalyticsPeriodDay: "CONVERT(char(10), transaction_time, 23)",
+		// days since Monday whatever SET DATEFIRST says
+		model.AnalyticsPeriodWeek:  "CONVERT(char(10), DATEADD(day, -((DATEPART(weekday, transaction_time) + @@DATEFIRST + 5) % 7), transaction_time), 23)",
+		model.AnalyticsPeriodMonth: "CONVERT(char(7), transaction_time, 23) + '-01'",
+	},
+	"sqlite": {
+		model.AnalyticsPeriodDay:   "date(transaction_time)",
+		model.AnalyticsPeriodWeek:  "date(transaction_time, '-' || ((CAST(strftime('%w', transaction_time) AS integer) + 6) % 7) || ' days')",
+		model.AnalyticsPeriodMonth: "strftime('%Y-%m-01', transaction_time)",
+	},
+}
+
+// SumOutflowTx returns the minor units spent, transferred, withdrawn or paid in
+// fees out of a wallet since the given time, net of what was refunded of it.
+func (r *TransactionSQLRepo) SumOutflowTx(ctx context.Context, tx *gorm.DB, walletId string, since time.Time) (int64, error) {
+	var total int64
+	if err := outflowQuery(ctx, tx, walletId, since).Select(outflowSum).Scan(&total).Error; err != nil {
+		slog.Error("failed to sum wallet outflows", "error", err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// SumMemberOutflowTx is SumOutflowTx narrowed to what the given user initiated.
+func (r *TransactionSQLRepo) SumMemberOutflowTx(
+	ctx context.Context, tx *gorm.DB, walletId string, userId string, since time.Time,
+) (int64, error) {
+	var total int64
+	if err := outflowQuery(ctx, tx, walletId, since).Select(outflowSum).Where("initiated_by = ?", userId).
+		Scan(&total).Error; err != nil {
+		slog.Error("failed to sum member outflows", "error", err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// SumOutflowByCategoryTx is SumOutflowTx over [from, to) per category id, the
+// uncategorized outflows are keyed by an empty id.
+func (r *TransactionSQLRepo) SumOutflowByCategoryTx(
+	ctx context.Context, tx *gorm.DB, walletId string, from, to time.Time,
+) (map[string]int64, error) {
+	var rows []struct {
+		CategoryId *string
+		Total      int64
+	}
+	if err := outflowQuery(ctx, tx, walletId, from).Where("transaction_time < ?", to).
+		Select("category_id, " + outflowSum + " AS total").Group("category_id").
+		Scan(&rows).Error; err != nil {
+		slog.Error("failed to sum outflows by category", "error", err)
+		return nil, err
+	}
+	totals := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		categoryId := ""
+		if row.CategoryId != nil {
+			categoryId = *row.CategoryId
+		}
+		totals[categoryId] += row.Total
+	}
+	return totals, nil
+}
+
+func outflowQuery(ctx context.Context, tx *gorm.DB, walletId string, since time.Time) *gorm.DB {
+	return tx.WithContext(ctx).Model(&entity.Transaction{}).
+		Where("wallet_id = ? AND direction = ?", walletId, entity.TransactionDirectionOut).
+		Where("type IN ?", outflowTypes).
+		Where("transaction_time >= ?", since)
+}
+
+// SumBalanceTx returns the balance of a wallet in minor units made up of the
+// transactions booked before the given time.
+func (r *TransactionSQLRepo) SumBalanceTx(ctx context.Context, tx *gorm.DB, walletId string, before time.Time) (int64, error) {
+	var total int64
+	if err := tx.WithContext(ctx).Model(&entity.Transaction{}).
+		Select(signedAmountSum, entity.TransactionDirectionOut).
+		Where("wallet_id = ? AND transaction_time < ?", walletId, before).
+		Scan(&total).Error; err != nil {
+		slog.Error("failed to sum wallet balance", "error", err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// SumWalletBalanceTx returns the balance of a wallet in minor units made up of all
+// of its transactions.
+func (r *TransactionSQLRepo) SumWalletBalanceTx(ctx context.Context, tx *gorm.DB, walletId string) (int64, error) {
+	var total int64
+	if err := tx.WithContext(ctx).Model(&entity.Transaction{}).
+		Select(signedAmountSum, entity.TransactionDirectionOut).
+		Where("wallet_id = ?", walletId).
+		Scan(&total).Error; err != nil {
+		slog.Error("failed to sum wallet balance", "error", err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// StreamByWallet calls fn with the transactions of a wallet booked in [from, to),
+// oldest first, reading them one row at a time instead of loading them all.
+func (r *TransactionSQLRepo) StreamByWallet(
+	ctx context.Context, tx *gorm.DB, walletId string, from, to time.Time, fn func(*entity.Transaction) error,
+) error {
+	query := tx.WithContext(ctx).Model(&entity.Transaction{}).
+		Where("wallet_id = ? AND transaction_time >= ? AND transaction_time < ?", walletId, from, to).
+		Order("transaction_time asc, id asc")
+	rows, err := query.Rows()
+	if err != nil {
+		slog.Error("failed to stream transactions", "error", err)
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var data entity.Transaction
+		if err := query.ScanRows(rows, &data); err != nil {
+			slog.Error("failed to scan transaction", "error", err)
+			return err
+		}
+		if err := fn(&data); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// FindByWalletInBatches calls fn with the transactions of a wallet, oldest first,
+// size at a time. Unlike StreamByWallet fn may run queries of its own on tx.
+func (r *TransactionSQLRepo) FindByWalletInBatches(
+	ctx context.Context, tx *gorm.DB, walletId string, size int, fn func([]entity.Transaction) error,
+) error {
+	var batch []entity.Transaction
+	if err := tx.WithContext(ctx).Where("wallet_id = ?", walletId).Order("transaction_time asc, id asc").
+		FindInBatches(&batch, size, func(*gorm.DB, int) error {
+			return fn(batch)
+		}).Error; err != nil {
+		slog.Error("failed to find transactions", "error", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateFilingTx saves the category and the tags of a transaction and nothing else,
+// so it never overwrites what a concurrent refund wrote.
+func (r *TransactionSQLRepo) UpdateFilingTx(ctx context.Context, tx *gorm.DB, data *entity.Transaction) error {
+	if err := tx.WithContext(ctx).Model(&entity.Transaction{}).Where("id = ?", data.Id).
+		Updates(map[string]interface{}{
+			"category_id": data.CategoryId,
+			"tags":        data.Tags,
+		}).Error; err != nil {
+		slog.Error("failed to update transaction filing", "error", err)
+		return err
+	}
+	return nil
+}
+
+// CountByInitiatorTx returns how many transactions of a type the given user made
+// besides excludeId.
+func (r *TransactionSQLRepo) CountByInitiatorTx(
+	ctx context.Context, tx *gorm.DB, userId, transactionType, excludeId string,
+) (int64, error) {
+	var count int64
+	if err := tx.WithContext(ctx).Model(&entity.Transaction{}).
+		Where("initiated_by = ? AND type = ? AND id <> ?", userId, transactionType, excludeId).
+		Count(&count).Error; err != nil {
+		slog.Error("failed to count user transactions", "error", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// SumByPeriodTx returns per period of [from, to) and currency the income, expenses and
+// transfers of the given wallets, net of refunds. Reversals are left out as they only
+// undo what their refunded transaction counts for.
+func (r *TransactionSQLRepo) SumByPeriodTx(
+	ctx context.Context, tx *gorm.DB, walletIds []string, period string, from, to time.Time,
+) ([]model.CashFlowPeriod, error) {
+	start, ok := periodStarts[tx.Dialector.Name()][period]
+	if !ok {
+		err := fmt.Errorf("period %q is not supported on %s", period, tx.Dialector.Name())
+		slog.Error("failed to sum transactions by period", "error", err)
+		return nil, err
+	}
+	var rows []struct {
+		Period      string
+		Currency    string
+		Income      int64
+		Expense     int64
+		TransferIn  int64
+		TransferOut int64
+	}
+	sum := "COALESCE(SUM(CASE WHEN type %s ? AND direction = ? THEN " + netAmount + " END), 0) AS %s"
+	if err := tx.WithContext(ctx).Model(&entity.Transaction{}).
+		Select(start+" AS period, amount_currency AS currency, "+
+			fmt.Sprintf(sum, "<>", "income")+", "+fmt.Sprintf(sum, "<>", "expense")+", "+
+			fmt.Sprintf(sum, "=", "transfer_in")+", "+fmt.Sprintf(sum, "=", "transfer_out"),
+			"transfer", entity.TransactionDirectionIn, "transfer", entity.TransactionDirectionOut,
+			"transfer", entity.TransactionDirectionIn, "transfer", entity.TransactionDirectionOut).
+		Where("wallet_id IN ? AND type <> ?", walletIds, "reversal").
+		Where("transaction_time >= ? AND transaction_time < ?", from, to).
+		Group(start + ", amount_currency").Order("period, currency").
+		Scan(&rows).Error; err != nil {
+		slog.Error("failed to sum transactions by period", "error", err)
+		return nil, err
+	}
+	periods := make([]model.CashFlowPeriod, 0, len(rows))
+	for _, row := range rows {
+		periods = append(periods, model.CashFlowPeriod{
+			Period:      row.Period,
+			Currency:    row.Currency,
+			Income:      money.New(row.Income, row.Currency),
+			Expense:     money.New(row.Expense, row.Currency),
+			TransferIn:  money.New(row.TransferIn, row.Currency),
+			TransferOut: money.New(row.TransferOut, row.Currency),
+			Net:         money.New(row.Income+row.TransferIn-row.Expense-row.TransferOut, row.Currency),
+		})
+	}
+	return periods, nil
+}
+
+// SumByProductTx returns the products bought out of the given wallets in [from, to),
+// the most bought first and at most limit of them. Names are left to the caller.
+func (r *TransactionSQLRepo) SumByProductTx(
+	ctx context.Context, tx *gorm.DB, walletIds []string, from, to time.Time, limit int,
+) ([]model.ProductSales, error) {
+	var rows []struct {
+		ProductId string
+		Currency  string
+		Quantity  int64
+		Purchases int64
+		Amount    int64
+	}
+	if err := tx.WithContext(ctx).Model(&entity.Transaction{}).
+		Select("product_id, amount_currency AS currency, SUM(product_quantity - refunded_quantity) AS quantity, "+
+			"SUM(CASE WHEN product_quantity > refunded_quantity THEN 1 ELSE 0 END) AS purchases, "+outflowSum+" AS amount").
+		Where("wallet_id IN ? AND type = ? AND direction = ? AND product_id IS NOT NULL",
+			walletIds, "expense", entity.TransactionDirectionOut).
+		Where("transaction_time >= ? AND transaction_time < ?", from, to).
+		Group("product_id, amount_currency").Having("SUM(product_quantity - refunded_quantity) > 0").
+		Order("quantity DESC, amount DESC").Limit(limit).
+		Scan(&rows).Error; err != nil {
+		slog.Error("failed to sum transactions by product", "error", err)
+		return nil, err
+	}
+	products := make([]model.ProductSales, 0, len(rows))
+	for _, row := range rows {
+		products = append(products, model.ProductSales{
+			ProductId: row.ProductId,
+			Quantity:  row.Quantity,
+			Purchases: row.Purchases,
+			Amount:    money.New(row.Amount, row.Currency),
+		})
+	}
+	return products, nil
+}
+
+// SumSpendingByCategoryTx is SumOutflowByCategoryTx over several wallets, largest
+// spending first, counting the transactions not refunded in full. Names are left to the caller.
+func (r *TransactionSQLRepo) SumSpendingByCategoryTx(
+	ctx context.Context, tx *gorm.DB, walletIds []string, from, to time.Time,
+) ([]model.CategorySpending, error) {
+	var rows []struct {
+		CategoryId *string
+		Currency   string
+		Entries    int64
+		Amount     int64
+	}
+	if err := tx.WithContext(ctx).Model(&entity.Transaction{}).
+		Select("category_id, amount_currency AS currency, "+
+			"SUM(CASE WHEN "+netAmount+" > 0 THEN 1 ELSE 0 END) AS entries, "+outflowSum+" AS amount").
+		Where("wallet_id IN ? AND direction = ? AND type IN ?", walletIds, entity.TransactionDirectionOut, outflowTypes).
+		Where("transaction_time >= ? AND transaction_time < ?", from, to).
+		Group("category_id, amount_currency").Order("amount DESC").
+		Scan(&rows).Error; err != nil {
+		slog.Error("failed to sum spending by category", "error", err)
+		return nil, err
+	}
+	categories := make([]model.CategorySpending, 0, len(rows))
+	for _, row := range rows {
+		categories = append(categories, model.CategorySpending{
+			CategoryId: row.CategoryId,
+			Count:      row.Entries,
+			Amount:     money.New(row.Amount, row.Currency),
+		})
+	}
+	return categories, nil
+}
+
+// SumByWalletTx returns per wallet what came in and went out of it in [from, to),
+// reversals included so the net is the change of its balance.
+func (r *TransactionSQLRepo) SumByWalletTx(
+	ctx context.Context, tx *gorm.DB, walletIds []string, from, to time.Time,
+) ([]model.WalletCashFlow, error) {
+	var rows []struct {
+		WalletId string
+		Currency string
+		Inflow   int64
+		Outflow  int64
+	}
+	if err := tx.WithContext(ctx).Model(&entity.Transaction{}).
+		Select("wallet_id, amount_currency AS currency, "+
+			"COALESCE(SUM(CASE WHEN direction = ? THEN amount_units ELSE 0 END), 0) AS inflow, "+
+			"COALESCE(SUM(CASE WHEN direction = ? THEN amount_units ELSE 0 END), 0) AS outflow",
+			entity.TransactionDirectionIn, entity.TransactionDirectionOut).
+		Where("wallet_id IN ?", walletIds).
+		Where("transaction_time >= ? AND transaction_time < ?", from, to).
+		Group("wallet_id, amount_currency").Order("wallet_id").
+		Scan(&rows).Error; err != nil {
+		slog.Error("failed to sum transactions by wallet", "error", err)
+		return nil, err
+	}
+	wallets := make([]model.WalletCashFlow, 0, len(rows))
+	for _, row := range rows {
+		wallets = append(wallets, model.WalletCashFlow{
+			WalletId: row.WalletId,
+			Inflow:   money.New(row.Inflow, row.Currency),
+			Outflow:  money.New(row.Outflow, row.Currency),
+			Net:      money.New(row.Inflow-row.Outflow, row.Currency),
+		})
+	}
+	return wallets, nil
+}
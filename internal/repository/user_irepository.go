@@ -1,9 +1,12 @@
 package repository
 
 import (
+	"context"
+	"gorm.io/gorm"
 	"product-wallet/internal/entity"
 )
 
 type UserRepository interface {
 	CommonQuery[entity.User]
+	UpdateDefaultWalletTx(ctx context.Context, tx *gorm.DB, id string, walletId string) error
 }
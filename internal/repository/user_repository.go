@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
 	"product-wallet/internal/entity"
 )
 
@@ -11,3 +14,13 @@ type UserSQLRepo struct {
 func NewUserSQLRepository() UserRepository {
 	return &UserSQLRepo{}
 }
+
+// UpdateDefaultWalletTx makes walletId the wallet transfers to the user go to.
+func (r *UserSQLRepo) UpdateDefaultWalletTx(ctx context.Context, tx *gorm.DB, id string, walletId string) error {
+	if err := tx.WithContext(ctx).Model(&entity.User{}).Where("id = ?", id).
+		Update("default_wallet_id", walletId).Error; err != nil {
+		slog.Error("failed to update default wallet", "error", err)
+		return err
+	}
+	return nil
+}
@@ -1,9 +1,19 @@
 package repository
 
 import (
+	"context"
+	"gorm.io/gorm"
 	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
 )
 
 type WalletRepository interface {
 	CommonQuery[entity.Wallet]
+	UpdateBalanceTx(ctx context.Context, tx *gorm.DB, id string, balance money.Money) error
+	FindIdsAfter(ctx context.Context, tx *gorm.DB, afterId string, limit int) ([]string, error)
+	FindOpenByUser(ctx context.Context, tx *gorm.DB, userId string) ([]entity.Wallet, error)
+}
+
+type WalletStatusChangeRepository interface {
+	CommonQuery[entity.WalletStatusChange]
 }
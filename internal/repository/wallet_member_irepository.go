@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+)
+
+type WalletMemberRepository interface {
+	CommonQuery[entity.WalletMember]
+	FindByWalletAndUser(ctx context.Context, tx *gorm.DB, walletId string, userId string) (*entity.WalletMember, error)
+	FindWalletIdsByUser(ctx context.Context, tx *gorm.DB, userId string) ([]string, error)
+	CountOwnersTx(ctx context.Context, tx *gorm.DB, walletId string) (int64, error)
+}
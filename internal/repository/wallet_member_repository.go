@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+)
+
+type WalletMemberSQLRepo struct {
+	Repository[entity.WalletMember]
+}
+
+func NewWalletMemberSQLRepository() WalletMemberRepository {
+	return &WalletMemberSQLRepo{}
+}
+
+func (r *WalletMemberSQLRepo) FindByWalletAndUser(ctx context.Context, tx *gorm.DB, walletId string, userId string) (*entity.WalletMember, error) {
+	var data entity.WalletMember
+	if err := tx.WithContext(ctx).Where("wallet_id = ? AND user_id = ?", walletId, userId).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.Error("failed to find wallet member", "error", err)
+		return nil, err
+	}
+	return &data, nil
+}
+
+// FindWalletIdsByUser returns the ids of every wallet the user is a member of.
+func (r *WalletMemberSQLRepo) FindWalletIdsByUser(ctx context.Context, tx *gorm.DB, userId string) ([]string, error) {
+	var ids []string
+	if err := tx.WithContext(ctx).Model(&entity.WalletMember{}).Where("user_id = ?", userId).
+		Order("wallet_id asc").Pluck("wallet_id", &ids).Error; err != nil {
+		slog.Error("failed to find member wallet ids", "error", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *WalletMemberSQLRepo) CountOwnersTx(ctx context.Context, tx *gorm.DB, walletId string) (int64, error) {
+	var count int64
+	if err := tx.WithContext(ctx).Model(&entity.WalletMember{}).
+		Where("wallet_id = ? AND role = ?", walletId, entity.WalletRoleOwner).Count(&count).Error; err != nil {
+		slog.Error("failed to count wallet owners", "error", err)
+		return 0, err
+	}
+	return count, nil
+}
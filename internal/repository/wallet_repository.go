@@ -1,7 +1,12 @@
 package repository
 
 import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
 	"product-wallet/internal/entity"
+	"product-wallet/pkg/money"
+	"time"
 )
 
 type WalletSQLRepo struct {
@@ -11,3 +16,50 @@ type WalletSQLRepo struct {
 func NewWalletSQLRepository() WalletRepository {
 	return &WalletSQLRepo{}
 }
+
+// UpdateBalanceTx writes the balance projected from the wallet's ledger account.
+func (r *WalletSQLRepo) UpdateBalanceTx(ctx context.Context, tx *gorm.DB, id string, balance money.Money) error {
+	balance = balance.Normalize()
+	if err := tx.WithContext(ctx).Model(&entity.Wallet{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"balance_units":    balance.Units,
+			"balance_currency": balance.Currency,
+			"last_transaction": time.Now(),
+		}).Error; err != nil {
+		slog.Error("failed to update wallet balance", "error", err)
+		return err
+	}
+	return nil
+}
+
+// FindIdsAfter returns up to limit wallet ids greater than afterId, in order, to walk
+// every wallet a batch at a time.
+func (r *WalletSQLRepo) FindIdsAfter(ctx context.Context, tx *gorm.DB, afterId string, limit int) ([]string, error) {
+	var ids []string
+	if err := tx.WithContext(ctx).Model(&entity.Wallet{}).Where("id > ?", afterId).
+		Order("id asc").Limit(limit).Pluck("id", &ids).Error; err != nil {
+		slog.Error("failed to find wallet ids", "error", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// FindOpenByUser returns the wallets a user opened that are not closed, by name.
+func (r *WalletSQLRepo) FindOpenByUser(ctx context.Context, tx *gorm.DB, userId string) ([]entity.Wallet, error) {
+	var data []entity.Wallet
+	if err := tx.WithContext(ctx).Where("user_id = ?", userId).
+		Where("status IS NULL OR status <> ?", entity.WalletStatusClosed).
+		Order("name asc").Find(&data).Error; err != nil {
+		slog.Error("failed to find user wallets", "error", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+type WalletStatusChangeSQLRepo struct {
+	Repository[entity.WalletStatusChange]
+}
+
+func NewWalletStatusChangeSQLRepository() WalletStatusChangeRepository {
+	return &WalletStatusChangeSQLRepo{}
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type AnalyticsService interface {
+	// CashFlow, TopProducts, SpendingByCategory and WalletCashFlow aggregate the transactions of
+	// the wallets a user may view inside the database, for dashboards that would otherwise
+	// download the whole history
+	CashFlow(ctx context.Context, req *model.GetCashFlowReq) (*model.GetCashFlowRes, *exception.Exception)
+	TopProducts(ctx context.Context, req *model.GetTopProductsReq) (*model.GetTopProductsRes, *exception.Exception)
+	SpendingByCategory(ctx context.Context, req *model.GetCategorySpendingReq) (
+		*model.GetCategorySpendingRes, *exception.Exception,
+	)
+	WalletCashFlow(ctx context.Context, req *model.GetWalletCashFlowReq) (*model.GetWalletCashFlowRes, *exception.Exception)
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/xvalidator"
+	"strings"
+)
+
+type AnalyticsServiceImpl struct {
+	db                     *gorm.DB
+	transactionRepository  repository.TransactionRepository
+	productRepository      repository.ProductRepository
+	categoryRepository     repository.CategoryRepository
+	walletMemberRepository repository.WalletMemberRepository
+	validate               *xvalidator.Validator
+}
+
+func NewAnalyticsService(
+	db *gorm.DB,
+	transactionRepository repository.TransactionRepository,
+	productRepository repository.ProductRepository,
+	categoryRepository repository.CategoryRepository,
+	walletMemberRepository repository.WalletMemberRepository,
+	validate *xvalidator.Validator,
+) AnalyticsService {
+	return &AnalyticsServiceImpl{
+		db:                     db,
+		transactionRepository:  transactionRepository,
+		productRepository:      productRepository,
+		categoryRepository:     categoryRepository,
+		walletMemberRepository: walletMemberRepository,
+		validate:               validate,
+	}
+}
+
+// walletIds returns the wallets req covers, checking the user may view the one asked
+// for. It is empty when the user is a member of no wallet.
+func (s *AnalyticsServiceImpl) walletIds(ctx context.Context, req *model.AnalyticsReq) ([]string, *exception.Exception) {
+	if !req.From.Before(req.To) {
+		return nil, exception.InvalidArgument("from must be before to")
+	}
+	if req.WalletId != "" {
+		if _, errException := authorizeMember(
+			ctx, s.db, s.walletMemberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer,
+		); errException != nil {
+			return nil, errException
+		}
+		return []string{req.WalletId}, nil
+	}
+	ids, err := s.walletMemberRepository.FindWalletIdsByUser(ctx, s.db, req.UserId)
+	if err != nil {
+		return nil, exception.Internal("failed getting member wallets", err)
+	}
+	return ids, nil
+}
+
+func (s *AnalyticsServiceImpl) CashFlow(ctx context.Context, req *model.GetCashFlowReq) (
+	*model.GetCashFlowRes, *exception.Exception,
+) {
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	ids, errException := s.walletIds(ctx, &req.AnalyticsReq)
+	if errException != nil {
+		return nil, errException
+	}
+	response := &model.GetCashFlowRes{From: req.From, To: req.To, Period: req.Period, Periods: []model.CashFlowPeriod{}}
+	if len(ids) == 0 {
+		return response, nil
+	}
+	periods, err := s.transactionRepository.SumByPeriodTx(ctx, s.db, ids, req.Period, req.From, req.To)
+	if err != nil {
+		return nil, exception.Internal("failed summing cash flow", err)
+	}
+	response.Periods = periods
+	return response, nil
+}
+
+func (s *AnalyticsServiceImpl) TopProducts(ctx context.Context, req *model.GetTopProductsReq) (
+	*model.GetTopProductsRes, *exception.Exception,
+) {
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	ids, errException := s.walletIds(ctx, &req.AnalyticsReq)
+	if errException != nil {
+		return nil, errException
+	}
+	response := &model.GetTopProductsRes{From: req.From, To: req.To, Products: []model.ProductSales{}}
+	if len(ids) == 0 {
+		return response, nil
+	}
+	products, err := s.transactionRepository.SumByProductTx(ctx, s.db, ids, req.From, req.To, req.Limit)
+	if err != nil {
+		return nil, exception.Internal("failed summing products", err)
+	}
+	if len(products) == 0 {
+		return response, nil
+	}
+	productIds := make([]string, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, product.ProductId)
+	}
+	found, err := s.productRepository.Find(ctx, s.db, model.OrderParam{}, model.FilterParams{{
+		Field:    "id",
+		Value:    strings.Join(productIds, ","),
+		Operator: "in",
+	}})
+	if err != nil {
+		return nil, exception.Internal("failed getting products", err)
+	}
+	names := make(map[string]string, len(*found))
+	for _, product := range *found {
+		names[product.Id] = product.Name
+	}
+	for i := range products {
+		products[i].Name = names[products[i].ProductId]
+	}
+	response.Products = products
+	return response, nil
+}
+
+func (s *AnalyticsServiceImpl) SpendingByCategory(ctx context.Context, req *model.GetCategorySpendingReq) (
+	*model.GetCategorySpendingRes, *exception.Exception,
+) {
+	ids, errException := s.walletIds(ctx, &req.AnalyticsReq)
+	if errException != nil {
+		return nil, errException
+	}
+	response := &model.GetCategorySpendingRes{From: req.From, To: req.To, Categories: []model.CategorySpending{}}
+	if len(ids) == 0 {
+		return response, nil
+	}
+	categories, err := s.transactionRepository.SumSpendingByCategoryTx(ctx, s.db, ids, req.From, req.To)
+	if err != nil {
+		return nil, exception.Internal("failed summing spending by category", err)
+	}
+	var categoryIds []string
+	for _, category := range categories {
+		if category.CategoryId != nil {
+			categoryIds = append(categoryIds, *category.CategoryId)
+		}
+	}
+	names := map[string]string{}
+	if len(categoryIds) > 0 {
+		found, err := s.categoryRepository.Find(ctx, s.db, model.OrderParam{}, model.FilterParams{{
+			Field:    "id",
+			Value:    strings.Join(categoryIds, ","),
+			Operator: "in",
+		}})
+		if err != nil {
+			return nil, exception.Internal("failed getting categories", err)
+		}
+		for _, category := range *found {
+			names[category.Id] = category.Name
+		}
+	}
+	for i := range categories {
+		categories[i].Name = "Uncategorized"
+		if categories[i].CategoryId != nil {
+			categories[i].Name = names[*categories[i].CategoryId]
+		}
+	}
+	response.Categories = categories
+	return response, nil
+}
+
+func (s *AnalyticsServiceImpl) WalletCashFlow(ctx context.Context, req *model.GetWalletCashFlowReq) (
+	*model.GetWalletCashFlowRes, *exception.Exception,
+) {
+	ids, errException := s.walletIds(ctx, &req.AnalyticsReq)
+	if errException != nil {
+		return nil, errException
+	}
+	response := &model.GetWalletCashFlowRes{From: req.From, To: req.To, Wallets: []model.WalletCashFlow{}}
+	if len(ids) == 0 {
+		return response, nil
+	}
+	wallets, err := s.transactionRepository.SumByWalletTx(ctx, s.db, ids, req.From, req.To)
+	if err != nil {
+		return nil, exception.Internal("failed summing wallet cash flow", err)
+	}
+	response.Wallets = wallets
+	return response, nil
+}
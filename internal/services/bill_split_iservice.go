@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type BillSplitService interface {
+	// Shares are asked for with payment requests and settled by paying them
+	Create(ctx context.Context, req *model.CreateBillSplitReq) (*model.CreateBillSplitRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllBillSplitReq) (*model.GetAllBillSplitRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetBillSplitByIDReq) (*model.GetBillSplitByIDRes, *exception.Exception)
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"math/big"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/utils/converter"
+	"product-wallet/pkg/xvalidator"
+)
+
+type BillSplitServiceImpl struct {
+	db                    *gorm.DB
+	billSplitRepository   repository.BillSplitRepository
+	userRepository        repository.UserRepository
+	walletRepository      repository.WalletRepository
+	transactionRepository repository.TransactionRepository
+	memberRepository      repository.WalletMemberRepository
+	paymentRequestService PaymentRequestService
+	validate              *xvalidator.Validator
+}
+
+func NewBillSplitService(
+	db *gorm.DB,
+	repo repository.BillSplitRepository,
+	userRepository repository.UserRepository,
+	walletRepository repository.WalletRepository,
+	transactionRepository repository.TransactionRepository,
+	memberRepository repository.WalletMemberRepository,
+	paymentRequestService PaymentRequestService,
+	validate *xvalidator.Validator,
+) BillSplitService {
+	return &BillSplitServiceImpl{
+		db:                    db,
+		billSplitRepository:   repo,
+		userRepository:        userRepository,
+		walletRepository:      walletRepository,
+		transactionRepository: transactionRepository,
+		memberRepository:      memberRepository,
+		paymentRequestService: paymentRequestService,
+		validate:              validate,
+	}
+}
+
+// Create splits the bill and asks every other participant for their share with a
+// payment request, all of them are created or none is.
+func (s *BillSplitServiceImpl) Create(
+	ctx context.Context, req *model.CreateBillSplitReq,
+) (*model.CreateBillSplitRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	creator, err := s.userRepository.FindByID(ctx, tx, req.UserId)
+	if err != nil {
+		return nil, exception.Internal("error finding user", err)
+	}
+	if creator == nil {
+		return nil, exception.NotFound("user not found")
+	}
+	seen := make(map[string]bool, len(req.Participants))
+	for _, participant := range req.Participants {
+		if seen[participant.Username] {
+			return nil, exception.InvalidArgument(participant.Username + " is listed more than once")
+		}
+		seen[participant.Username] = true
+	}
+	if len(req.Participants) == 1 && seen[creator.Username] {
+		return nil, exception.InvalidArgument("a bill is split with at least one other user")
+	}
+
+	if req.TransactionId != nil && req.Amount != nil {
+		return nil, exception.InvalidArgument("the split of an expense takes its amount from the transaction, leave amount out")
+	}
+
+	var total *money.Money
+	if req.TransactionId != nil {
+		transaction, errException := s.expense(ctx, tx, *req.TransactionId, req.UserId)
+		if errException != nil {
+			return nil, errException
+		}
+		remaining := transaction.RemainingAmount()
+		total = &remaining
+		if req.WalletId == "" {
+			req.WalletId = transaction.WalletId
+		}
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	if total != nil && total.Currency != wallet.CurrencyCode() {
+		return nil, exception.InvalidArgument("the shares of a " + total.Currency + " expense must be paid into a " + total.Currency + " wallet")
+	}
+	if req.Amount != nil {
+		amount, err := req.Amount.WithCurrency(wallet.CurrencyCode())
+		if err != nil {
+			return nil, exception.InvalidArgument(err.Error())
+		}
+		total = &amount
+	}
+	amount, shares, errException := splitShares(req, total, wallet.CurrencyCode())
+	if errException != nil {
+		return nil, errException
+	}
+
+	ownShare := money.Zero(amount.Currency)
+	for i, participant := range req.Participants {
+		if participant.Username == creator.Username {
+			ownShare = shares[i]
+		}
+	}
+	split := req.ToEntity(wallet.Id, amount, ownShare)
+	if err := s.billSplitRepository.CreateTx(ctx, tx, split); err != nil {
+		return nil, exception.Internal("failed creating bill split", err)
+	}
+	for i, participant := range req.Participants {
+		if participant.Username == creator.Username {
+			continue
+		}
+		request, errException := s.paymentRequestService.CreateTx(ctx, tx, req.ToPaymentRequestReq(*split, participant, shares[i]))
+		if errException != nil {
+			return nil, errException
+		}
+		split.Requests = append(split.Requests, *request)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CreateBillSplitRes{
+		BillSplitRes: model.NewBillSplitRes(*split),
+	}, nil
+}
+
+// expense locks the expense transaction a user splits, which must not be split
+// already nor be fully refunded. The lock makes concurrent splits of the same
+// expense wait, the later one then finds the split of the first.
+func (s *BillSplitServiceImpl) expense(
+	ctx context.Context, tx *gorm.DB, id, userId string,
+) (*entity.Transaction, *exception.Exception) {
+	transaction, err := s.transactionRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting transaction detail", err)
+	}
+	if transaction == nil {
+		return nil, exception.NotFound("transaction not found")
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, transaction.WalletId, userId, entity.WalletRoleSpender); errException != nil {
+		return nil, errException
+	}
+	if transaction.Type != "expense" || transaction.Direction != entity.TransactionDirectionOut {
+		return nil, exception.InvalidArgument("only expenses can be split")
+	}
+	if transaction.Status == entity.TransactionStatusReversed || !transaction.RemainingAmount().IsPositive() {
+		return nil, exception.InvalidArgument("the expense was refunded, nothing is left to split")
+	}
+	existing, err := s.billSplitRepository.FindByFilter(ctx, tx, model.FilterParams{
+		{
+			Field:    "transaction_id",
+			Value:    id,
+			Operator: "=",
+		},
+	}, model.OrderParam{
+		Order:   "asc",
+		OrderBy: "created_at",
+	})
+	if err != nil {
+		return nil, exception.Internal("failed getting bill splits", err)
+	}
+	if existing != nil {
+		return nil, exception.InvalidArgument("the expense is already split")
+	}
+	return transaction, nil
+}
+
+// splitShares works out the total of a split and the share of each participant,
+// in the order they are listed. Equal and percent splits leave the rounding to
+// money.Allocate so the shares always add up to the total.
+func splitShares(
+	req *model.CreateBillSplitReq, total *money.Money, currency string,
+) (money.Money, []money.Money, *exception.Exception) {
+	var shares []money.Money
+	var err error
+	switch req.Method {
+	case entity.BillSplitMethodExact:
+		sum := money.Zero(currency)
+		for _, participant := range req.Participants {
+			if participant.Amount == nil || participant.Percent != "" {
+				return money.Money{}, nil, exception.InvalidArgument("an exact split gives every participant an amount and no percent")
+			}
+			share, err := participant.Amount.WithCurrency(currency)
+			if err != nil {
+				return money.Money{}, nil, exception.InvalidArgument(err.Error())
+			}
+			shares = append(shares, share)
+			sum.Units += share.Units
+		}
+		if total == nil {
+			total = &sum
+		}
+		if sum.Units != total.Units {
+			return money.Money{}, nil, exception.InvalidArgument("the shares add up to " + converter.ToString(sum) + " instead of " + converter.ToString(*total))
+		}
+	case entity.BillSplitMethodPercent:
+		if total == nil {
+			return money.Money{}, nil, exception.InvalidArgument("amount is required to split by percent")
+		}
+		var weights []int64
+		var sum int64
+		for _, participant := range req.Participants {
+			basisPoints, ok := percentBasisPoints(participant.Percent)
+			if !ok || participant.Amount != nil {
+				return money.Money{}, nil, exception.InvalidArgument("a percent split gives every participant a percent above zero with at most two decimals and no amount")
+			}
+			weights = append(weights, basisPoints)
+			sum += basisPoints
+		}
+		if sum != 10000 {
+			return money.Money{}, nil, exception.InvalidArgument("the percents must add up to 100")
+		}
+		shares, err = total.Allocate(weights...)
+	default:
+		if total == nil {
+			return money.Money{}, nil, exception.InvalidArgument("amount is required to split equally")
+		}
+		weights := make([]int64, len(req.Participants))
+		for i, participant := range req.Participants {
+			if participant.Amount != nil || participant.Percent != "" {
+				return money.Money{}, nil, exception.InvalidArgument("an equal split gives participants neither an amount nor a percent")
+			}
+			weights[i] = 1
+		}
+		shares, err = total.Allocate(weights...)
+	}
+	if err != nil {
+		return money.Money{}, nil, exception.InvalidArgument(err.Error())
+	}
+	for _, share := range shares {
+		if !share.IsPositive() {
+			return money.Money{}, nil, exception.InvalidArgument("every share must be greater than zero")
+		}
+	}
+	return total.Normalize(), shares, nil
+}
+
+// percentBasisPoints parses a positive percent with at most two decimals into basis points.
+func percentBasisPoints(percent string) (int64, bool) {
+	value, ok := new(big.Rat).SetString(percent)
+	if !ok {
+		return 0, false
+	}
+	value.Mul(value, big.NewRat(100, 1))
+	if !value.IsInt() || value.Sign() <= 0 || !value.Num().IsInt64() {
+		return 0, false
+	}
+	return value.Num().Int64(), true
+}
+
+func (s *BillSplitServiceImpl) Find(ctx context.Context, req *model.GetAllBillSplitReq) (
+	*model.GetAllBillSplitRes, *exception.Exception,
+) {
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "created_by",
+		Value:    req.UserId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.billSplitRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllBillSplitRes{
+		PaginationData: *result,
+	}, nil
+}
+
+// Detail shows a split to its creator and to the participants asked for a share.
+func (s *BillSplitServiceImpl) Detail(ctx context.Context, req *model.GetBillSplitByIDReq) (
+	*model.GetBillSplitByIDRes, *exception.Exception,
+) {
+	result, err := s.billSplitRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil {
+		return nil, exception.NotFound("bill split not found")
+	}
+	allowed := result.CreatedBy == req.UserId
+	for _, request := range result.Requests {
+		allowed = allowed || request.PayerId == req.UserId
+	}
+	if !allowed {
+		return nil, exception.NotFound("bill split not found")
+	}
+
+	return &model.GetBillSplitByIDRes{
+		BillSplitRes: model.NewBillSplitRes(*result),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/money"
+	"testing"
+	"time"
+)
+
+func TestBillSplitExpense(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	userRepository := repository.NewUserSQLRepository()
+	requests := NewPaymentRequestService(
+		env.db, repository.NewPaymentRequestSQLRepository(), repository.NewPaymentRequestStatusChangeSQLRepository(),
+		userRepository, env.walletRepository, env.memberRepository, env.transactionService,
+		env.validate, time.Hour, 24*time.Hour,
+	)
+	service := NewBillSplitService(
+		env.db, repository.NewBillSplitSQLRepository(), userRepository, env.walletRepository,
+		repository.NewTransactionSQLRepository(), env.memberRepository, requests, env.validate,
+	)
+	owner, wallet := env.user(t, 0)
+	friend, _ := env.user(t, 0)
+	expense := &entity.Transaction{
+		Id: uuid.NewString(), WalletId: wallet.Id, Type: "expense", Direction: entity.TransactionDirectionOut,
+		Status: entity.TransactionStatusCompleted, Amount: money.New(30000, "IDR"), Description: "Dinner",
+	}
+	if err := env.db.Create(expense).Error; err != nil {
+		t.Fatal(err)
+	}
+	split := func(amount *money.Money) *model.CreateBillSplitReq {
+		return &model.CreateBillSplitReq{
+			UserId: owner.Id, TransactionId: &expense.Id, Amount: amount, Method: entity.BillSplitMethodEqual,
+			Participants: []model.SplitParticipantReq{{Username: owner.Username}, {Username: friend.Username}},
+		}
+	}
+
+	amount := money.New(10000, "IDR")
+	if _, errException := service.Create(ctx, split(&amount)); errException == nil {
+		t.Fatal("expense and amount given: want invalid argument")
+	}
+	created, errException := service.Create(ctx, split(nil))
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+	if created.Amount.Units != 30000 {
+		t.Errorf("split amount = %d, want the 30000 of the expense", created.Amount.Units)
+	}
+	if _, errException := service.Create(ctx, split(nil)); errException == nil {
+		t.Fatal("expense split twice: want invalid argument")
+	}
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type BudgetService interface {
+	// Budgets belong to a wallet, owners set them and every member reads them
+	Create(ctx context.Context, req *model.CreateBudgetReq) (*model.CreateBudgetRes, *exception.Exception)
+	Update(ctx context.Context, req *model.UpdateBudgetReq) (*model.UpdateBudgetRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllBudgetReq) (*model.GetAllBudgetRes, *exception.Exception)
+	Delete(ctx context.Context, req *model.DeleteBudgetReq) (*model.DeleteBudgetRes, *exception.Exception)
+
+	// Status reports what was spent against each budget over a calendar month
+	Status(ctx context.Context, req *model.GetBudgetStatusReq) (*model.GetBudgetStatusRes, *exception.Exception)
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+type BudgetServiceImpl struct {
+	db                    *gorm.DB
+	budgetRepository      repository.BudgetRepository
+	categoryRepository    repository.CategoryRepository
+	walletRepository      repository.WalletRepository
+	transactionRepository repository.TransactionRepository
+	memberRepository      repository.WalletMemberRepository
+	validate              *xvalidator.Validator
+}
+
+func NewBudgetService(
+	db *gorm.DB, repo repository.BudgetRepository,
+	categoryRepository repository.CategoryRepository,
+	walletRepository repository.WalletRepository,
+	transactionRepository repository.TransactionRepository,
+	memberRepository repository.WalletMemberRepository,
+	validate *xvalidator.Validator,
+) BudgetService {
+	return &BudgetServiceImpl{
+		db:                    db,
+		budgetRepository:      repo,
+		categoryRepository:    categoryRepository,
+		walletRepository:      walletRepository,
+		transactionRepository: transactionRepository,
+		memberRepository:      memberRepository,
+		validate:              validate,
+	}
+}
+
+func (s *BudgetServiceImpl) Create(
+	ctx context.Context, req *model.CreateBudgetReq,
+) (*model.CreateBudgetRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet not found")
+	}
+	category, err := s.categoryRepository.FindByID(ctx, tx, req.CategoryId)
+	if err != nil {
+		return nil, exception.Internal("failed getting category detail", err)
+	}
+	if category == nil || !category.VisibleTo(req.UserId) {
+		return nil, exception.NotFound("category not found")
+	}
+	if category.Kind != entity.CategoryKindExpense {
+		return nil, exception.InvalidArgument("only expense categories can be budgeted")
+	}
+	duplicateCheck, err := s.budgetRepository.FindByWalletAndCategory(ctx, tx, wallet.Id, category.Id)
+	if err != nil {
+		return nil, exception.Internal("failed getting budget", err)
+	}
+	if duplicateCheck != nil {
+		return nil, exception.AlreadyExists("wallet already has a budget for " + category.Name + ", update it instead")
+	}
+	amount, errException := budgetAmount(wallet, req.Amount)
+	if errException != nil {
+		return nil, errException
+	}
+
+	body := req.ToEntity(amount)
+	if err := s.budgetRepository.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("failed creating budget", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	body.Category = category
+	return &model.CreateBudgetRes{
+		Budget: *body,
+	}, nil
+}
+
+// budgetAmount puts a budget in the currency of its wallet.
+func budgetAmount(wallet *entity.Wallet, amount money.Money) (money.Money, *exception.Exception) {
+	converted, err := amount.WithCurrency(wallet.CurrencyCode())
+	if err != nil {
+		return money.Money{}, exception.InvalidArgument("amount: " + err.Error())
+	}
+	if !converted.IsPositive() {
+		return money.Money{}, exception.InvalidArgument("amount must be greater than zero, delete the budget instead")
+	}
+	return converted, nil
+}
+
+func (s *BudgetServiceImpl) Update(
+	ctx context.Context, req *model.UpdateBudgetReq,
+) (*model.UpdateBudgetRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	budget, errException := s.findForUpdate(ctx, tx, req.WalletId, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, budget.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if budget.Amount, errException = budgetAmount(wallet, req.Amount); errException != nil {
+		return nil, errException
+	}
+	if err := s.budgetRepository.UpdateTx(ctx, tx, budget); err != nil {
+		return nil, exception.Internal("failed updating budget", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.UpdateBudgetRes{
+		Budget: *budget,
+	}, nil
+}
+
+// findForUpdate locks a budget of the wallet, budgets of other wallets are not found.
+func (s *BudgetServiceImpl) findForUpdate(ctx context.Context, tx *gorm.DB, walletId, id string) (*entity.Budget, *exception.Exception) {
+	budget, err := s.budgetRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting budget", err)
+	}
+	if budget == nil || budget.WalletId != walletId {
+		return nil, exception.NotFound("budget not found")
+	}
+	return budget, nil
+}
+
+func (s *BudgetServiceImpl) Find(ctx context.Context, req *model.GetAllBudgetReq) (
+	*model.GetAllBudgetRes, *exception.Exception,
+) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "wallet_id",
+		Value:    req.WalletId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "asc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.budgetRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllBudgetRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *BudgetServiceImpl) Delete(ctx context.Context, req *model.DeleteBudgetReq) (
+	*model.DeleteBudgetRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	budget, errException := s.findForUpdate(ctx, tx, req.WalletId, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	if err := s.budgetRepository.DeleteByIDTx(ctx, tx, budget.Id); err != nil {
+		return nil, exception.Internal("failed deleting budget", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.DeleteBudgetRes{
+		ID: budget.Id,
+	}, nil
+}
+
+func (s *BudgetServiceImpl) Status(ctx context.Context, req *model.GetBudgetStatusReq) (
+	*model.GetBudgetStatusRes, *exception.Exception,
+) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, s.db, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet not found")
+	}
+	if req.Period == "" {
+		req.Period = time.Now().UTC().Format(entity.StatementPeriodLayout)
+	}
+	start, err := time.Parse(entity.StatementPeriodLayout, req.Period)
+	if err != nil {
+		return nil, exception.InvalidArgument("period must be a month formatted as YYYY-MM")
+	}
+	end := start.AddDate(0, 1, 0)
+
+	budgets, err := s.budgetRepository.FindByWalletId(ctx, s.db, wallet.Id)
+	if err != nil {
+		return nil, exception.Internal("failed getting budgets", err)
+	}
+	spent, err := s.transactionRepository.SumOutflowByCategoryTx(ctx, s.db, wallet.Id, start, end)
+	if err != nil {
+		return nil, exception.Internal("failed getting spending", err)
+	}
+
+	currency := wallet.CurrencyCode()
+	response := &model.GetBudgetStatusRes{
+		WalletId:    wallet.Id,
+		Period:      req.Period,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Budgets:     []model.BudgetStatus{},
+		TotalBudget: money.Zero(currency),
+		TotalSpent:  money.Zero(currency),
+		Unbudgeted:  money.Zero(currency),
+	}
+	for _, budget := range budgets {
+		amount := budget.Amount.Normalize()
+		used := spent[budget.CategoryId]
+		delete(spent, budget.CategoryId)
+		status := model.BudgetStatus{
+			BudgetId:  budget.Id,
+			Budget:    amount,
+			Spent:     money.New(used, currency),
+			Remaining: money.New(max(amount.Units-used, 0), currency),
+			Exceeded:  used > amount.Units,
+		}
+		if budget.Category != nil {
+			status.Category = *budget.Category
+		}
+		if amount.IsPositive() {
+			status.UsedPercent = used * 100 / amount.Units
+		}
+		response.Budgets = append(response.Budgets, status)
+		response.TotalBudget.Units += amount.Units
+		response.TotalSpent.Units += used
+	}
+	for _, used := range spent {
+		response.Unbudgeted.Units += used
+	}
+	return response, nil
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type CampaignService interface {
+	// CRUD operations for Campaign, reserved to admins, a campaign ends by being deactivated
+	Create(ctx context.Context, req *model.CreateCampaignReq) (*model.CreateCampaignRes, *exception.Exception)
+	Update(ctx context.Context, req *model.UpdateCampaignReq) (*model.UpdateCampaignRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllCampaignReq) (*model.GetAllCampaignRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetCampaignByIDReq) (*model.GetCampaignByIDRes, *exception.Exception)
+}
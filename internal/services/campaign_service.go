@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+)
+
+type CampaignServiceImpl struct {
+	db                 *gorm.DB
+	campaignRepository repository.CampaignRepository
+	categoryRepository repository.CategoryRepository
+	productRepository  repository.ProductRepository
+	validate           *xvalidator.Validator
+}
+
+func NewCampaignService(
+	db *gorm.DB,
+	repo repository.CampaignRepository,
+	categoryRepository repository.CategoryRepository,
+	productRepository repository.ProductRepository,
+	validate *xvalidator.Validator,
+) CampaignService {
+	return &CampaignServiceImpl{
+		db:                 db,
+		campaignRepository: repo,
+		categoryRepository: categoryRepository,
+		productRepository:  productRepository,
+		validate:           validate,
+	}
+}
+
+func (s *CampaignServiceImpl) Create(
+	ctx context.Context, req *model.CreateCampaignReq,
+) (*model.CreateCampaignRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	currency, errException := knownCurrency(req.Currency)
+	if errException != nil {
+		return nil, errException
+	}
+	body, err := req.ToEntity(currency)
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	if errException := s.check(ctx, tx, body); errException != nil {
+		return nil, errException
+	}
+	if err := s.campaignRepository.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CreateCampaignRes{
+		Campaign: *body,
+	}, nil
+}
+
+// Update replaces a campaign under lock, so that the rewards granted meanwhile
+// stay counted in what it spent.
+func (s *CampaignServiceImpl) Update(
+	ctx context.Context, req *model.UpdateCampaignReq,
+) (*model.UpdateCampaignRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	current, err := s.campaignRepository.FindByIDForUpdate(ctx, tx, req.ID)
+	if err != nil {
+		return nil, exception.Internal("error finding campaign", err)
+	}
+	if current == nil {
+		return nil, exception.NotFound("campaign not found")
+	}
+	currency, errException := knownCurrency(req.Currency)
+	if errException != nil {
+		return nil, errException
+	}
+	if current.Spent.IsPositive() && currency != current.Currency {
+		return nil, exception.PermissionDenied("the currency of a campaign that already rewarded cannot change")
+	}
+	body, err := req.BaseCampaignReq.ToEntity(current.Id, currency)
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	body.Spent = current.Spent
+	body.CreatedAt = current.CreatedAt
+	if errException := s.check(ctx, tx, body); errException != nil {
+		return nil, errException
+	}
+	if err := s.campaignRepository.UpdateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.UpdateCampaignRes{
+		Campaign: *body,
+	}, nil
+}
+
+// check refuses a campaign whose amounts contradict each other or that narrows
+// to a category or product it cannot see.
+func (s *CampaignServiceImpl) check(ctx context.Context, tx *gorm.DB, campaign *entity.Campaign) *exception.Exception {
+	for _, amount := range []money.Money{campaign.MinAmount, campaign.Flat, campaign.MaxReward, campaign.UserCap} {
+		if amount.IsNegative() {
+			return exception.InvalidArgument("amounts of a campaign cannot be negative")
+		}
+	}
+	if !campaign.Budget.IsPositive() {
+		return exception.InvalidArgument("budget must be greater than zero")
+	}
+	if campaign.Budget.LessThan(campaign.Spent) {
+		return exception.InvalidArgument("budget cannot be less than what the campaign already spent: " + campaign.Spent.String())
+	}
+	if campaign.BasisPoints == 0 && !campaign.Flat.IsPositive() {
+		return exception.InvalidArgument("a campaign rewards basis points of the amount, a flat amount or both")
+	}
+	if campaign.Trigger != entity.CampaignTriggerPurchase && (campaign.CategoryId != nil || campaign.ProductId != nil) {
+		return exception.InvalidArgument("only purchase campaigns can be narrowed to a category or a product")
+	}
+	if campaign.CategoryId != nil {
+		category, err := s.categoryRepository.FindByID(ctx, tx, *campaign.CategoryId)
+		if err != nil {
+			return exception.Internal("failed getting category detail", err)
+		}
+		if category == nil || !category.IsSystem() {
+			return exception.NotFound("system category not found")
+		}
+	}
+	if campaign.ProductId != nil {
+		product, err := s.productRepository.FindByID(ctx, tx, *campaign.ProductId)
+		if err != nil {
+			return exception.Internal("error in finding product", err)
+		}
+		if product == nil {
+			return exception.NotFound("product not found")
+		}
+	}
+	return nil
+}
+
+func (s *CampaignServiceImpl) Find(ctx context.Context, req *model.GetAllCampaignReq) (
+	*model.GetAllCampaignRes, *exception.Exception,
+) {
+	result, err := s.campaignRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, req.Filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllCampaignRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *CampaignServiceImpl) Detail(ctx context.Context, req *model.GetCampaignByIDReq) (
+	*model.GetCampaignByIDRes, *exception.Exception,
+) {
+	result, err := s.campaignRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil {
+		return nil, exception.NotFound("campaign not found")
+	}
+
+	return &model.GetCampaignByIDRes{
+		Campaign: *result,
+	}, nil
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type CategoryService interface {
+	// System categories are read only, users manage their own custom categories
+	Create(ctx context.Context, req *model.CreateCategoryReq) (*model.CreateCategoryRes, *exception.Exception)
+	Update(ctx context.Context, req *model.UpdateCategoryReq) (*model.UpdateCategoryRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllCategoryReq) (*model.GetAllCategoryRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetCategoryByIDReq) (*model.GetCategoryByIDRes, *exception.Exception)
+	Delete(ctx context.Context, req *model.DeleteCategoryReq) (*model.DeleteCategoryRes, *exception.Exception)
+}
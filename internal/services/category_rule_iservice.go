@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type CategoryRuleService interface {
+	// Rules belong to a wallet, owners set them and every member reads them
+	Create(ctx context.Context, req *model.CreateCategoryRuleReq) (*model.CreateCategoryRuleRes, *exception.Exception)
+	Update(ctx context.Context, req *model.UpdateCategoryRuleReq) (*model.UpdateCategoryRuleRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllCategoryRuleReq) (*model.GetAllCategoryRuleRes, *exception.Exception)
+	Delete(ctx context.Context, req *model.DeleteCategoryRuleReq) (*model.DeleteCategoryRuleRes, *exception.Exception)
+
+	// Apply runs the rules of a wallet over the transactions it already booked
+	Apply(ctx context.Context, req *model.ApplyCategoryRulesReq) (*model.ApplyCategoryRulesRes, *exception.Exception)
+}
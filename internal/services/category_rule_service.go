@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+)
+
+// categoryRuleBatchSize is how many transactions Apply loads at once.
+const categoryRuleBatchSize = 500
+
+type CategoryRuleServiceImpl struct {
+	db                    *gorm.DB
+	ruleRepository        repository.CategoryRuleRepository
+	categoryRepository    repository.CategoryRepository
+	walletRepository      repository.WalletRepository
+	productRepository     repository.ProductRepository
+	transactionRepository repository.TransactionRepository
+	memberRepository      repository.WalletMemberRepository
+	validate              *xvalidator.Validator
+}
+
+func NewCategoryRuleService(
+	db *gorm.DB, repo repository.CategoryRuleRepository,
+	categoryRepository repository.CategoryRepository,
+	walletRepository repository.WalletRepository,
+	productRepository repository.ProductRepository,
+	transactionRepository repository.TransactionRepository,
+	memberRepository repository.WalletMemberRepository,
+	validate *xvalidator.Validator,
+) CategoryRuleService {
+	return &CategoryRuleServiceImpl{
+		db:                    db,
+		ruleRepository:        repo,
+		categoryRepository:    categoryRepository,
+		walletRepository:      walletRepository,
+		productRepository:     productRepository,
+		transactionRepository: transactionRepository,
+		memberRepository:      memberRepository,
+		validate:              validate,
+	}
+}
+
+func (s *CategoryRuleServiceImpl) Create(
+	ctx context.Context, req *model.CreateCategoryRuleReq,
+) (*model.CreateCategoryRuleRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	body := req.ToEntity()
+	if errException := s.checkRule(ctx, tx, body); errException != nil {
+		return nil, errException
+	}
+	if err := s.ruleRepository.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("failed creating category rule", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CreateCategoryRuleRes{
+		CategoryRule: *body,
+	}, nil
+}
+
+func (s *CategoryRuleServiceImpl) Update(
+	ctx context.Context, req *model.UpdateCategoryRuleReq,
+) (*model.UpdateCategoryRuleRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	current, errException := s.findForUpdate(ctx, tx, req.WalletId, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	body := req.ToEntity()
+	body.Id = current.Id
+	body.CreatedAt = current.CreatedAt
+	if errException := s.checkRule(ctx, tx, body); errException != nil {
+		return nil, errException
+	}
+	if err := s.ruleRepository.UpdateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("failed updating category rule", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.UpdateCategoryRuleRes{
+		CategoryRule: *body,
+	}, nil
+}
+
+// checkRule refuses a rule doing nothing or naming what its creator cannot see, and
+// puts its amounts in the currency of the wallet.
+func (s *CategoryRuleServiceImpl) checkRule(ctx context.Context, tx *gorm.DB, rule *entity.CategoryRule) *exception.Exception {
+	if rule.CategoryId == nil && len(rule.Tags) == 0 {
+		return exception.InvalidArgument("a rule must set a category, tags or both")
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, rule.WalletId)
+	if err != nil {
+		return exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return exception.NotFound("wallet not found")
+	}
+	for _, bound := range []*money.Money{&rule.MinAmount, &rule.MaxAmount} {
+		if bound.IsZero() {
+			*bound = money.Zero(wallet.CurrencyCode())
+			continue
+		}
+		converted, err := bound.WithCurrency(wallet.CurrencyCode())
+		if err != nil {
+			return exception.InvalidArgument("amount: " + err.Error())
+		}
+		if converted.IsNegative() {
+			return exception.InvalidArgument("amounts of a rule cannot be negative")
+		}
+		*bound = converted
+	}
+	if !rule.MaxAmount.IsZero() && rule.MaxAmount.LessThan(rule.MinAmount) {
+		return exception.InvalidArgument("max_amount cannot be less than min_amount")
+	}
+	if rule.CategoryId != nil {
+		category, err := s.categoryRepository.FindByID(ctx, tx, *rule.CategoryId)
+		if err != nil {
+			return exception.Internal("failed getting category detail", err)
+		}
+		if category == nil || !category.VisibleTo(rule.CreatedBy) {
+			return exception.NotFound("category not found")
+		}
+	}
+	if rule.ProductId != nil {
+		product, err := s.productRepository.FindByID(ctx, tx, *rule.ProductId)
+		if err != nil {
+			return exception.Internal("failed getting product detail", err)
+		}
+		if product == nil {
+			return exception.NotFound("product not found")
+		}
+	}
+	if rule.CounterpartyWalletId != nil {
+		if *rule.CounterpartyWalletId == wallet.Id {
+			return exception.InvalidArgument("a wallet cannot be its own counterparty")
+		}
+		counterparty, err := s.walletRepository.FindByID(ctx, tx, *rule.CounterpartyWalletId)
+		if err != nil {
+			return exception.Internal("failed getting wallet detail", err)
+		}
+		if counterparty == nil {
+			return exception.NotFound("counterparty wallet not found")
+		}
+	}
+	return nil
+}
+
+// findForUpdate locks a rule of the wallet, rules of other wallets are not found.
+func (s *CategoryRuleServiceImpl) findForUpdate(
+	ctx context.Context, tx *gorm.DB, walletId, id string,
+) (*entity.CategoryRule, *exception.Exception) {
+	rule, err := s.ruleRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting category rule", err)
+	}
+	if rule == nil || rule.WalletId != walletId {
+		return nil, exception.NotFound("category rule not found")
+	}
+	return rule, nil
+}
+
+func (s *CategoryRuleServiceImpl) Find(ctx context.Context, req *model.GetAllCategoryRuleReq) (
+	*model.GetAllCategoryRuleRes, *exception.Exception,
+) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "wallet_id",
+		Value:    req.WalletId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "asc",
+			OrderBy: "priority",
+		}
+	}
+	result, err := s.ruleRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllCategoryRuleRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *CategoryRuleServiceImpl) Delete(ctx context.Context, req *model.DeleteCategoryRuleReq) (
+	*model.DeleteCategoryRuleRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	rule, errException := s.findForUpdate(ctx, tx, req.WalletId, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	if err := s.ruleRepository.DeleteByIDTx(ctx, tx, rule.Id); err != nil {
+		return nil, exception.Internal("failed deleting category rule", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.DeleteCategoryRuleRes{
+		ID: rule.Id,
+	}, nil
+}
+
+// Apply files the history of a wallet as its rules would have filed it, a matching
+// rule overriding the category a transaction was given by hand. Transactions no rule
+// matches are left as they are.
+func (s *CategoryRuleServiceImpl) Apply(ctx context.Context, req *model.ApplyCategoryRulesReq) (
+	*model.ApplyCategoryRulesRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	rules, err := s.ruleRepository.FindByWalletId(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting category rules", err)
+	}
+	response := &model.ApplyCategoryRulesRes{
+		WalletId: req.WalletId,
+	}
+	if len(rules) == 0 {
+		return response, nil
+	}
+	err = s.transactionRepository.FindByWalletInBatches(ctx, tx, req.WalletId, categoryRuleBatchSize,
+		func(transactions []entity.Transaction) error {
+			for i := range transactions {
+				response.Checked++
+				if !fileByRules(rules, &transactions[i], false) {
+					continue
+				}
+				if err := s.transactionRepository.UpdateFilingTx(ctx, tx, &transactions[i]); err != nil {
+					return err
+				}
+				response.Updated++
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, exception.Internal("failed applying category rules", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return response, nil
+}
+
+// fileByRules runs rules, in the order they were found, over a transaction. The
+// first matching rule with a category files it there unless keepCategory, every
+// matching rule adds its tags. It reports whether the transaction changed.
+func fileByRules(rules []entity.CategoryRule, transaction *entity.Transaction, keepCategory bool) bool {
+	categoryId, tags := transaction.CategoryId, transaction.Tags
+	decided := keepCategory
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.Matches(transaction) {
+			continue
+		}
+		if !decided && rule.CategoryId != nil {
+			categoryId, decided = rule.CategoryId, true
+		}
+		tags = tags.Add(rule.Tags...)
+	}
+	changed := len(tags) != len(transaction.Tags) ||
+		(categoryId == nil) != (transaction.CategoryId == nil) ||
+		(categoryId != nil && *categoryId != *transaction.CategoryId)
+	transaction.CategoryId, transaction.Tags = categoryId, tags
+	return changed
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/xvalidator"
+)
+
+type CategoryServiceImpl struct {
+	db       *gorm.DB
+	repo     repository.CategoryRepository
+	validate *xvalidator.Validator
+}
+
+func NewCategoryService(
+	db *gorm.DB, repo repository.CategoryRepository,
+	validate *xvalidator.Validator,
+) CategoryService {
+	return &CategoryServiceImpl{
+		db:       db,
+		repo:     repo,
+		validate: validate,
+	}
+}
+
+func (s *CategoryServiceImpl) Create(
+	ctx context.Context, req *model.CreateCategoryReq,
+) (*model.CreateCategoryRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	body := req.ToEntity()
+	if errException := s.checkName(ctx, tx, body); errException != nil {
+		return nil, errException
+	}
+
+	if err := s.repo.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CreateCategoryRes{
+		Category: *body,
+	}, nil
+}
+
+func (s *CategoryServiceImpl) Update(
+	ctx context.Context, req *model.UpdateCategoryReq,
+) (*model.UpdateCategoryRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	current, errException := s.findOwn(ctx, tx, req.ID, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	body := req.ToEntity()
+	body.Id = current.Id
+	body.CreatedAt = current.CreatedAt
+	if errException := s.checkName(ctx, tx, body); errException != nil {
+		return nil, errException
+	}
+	if err := s.repo.UpdateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.UpdateCategoryRes{
+		Category: *body,
+	}, nil
+}
+
+// checkName refuses a custom category named like a system category or like
+// another category of the same user.
+func (s *CategoryServiceImpl) checkName(ctx context.Context, tx *gorm.DB, category *entity.Category) *exception.Exception {
+	for _, owner := range []*string{nil, category.UserId} {
+		duplicateCheck, err := s.repo.FindByName(ctx, tx, owner, category.Name)
+		if err != nil {
+			return exception.Internal("error finding category", err)
+		}
+		if duplicateCheck != nil && duplicateCheck.Id != category.Id {
+			return exception.AlreadyExists("category " + category.Name + " already exists")
+		}
+	}
+	return nil
+}
+
+// findOwn returns a custom category of userId, system categories cannot be changed.
+func (s *CategoryServiceImpl) findOwn(ctx context.Context, tx *gorm.DB, id, userId string) (*entity.Category, *exception.Exception) {
+	category, err := s.repo.FindByID(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("error finding category", err)
+	}
+	if category == nil || !category.VisibleTo(userId) {
+		return nil, exception.NotFound("category not found")
+	}
+	if category.IsSystem() {
+		return nil, exception.PermissionDenied("system categories cannot be changed")
+	}
+	return category, nil
+}
+
+func (s *CategoryServiceImpl) Find(ctx context.Context, req *model.GetAllCategoryReq) (
+	*model.GetAllCategoryRes, *exception.Exception,
+) {
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "asc",
+			OrderBy: "name",
+		}
+	}
+	result, err := s.repo.FindVisibleByPagination(ctx, s.db, req.UserId, req.Page, req.Sort, req.Filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllCategoryRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *CategoryServiceImpl) Detail(ctx context.Context, req *model.GetCategoryByIDReq) (
+	*model.GetCategoryByIDRes, *exception.Exception,
+) {
+	result, err := s.repo.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil || !result.VisibleTo(req.UserId) {
+		return nil, exception.NotFound("category not found")
+	}
+
+	return &model.GetCategoryByIDRes{
+		Category: *result,
+	}, nil
+}
+
+// Delete removes a custom category, its transactions become uncategorized and
+// its budgets go with it.
+func (s *CategoryServiceImpl) Delete(ctx context.Context, req *model.DeleteCategoryReq) (
+	*model.DeleteCategoryRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	category, errException := s.findOwn(ctx, tx, req.ID, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	if err := s.repo.DeleteByIDTx(ctx, tx, category.Id); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.DeleteCategoryRes{
+		ID: category.Id,
+	}, nil
+}
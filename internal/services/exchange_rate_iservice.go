@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+)
+
+type ExchangeRateService interface {
+	// CRUD operations for ExchangeRate, reserved to admins
+	Create(
+		ctx context.Context, req *model.CreateExchangeRateReq,
+	) (*model.CreateExchangeRateRes, *exception.Exception)
+	Update(
+		ctx context.Context, req *model.UpdateExchangeRateReq,
+	) (*model.UpdateExchangeRateRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllExchangeRateReq) (*model.GetAllExchangeRateRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetExchangeRateByIDReq) (*model.GetExchangeRateByIDRes, *exception.Exception)
+	Delete(ctx context.Context, req *model.DeleteExchangeRateReq) (*model.DeleteExchangeRateRes, *exception.Exception)
+
+	// Convert exchanges an amount into currency and returns the rate used
+	Convert(ctx context.Context, tx *gorm.DB, amount money.Money, currency string) (
+		money.Money, money.Rate, *exception.Exception,
+	)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+	"strings"
+)
+
+type ExchangeRateServiceImpl struct {
+	db       *gorm.DB
+	repo     repository.ExchangeRateRepository
+	validate *xvalidator.Validator
+}
+
+func NewExchangeRateService(
+	db *gorm.DB, repo repository.ExchangeRateRepository,
+	validate *xvalidator.Validator,
+) ExchangeRateService {
+	return &ExchangeRateServiceImpl{
+		db:       db,
+		repo:     repo,
+		validate: validate,
+	}
+}
+
+func (s *ExchangeRateServiceImpl) Create(
+	ctx context.Context, req *model.CreateExchangeRateReq,
+) (*model.CreateExchangeRateRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	body := req.ToEntity()
+	duplicateCheck, err := s.repo.FindPair(ctx, s.db, body.BaseCurrency, body.QuoteCurrency)
+	if err != nil {
+		return nil, exception.Internal("error finding exchange rate", err)
+	}
+	if duplicateCheck != nil {
+		return nil, exception.PermissionDenied("exchange rate already exists")
+	}
+
+	if err := s.repo.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CreateExchangeRateRes{
+		ExchangeRate: *body,
+	}, nil
+}
+
+func (s *ExchangeRateServiceImpl) Update(
+	ctx context.Context, req *model.UpdateExchangeRateReq,
+) (*model.UpdateExchangeRateRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	current, err := s.repo.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("error finding exchange rate", err)
+	}
+	if current == nil {
+		return nil, exception.NotFound("exchange rate not found")
+	}
+	body := req.ToEntity()
+	duplicateCheck, err := s.repo.FindPair(ctx, s.db, body.BaseCurrency, body.QuoteCurrency)
+	if err != nil {
+		return nil, exception.Internal("error finding exchange rate", err)
+	}
+	if duplicateCheck != nil && duplicateCheck.Id != req.ID {
+		return nil, exception.PermissionDenied("exchange rate already exists")
+	}
+	body.Id = req.ID
+	body.CreatedAt = current.CreatedAt
+	if err := s.repo.UpdateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.UpdateExchangeRateRes{
+		ExchangeRate: *body,
+	}, nil
+}
+
+func (s *ExchangeRateServiceImpl) Find(ctx context.Context, req *model.GetAllExchangeRateReq) (
+	*model.GetAllExchangeRateRes, *exception.Exception,
+) {
+	result, err := s.repo.FindByPagination(ctx, s.db, req.Page, req.Sort, req.Filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllExchangeRateRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *ExchangeRateServiceImpl) Detail(ctx context.Context, req *model.GetExchangeRateByIDReq) (
+	*model.GetExchangeRateByIDRes, *exception.Exception,
+) {
+	result, err := s.repo.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil {
+		return nil, exception.NotFound("exchange rate not found")
+	}
+
+	return &model.GetExchangeRateByIDRes{
+		ExchangeRate: *result,
+	}, nil
+}
+
+func (s *ExchangeRateServiceImpl) Delete(ctx context.Context, req *model.DeleteExchangeRateReq) (
+	*model.DeleteExchangeRateRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+
+	if err := s.repo.DeleteByIDTx(ctx, tx, req.ID); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.DeleteExchangeRateRes{
+		ID: req.ID,
+	}, nil
+}
+
+// Convert looks up the explicit pair first and falls back to the inverse of the opposite pair.
+func (s *ExchangeRateServiceImpl) Convert(
+	ctx context.Context, tx *gorm.DB, amount money.Money, currency string,
+) (money.Money, money.Rate, *exception.Exception) {
+	amount = amount.Normalize()
+	currency = strings.ToUpper(currency)
+	if amount.Currency == currency {
+		return amount, money.OneRate(), nil
+	}
+	var rate money.Rate
+	pair, err := s.repo.FindPair(ctx, tx, amount.Currency, currency)
+	if err != nil {
+		return money.Money{}, money.Rate{}, exception.Internal("error finding exchange rate", err)
+	}
+	if pair != nil {
+		rate = pair.Rate
+	} else {
+		inverse, err := s.repo.FindPair(ctx, tx, currency, amount.Currency)
+		if err != nil {
+			return money.Money{}, money.Rate{}, exception.Internal("error finding exchange rate", err)
+		}
+		if inverse == nil {
+			return money.Money{}, money.Rate{}, exception.PermissionDenied("no exchange rate from " + amount.Currency + " to " + currency)
+		}
+		rate = inverse.Rate.Inverse()
+	}
+	converted, err := money.Convert(amount, rate, currency, money.DefaultRounding)
+	if err != nil {
+		return money.Money{}, money.Rate{}, exception.Internal("failed converting amount", err)
+	}
+	if amount.IsPositive() && !converted.IsPositive() {
+		return money.Money{}, money.Rate{}, exception.InvalidArgument("amount is too small to convert into " + currency)
+	}
+	return converted, rate, nil
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+)
+
+type FeeService interface {
+	// CRUD operations for FeeRule, reserved to admins
+	Create(ctx context.Context, req *model.CreateFeeRuleReq) (*model.CreateFeeRuleRes, *exception.Exception)
+	Update(ctx context.Context, req *model.UpdateFeeRuleReq) (*model.UpdateFeeRuleRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllFeeRuleReq) (*model.GetAllFeeRuleRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetFeeRuleByIDReq) (*model.GetFeeRuleByIDRes, *exception.Exception)
+	Delete(ctx context.Context, req *model.DeleteFeeRuleReq) (*model.DeleteFeeRuleRes, *exception.Exception)
+
+	// Quote tells a user what an operation would cost before they confirm it
+	Quote(ctx context.Context, req *model.QuoteFeeReq) (*model.QuoteFeeRes, *exception.Exception)
+
+	// QuoteTx prices an operation moving amount out of wallet inside the caller's database transaction
+	QuoteTx(ctx context.Context, tx *gorm.DB, operation string, wallet *entity.Wallet, amount money.Money) (
+		*model.FeeQuote, *exception.Exception,
+	)
+	// HouseWalletId is the wallet fees are credited to, empty while none is configured
+	HouseWalletId() string
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+)
+
+type FeeServiceImpl struct {
+	db                *gorm.DB
+	feeRuleRepository repository.FeeRuleRepository
+	walletRepository  repository.WalletRepository
+	memberRepository  repository.WalletMemberRepository
+	validate          *xvalidator.Validator
+	houseWalletId     string
+}
+
+func NewFeeService(
+	db *gorm.DB,
+	repo repository.FeeRuleRepository,
+	walletRepository repository.WalletRepository,
+	memberRepository repository.WalletMemberRepository,
+	validate *xvalidator.Validator,
+	houseWalletId string,
+) FeeService {
+	return &FeeServiceImpl{
+		db:                db,
+		feeRuleRepository: repo,
+		walletRepository:  walletRepository,
+		memberRepository:  memberRepository,
+		validate:          validate,
+		houseWalletId:     houseWalletId,
+	}
+}
+
+func (s *FeeServiceImpl) Create(
+	ctx context.Context, req *model.CreateFeeRuleReq,
+) (*model.CreateFeeRuleRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	currency, errException := knownCurrency(req.Currency)
+	if errException != nil {
+		return nil, errException
+	}
+	body, err := req.ToEntity(currency)
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	if errException := checkFeeRule(body); errException != nil {
+		return nil, errException
+	}
+	if err := s.feeRuleRepository.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CreateFeeRuleRes{
+		FeeRule: *body,
+	}, nil
+}
+
+func (s *FeeServiceImpl) Update(
+	ctx context.Context, req *model.UpdateFeeRuleReq,
+) (*model.UpdateFeeRuleRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	current, err := s.feeRuleRepository.FindByID(ctx, tx, req.ID)
+	if err != nil {
+		return nil, exception.Internal("error finding fee rule", err)
+	}
+	if current == nil {
+		return nil, exception.NotFound("fee rule not found")
+	}
+	currency, errException := knownCurrency(req.Currency)
+	if errException != nil {
+		return nil, errException
+	}
+	body, err := req.BaseFeeRuleReq.ToEntity(current.Id, currency)
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	if errException := checkFeeRule(body); errException != nil {
+		return nil, errException
+	}
+	body.CreatedAt = current.CreatedAt
+	if err := s.feeRuleRepository.UpdateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.UpdateFeeRuleRes{
+		FeeRule: *body,
+	}, nil
+}
+
+// knownCurrency is the upper-cased code of a supported currency.
+func knownCurrency(code string) (string, *exception.Exception) {
+	currency := money.Zero(code).Currency
+	if !money.IsKnownCurrency(currency) {
+		return "", exception.InvalidArgument("unknown currency " + code)
+	}
+	return currency, nil
+}
+
+// checkFeeRule refuses a rule whose amounts contradict each other.
+func checkFeeRule(rule *entity.FeeRule) *exception.Exception {
+	for _, amount := range []money.Money{rule.AmountFrom, rule.AmountTo, rule.Flat, rule.MinFee, rule.MaxFee} {
+		if amount.IsNegative() {
+			return exception.InvalidArgument("amounts of a fee rule cannot be negative")
+		}
+	}
+	if rule.AmountTo.IsPositive() && rule.AmountTo.Units <= rule.AmountFrom.Units {
+		return exception.InvalidArgument("amount_to must be greater than amount_from")
+	}
+	if rule.MaxFee.IsPositive() && rule.MaxFee.Units < rule.MinFee.Units {
+		return exception.InvalidArgument("max_fee cannot be less than min_fee")
+	}
+	return nil
+}
+
+func (s *FeeServiceImpl) Find(ctx context.Context, req *model.GetAllFeeRuleReq) (
+	*model.GetAllFeeRuleRes, *exception.Exception,
+) {
+	result, err := s.feeRuleRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, req.Filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllFeeRuleRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *FeeServiceImpl) Detail(ctx context.Context, req *model.GetFeeRuleByIDReq) (
+	*model.GetFeeRuleByIDRes, *exception.Exception,
+) {
+	result, err := s.feeRuleRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil {
+		return nil, exception.NotFound("fee rule not found")
+	}
+
+	return &model.GetFeeRuleByIDRes{
+		FeeRule: *result,
+	}, nil
+}
+
+func (s *FeeServiceImpl) Delete(ctx context.Context, req *model.DeleteFeeRuleReq) (
+	*model.DeleteFeeRuleRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	current, err := s.feeRuleRepository.FindByID(ctx, tx, req.ID)
+	if err != nil {
+		return nil, exception.Internal("error finding fee rule", err)
+	}
+	if current == nil {
+		return nil, exception.NotFound("fee rule not found")
+	}
+	if err := s.feeRuleRepository.DeleteByIDTx(ctx, tx, req.ID); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.DeleteFeeRuleRes{
+		ID: req.ID,
+	}, nil
+}
+
+func (s *FeeServiceImpl) Quote(ctx context.Context, req *model.QuoteFeeReq) (
+	*model.QuoteFeeRes, *exception.Exception,
+) {
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, exception.InvalidArgument("amount must be greater than zero")
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, s.db, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, wallet.Id, req.UserId, entity.WalletRoleSpender); errException != nil {
+		return nil, errException
+	}
+	amount, err := req.Amount.WithCurrency(wallet.CurrencyCode())
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	quote, errException := s.QuoteTx(ctx, s.db, req.Operation, wallet, amount.Normalize())
+	if errException != nil {
+		return nil, errException
+	}
+	return &model.QuoteFeeRes{
+		FeeQuote: *quote,
+	}, nil
+}
+
+// QuoteTx prices an operation with the first rule covering it. The house wallet
+// pays no fees, and none are charged while no house wallet is configured.
+func (s *FeeServiceImpl) QuoteTx(
+	ctx context.Context, tx *gorm.DB, operation string, wallet *entity.Wallet, amount money.Money,
+) (*model.FeeQuote, *exception.Exception) {
+	quote := &model.FeeQuote{
+		Operation: operation,
+		Amount:    amount,
+		Fee:       money.Zero(amount.Currency),
+		Total:     amount,
+	}
+	if s.houseWalletId == "" || wallet.Id == s.houseWalletId {
+		return quote, nil
+	}
+	rules, err := s.feeRuleRepository.FindActive(ctx, tx, operation, amount.Currency)
+	if err != nil {
+		return nil, exception.Internal("failed getting fee rules", err)
+	}
+	for i := range *rules {
+		rule := &(*rules)[i]
+		if !rule.Covers(wallet.TierCode(), amount) {
+			continue
+		}
+		quote.Fee = rule.Fee(amount)
+		if quote.Fee.IsPositive() {
+			quote.RuleId = &rule.Id
+			quote.HouseWalletId = s.houseWalletId
+			quote.Total.Units += quote.Fee.Units
+		}
+		break
+	}
+	return quote, nil
+}
+
+func (s *FeeServiceImpl) HouseWalletId() string {
+	return s.houseWalletId
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/money"
+	"testing"
+)
+
+// feeRule stores rule for the rest of the test and returns its id.
+func (env *testEnv) feeRule(t *testing.T, rule entity.FeeRule) string {
+	t.Helper()
+	rule.Id = uuid.NewString()
+	active := rule.Active
+	if err := env.feeRuleRepository.CreateTx(context.Background(), env.db, &rule); err != nil {
+		t.Fatal(err)
+	}
+	// the column defaults to active, an inactive rule is switched off once stored
+	if !active {
+		if err := env.db.Model(&entity.FeeRule{}).Where("id = ?", rule.Id).Update("active", false).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() {
+		env.db.Delete(&entity.FeeRule{}, "id = ?", rule.Id)
+	})
+	return rule.Id
+}
+
+func idr(units int64) money.Money {
+	return money.New(units, "IDR")
+}
+
+func TestFeeQuote(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	const house = "00000000-0000-0000-0000-00000000f0e5"
+	service := NewFeeService(env.db, env.feeRuleRepository, env.walletRepository, env.memberRepository, env.validate, house)
+	standard := &entity.Wallet{Id: uuid.NewString(), Tier: entity.WalletTierStandard}
+	premium := &entity.Wallet{Id: uuid.NewString(), Tier: entity.WalletTierPremium}
+
+	tests := []struct {
+		name     string
+		rules    []entity.FeeRule
+		wallet   *entity.Wallet
+		amount   int64
+		wantFee  int64
+		wantRule int // index in rules of the rule charged, -1 for none
+	}{
+		{
+			name:     "no rule",
+			wallet:   standard,
+			amount:   100000,
+			wantRule: -1,
+		},
+		{
+			name:     "flat plus basis points",
+			rules:    []entity.FeeRule{{Flat: idr(500), BasisPoints: 150, Active: true}},
+			wallet:   standard,
+			amount:   100000,
+			wantFee:  2000,
+			wantRule: 0,
+		},
+		{
+			name:     "raised to the minimum",
+			rules:    []entity.FeeRule{{BasisPoints: 100, MinFee: idr(250), Active: true}},
+			wallet:   standard,
+			amount:   1000,
+			wantFee:  250,
+			wantRule: 0,
+		},
+		{
+			name:     "capped at the maximum",
+			rules:    []entity.FeeRule{{BasisPoints: 100, MaxFee: idr(50000), Active: true}},
+			wallet:   standard,
+			amount:   10000000,
+			wantFee:  50000,
+			wantRule: 0,
+		},
+		{
+			name:     "half a unit rounds to even, up",
+			rules:    []entity.FeeRule{{BasisPoints: 100, Active: true}},
+			wallet:   standard,
+			amount:   150,
+			wantFee:  2,
+			wantRule: 0,
+		},
+		{
+			name:     "half a unit rounds to even, down",
+			rules:    []entity.FeeRule{{BasisPoints: 100, Active: true}},
+			wallet:   standard,
+			amount:   250,
+			wantFee:  2,
+			wantRule: 0,
+		},
+		{
+			name: "below the upper bound of a range",
+			rules: []entity.FeeRule{
+				{AmountTo: idr(100000), BasisPoints: 100, Active: true},
+				{AmountFrom: idr(100000), BasisPoints: 50, Active: true},
+			},
+			wallet:   standard,
+			amount:   99999,
+			wantFee:  1000,
+			wantRule: 0,
+		},
+		{
+			name: "upper bound belongs to the next range",
+			rules: []entity.FeeRule{
+				{AmountTo: idr(100000), BasisPoints: 100, Active: true},
+				{AmountFrom: idr(100000), BasisPoints: 50, Active: true},
+			},
+			wallet:   standard,
+			amount:   100000,
+			wantFee:  500,
+			wantRule: 1,
+		},
+		{
+			name: "tier rule wins",
+			rules: []entity.FeeRule{
+				{BasisPoints: 100, Active: true},
+				{Tier: entity.WalletTierPremium, BasisPoints: 10, Active: true},
+			},
+			wallet:   premium,
+			amount:   100000,
+			wantFee:  100,
+			wantRule: 1,
+		},
+		{
+			name: "rule of another tier",
+			rules: []entity.FeeRule{
+				{BasisPoints: 100, Active: true},
+				{Tier: entity.WalletTierPremium, BasisPoints: 10, Active: true},
+			},
+			wallet:   standard,
+			amount:   100000,
+			wantFee:  1000,
+			wantRule: 0,
+		},
+		{
+			name:     "inactive rule",
+			rules:    []entity.FeeRule{{BasisPoints: 100}},
+			wallet:   standard,
+			amount:   100000,
+			wantRule: -1,
+		},
+		{
+			name:     "rule of another currency",
+			rules:    []entity.FeeRule{{Currency: "USD", BasisPoints: 100, Active: true}},
+			wallet:   standard,
+			amount:   100000,
+			wantRule: -1,
+		},
+		{
+			name:     "house wallet pays none",
+			rules:    []entity.FeeRule{{BasisPoints: 100, Active: true}},
+			wallet:   &entity.Wallet{Id: house},
+			amount:   100000,
+			wantRule: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := make([]string, len(tt.rules))
+			for i, rule := range tt.rules {
+				rule.Operation = entity.FeeOperationWithdrawal
+				if rule.Currency == "" {
+					rule.Currency = "IDR"
+				}
+				ids[i] = env.feeRule(t, rule)
+			}
+			quote, errException := service.QuoteTx(ctx, env.db, entity.FeeOperationWithdrawal, tt.wallet, idr(tt.amount))
+			if errException != nil {
+				t.Fatal(errException.Message)
+			}
+			if quote.Fee.Units != tt.wantFee {
+				t.Errorf("fee = %d, want %d", quote.Fee.Units, tt.wantFee)
+			}
+			if quote.Total.Units != tt.amount+tt.wantFee {
+				t.Errorf("total = %d, want %d", quote.Total.Units, tt.amount+tt.wantFee)
+			}
+			switch {
+			case tt.wantRule < 0 && quote.RuleId != nil:
+				t.Errorf("rule = %s, want none", *quote.RuleId)
+			case tt.wantRule >= 0 && (quote.RuleId == nil || *quote.RuleId != ids[tt.wantRule] || quote.HouseWalletId != house):
+				t.Errorf("rule = %v, house = %q, want %s for %s", quote.RuleId, quote.HouseWalletId, ids[tt.wantRule], house)
+			}
+		})
+	}
+}
+
+func TestTransferChargesFee(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	_, house := env.user(t, 0)
+	service := env.newTransactionService(
+		NewFeeService(env.db, env.feeRuleRepository, env.walletRepository, env.memberRepository, env.validate, house.Id),
+	)
+	env.feeRule(t, entity.FeeRule{
+		Operation: entity.FeeOperationTransfer, Currency: "IDR", Flat: idr(1000), Active: true,
+	})
+	owner, sender := env.user(t, 100000)
+	_, receiver := env.user(t, 0)
+
+	transfer, errException := service.Transfer(ctx, &model.TransferTransactionReq{
+		UserId: owner.Id, SenderId: sender.Id, ReceiverId: receiver.Id, Amount: idr(50000),
+	})
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+	if transfer.FeeTransaction == nil || transfer.FeeTransaction.Amount.Units != 1000 {
+		t.Fatalf("fee transaction = %v, want 1000", transfer.FeeTransaction)
+	}
+	for _, want := range []struct {
+		wallet  *entity.Wallet
+		balance int64
+	}{
+		{sender, 49000},
+		{receiver, 50000},
+		{house, 1000},
+	} {
+		if got := env.wallet(t, want.wallet.Id).Balance.Units; got != want.balance {
+			t.Errorf("balance of %s = %d, want %d", want.wallet.Id, got, want.balance)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type HoldService interface {
+	// Two-phase payments, funds are reserved by Authorize and moved by Capture
+	Authorize(ctx context.Context, req *model.AuthorizeHoldReq) (*model.AuthorizeHoldRes, *exception.Exception)
+	Capture(ctx context.Context, req *model.CaptureHoldReq) (*model.CaptureHoldRes, *exception.Exception)
+	Void(ctx context.Context, req *model.VoidHoldReq) (*model.VoidHoldRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllHoldReq) (*model.GetAllHoldRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetHoldByIDReq) (*model.GetHoldByIDRes, *exception.Exception)
+
+	// Expire releases the holds past their expiry and returns how many were released
+	Expire(ctx context.Context) (int64, *exception.Exception)
+}
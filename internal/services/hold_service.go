@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/utils/converter"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+type HoldServiceImpl struct {
+	db                 *gorm.DB
+	holdRepository     repository.HoldRepository
+	walletRepository   repository.WalletRepository
+	memberRepository   repository.WalletMemberRepository
+	transactionService TransactionService
+	validate           *xvalidator.Validator
+	defaultTTL         time.Duration
+	maxTTL             time.Duration
+}
+
+func NewHoldService(
+	db *gorm.DB,
+	repo repository.HoldRepository,
+	walletRepository repository.WalletRepository,
+	memberRepository repository.WalletMemberRepository,
+	transactionService TransactionService,
+	validate *xvalidator.Validator,
+	defaultTTL, maxTTL time.Duration,
+) HoldService {
+	return &HoldServiceImpl{
+		db:                 db,
+		holdRepository:     repo,
+		walletRepository:   walletRepository,
+		memberRepository:   memberRepository,
+		transactionService: transactionService,
+		validate:           validate,
+		defaultTTL:         defaultTTL,
+		maxTTL:             maxTTL,
+	}
+}
+
+// ErrInsufficientBalance is the cause of the exceptions raised when a wallet cannot cover a debit.
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+func insufficientBalance(message string) *exception.Exception {
+	return &exception.Exception{
+		Code:    exception.PermissionDeniedCode,
+		Message: message,
+		Error:   ErrInsufficientBalance,
+	}
+}
+
+// availableBalance is the ledger balance of a wallet minus what its active holds reserve,
+// the holds of excludeIds left aside.
+func availableBalance(
+	ctx context.Context, tx *gorm.DB, holdRepository repository.HoldRepository, wallet *entity.Wallet, excludeIds ...string,
+) (money.Money, *exception.Exception) {
+	held, err := holdRepository.SumActiveTx(ctx, tx, wallet.Id, time.Now(), excludeIds...)
+	if err != nil {
+		return money.Money{}, exception.Internal("failed getting wallet holds", err)
+	}
+	available := wallet.Balance.Normalize()
+	available.Units -= held
+	return available, nil
+}
+
+func (s *HoldServiceImpl) Authorize(
+	ctx context.Context, req *model.AuthorizeHoldReq,
+) (*model.AuthorizeHoldRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, exception.InvalidArgument("amount must be greater than zero")
+	}
+	now := time.Now()
+	expiresAt := now.Add(s.defaultTTL)
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+	if !expiresAt.After(now) || expiresAt.Sub(now) > s.maxTTL {
+		return nil, exception.InvalidArgument("expires_at must be in the future and at most " + s.maxTTL.String() + " away")
+	}
+	wallet, err := s.walletRepository.FindByIDForUpdate(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, wallet.Id, req.UserId, entity.WalletRoleSpender); errException != nil {
+		return nil, errException
+	}
+	if errException := checkDebit(wallet); errException != nil {
+		return nil, errException
+	}
+	req.Amount, err = req.Amount.WithCurrency(wallet.CurrencyCode())
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	available, errException := availableBalance(ctx, tx, s.holdRepository, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+	if available.LessThan(req.Amount) {
+		return nil, insufficientBalance(wallet.Name + " does not have enough available balance. Available: " + converter.ToString(available))
+	}
+
+	body := req.ToEntity(expiresAt)
+	if err := s.holdRepository.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("failed creating hold", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.AuthorizeHoldRes{
+		Hold: *body,
+	}, nil
+}
+
+// lockActive locks a hold and checks it can still be settled.
+func (s *HoldServiceImpl) lockActive(ctx context.Context, tx *gorm.DB, id string) (*entity.Hold, *exception.Exception) {
+	hold, err := s.holdRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting hold detail", err)
+	}
+	if hold == nil {
+		return nil, exception.NotFound("hold not found")
+	}
+	if hold.Status == entity.HoldStatusAuthorized && !hold.IsActive(time.Now()) {
+		return nil, exception.PermissionDenied("hold has expired")
+	}
+	if hold.Status != entity.HoldStatusAuthorized {
+		return nil, exception.PermissionDenied("hold is already " + hold.Status)
+	}
+	return hold, nil
+}
+
+// Capture books what is captured through the transaction service, which locks the
+// wallet and checks the caller may spend from it.
+func (s *HoldServiceImpl) Capture(
+	ctx context.Context, req *model.CaptureHoldReq,
+) (*model.CaptureHoldRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	hold, errException := s.lockActive(ctx, tx, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	amount := hold.Amount.Normalize()
+	if req.Amount != nil {
+		var err error
+		amount, err = req.Amount.WithCurrency(amount.Currency)
+		if err != nil {
+			return nil, exception.InvalidArgument(err.Error())
+		}
+		if !amount.IsPositive() {
+			return nil, exception.InvalidArgument("amount must be greater than zero")
+		}
+		if hold.Amount.LessThan(amount) {
+			return nil, exception.PermissionDenied("cannot capture more than the " + converter.ToString(hold.Amount) + " held")
+		}
+	}
+	captured, errException := s.transactionService.CaptureTx(ctx, tx, hold, req.UserId, amount)
+	if errException != nil {
+		return nil, errException
+	}
+
+	hold.Status = entity.HoldStatusCaptured
+	hold.CapturedAmount = amount
+	hold.TransactionId = &captured.Id
+	if err := s.holdRepository.UpdateTx(ctx, tx, hold); err != nil {
+		return nil, exception.Internal("failed updating hold", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CaptureHoldRes{
+		Hold:           *hold,
+		Transaction:    captured.Transaction,
+		FeeTransaction: captured.FeeTransaction,
+		Rewards:        captured.Rewards,
+	}, nil
+}
+
+func (s *HoldServiceImpl) Void(ctx context.Context, req *model.VoidHoldReq) (*model.VoidHoldRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	hold, errException := s.lockActive(ctx, tx, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, hold.WalletId, req.UserId, entity.WalletRoleSpender); errException != nil {
+		return nil, errException
+	}
+	hold.Status = entity.HoldStatusVoided
+	if err := s.holdRepository.UpdateTx(ctx, tx, hold); err != nil {
+		return nil, exception.Internal("failed updating hold", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.VoidHoldRes{
+		Hold: *hold,
+	}, nil
+}
+
+func (s *HoldServiceImpl) Find(ctx context.Context, req *model.GetAllHoldReq) (
+	*model.GetAllHoldRes, *exception.Exception,
+) {
+	members, errException := memberWalletFilter(ctx, s.db, s.memberRepository, "wallet_id", req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	if members == nil {
+		return &model.GetAllHoldRes{
+			PaginationData: model.NewEmptyPaginationData[entity.Hold](req.Page),
+		}, nil
+	}
+	result, err := s.holdRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, append(req.Filter, members))
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllHoldRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *HoldServiceImpl) Detail(ctx context.Context, req *model.GetHoldByIDReq) (
+	*model.GetHoldByIDRes, *exception.Exception,
+) {
+	result, err := s.holdRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil {
+		return nil, exception.NotFound("hold not found")
+	}
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, result.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+
+	return &model.GetHoldByIDRes{
+		Hold: *result,
+	}, nil
+}
+
+func (s *HoldServiceImpl) Expire(ctx context.Context) (int64, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	expired, err := s.holdRepository.ExpireTx(ctx, tx, time.Now())
+	if err != nil {
+		return 0, exception.Internal("failed expiring holds", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, exception.Internal("commit transaction", err)
+	}
+	return expired, nil
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type IdempotencyService interface {
+	// Begin reserves a key or returns the completed one to replay
+	Begin(ctx context.Context, req *model.BeginIdempotencyReq) (*model.BeginIdempotencyRes, *exception.Exception)
+	// Complete stores the response sent for a reserved key
+	Complete(ctx context.Context, req *model.CompleteIdempotencyReq) *exception.Exception
+	// Release frees a reserved key so the request can be retried
+	Release(ctx context.Context, req *model.ReleaseIdempotencyReq) *exception.Exception
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+type IdempotencyServiceImpl struct {
+	db          *gorm.DB
+	repo        repository.IdempotencyKeyRepository
+	validate    *xvalidator.Validator
+	lockTimeout time.Duration
+}
+
+func NewIdempotencyService(
+	db *gorm.DB, repo repository.IdempotencyKeyRepository,
+	validate *xvalidator.Validator, lockTimeout time.Duration,
+) IdempotencyService {
+	return &IdempotencyServiceImpl{
+		db:          db,
+		repo:        repo,
+		validate:    validate,
+		lockTimeout: lockTimeout,
+	}
+}
+
+func (s *IdempotencyServiceImpl) Begin(
+	ctx context.Context, req *model.BeginIdempotencyReq,
+) (*model.BeginIdempotencyRes, *exception.Exception) {
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	existing, err := s.repo.FindByKey(ctx, s.db, req.UserId, req.Key)
+	if err != nil {
+		return nil, exception.Internal("error finding idempotency key", err)
+	}
+	if existing == nil {
+		body := req.ToEntity()
+		tx := s.db.Begin()
+		defer tx.Rollback()
+		err := s.repo.InsertTx(ctx, tx, body)
+		if err == nil {
+			err = tx.Commit().Error
+		}
+		if err == nil {
+			return &model.BeginIdempotencyRes{
+				IdempotencyKey: *body,
+			}, nil
+		}
+		// a concurrent request reserved the same key first
+		existing, err = s.repo.FindByKey(ctx, s.db, req.UserId, req.Key)
+		if err != nil {
+			return nil, exception.Internal("error finding idempotency key", err)
+		}
+		if existing == nil {
+			return nil, exception.Internal("failed reserving idempotency key", nil)
+		}
+	}
+	return s.replay(ctx, existing, req)
+}
+
+func (s *IdempotencyServiceImpl) replay(
+	ctx context.Context, existing *entity.IdempotencyKey, req *model.BeginIdempotencyReq,
+) (*model.BeginIdempotencyRes, *exception.Exception) {
+	if existing.Fingerprint != req.Fingerprint() {
+		return nil, exception.Conflict("Idempotency-Key was already used for a different request")
+	}
+	now := time.Now()
+	if existing.IsAbandoned(now.Add(-s.lockTimeout)) {
+		// the request holding the key died without releasing it, this retry runs in its place
+		taken, err := s.repo.TakeOverTx(ctx, s.db, existing.Id, now.Add(-s.lockTimeout), now)
+		if err != nil {
+			return nil, exception.Internal("failed reserving idempotency key", err)
+		}
+		if taken {
+			existing.ReservedAt = now
+			return &model.BeginIdempotencyRes{
+				IdempotencyKey: *existing,
+			}, nil
+		}
+		if existing, err = s.repo.FindByKey(ctx, s.db, req.UserId, req.Key); err != nil {
+			return nil, exception.Internal("error finding idempotency key", err)
+		}
+		if existing == nil {
+			return nil, exception.Conflict("a request with this Idempotency-Key was released, retry it")
+		}
+	}
+	if !existing.IsCompleted() {
+		return nil, exception.Conflict("a request with this Idempotency-Key is still being processed")
+	}
+	return &model.BeginIdempotencyRes{
+		IdempotencyKey: *existing,
+		Replay:         true,
+	}, nil
+}
+
+func (s *IdempotencyServiceImpl) Complete(ctx context.Context, req *model.CompleteIdempotencyReq) *exception.Exception {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	body, err := s.repo.FindByID(ctx, tx, req.ID)
+	if err != nil {
+		return exception.Internal("error finding idempotency key", err)
+	}
+	if body == nil {
+		return exception.NotFound("idempotency key not found")
+	}
+	now := time.Now()
+	body.StatusCode = req.StatusCode
+	body.Response = string(req.Response)
+	body.CompletedAt = &now
+	if err := s.repo.UpdateTx(ctx, tx, body); err != nil {
+		return exception.Internal("failed storing idempotent response", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return exception.Internal("commit transaction", err)
+	}
+	return nil
+}
+
+func (s *IdempotencyServiceImpl) Release(ctx context.Context, req *model.ReleaseIdempotencyReq) *exception.Exception {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if err := s.repo.DeleteByIDTx(ctx, tx, req.ID); err != nil {
+		return exception.Internal("failed releasing idempotency key", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return exception.Internal("commit transaction", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyBegin(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	service := NewIdempotencyService(env.db, repository.NewIdempotencyKeySQLRepository(), env.validate, time.Minute)
+	user, _ := env.user(t, 0)
+	begin := func(key, body string) (*model.BeginIdempotencyRes, bool) {
+		res, errException := service.Begin(ctx, &model.BeginIdempotencyReq{
+			UserId: user.Id, Key: key, Method: "POST", Path: "/api/v1/transaction", Body: []byte(body),
+		})
+		return res, errException != nil
+	}
+
+	key := uuid.NewString()
+	reserved, failed := begin(key, `{"amount":1}`)
+	if failed || reserved.Replay {
+		t.Fatalf("first request: want a fresh reservation, got %+v", reserved)
+	}
+	if _, failed := begin(key, `{"amount":1}`); !failed {
+		t.Fatal("retry while in flight: want a conflict")
+	}
+	if _, failed := begin(key, `{"amount":2}`); !failed {
+		t.Fatal("different body: want a conflict")
+	}
+
+	// the request holding the key never came back
+	if err := env.db.Model(&entity.IdempotencyKey{}).Where("id = ?", reserved.Id).
+		Update("reserved_at", time.Now().Add(-time.Hour)).Error; err != nil {
+		t.Fatal(err)
+	}
+	taken, failed := begin(key, `{"amount":1}`)
+	if failed || taken.Replay || taken.Id != reserved.Id {
+		t.Fatalf("retry of an abandoned key: want it taken over, got %+v", taken)
+	}
+	if _, failed := begin(key, `{"amount":1}`); !failed {
+		t.Fatal("retry after a takeover: want a conflict while the new holder runs")
+	}
+
+	if errException := service.Complete(ctx, &model.CompleteIdempotencyReq{
+		ID: reserved.Id, StatusCode: 201, Response: []byte(`{"ok":true}`),
+	}); errException != nil {
+		t.Fatal(errException.Message)
+	}
+	replayed, failed := begin(key, `{"amount":1}`)
+	if failed || !replayed.Replay || replayed.StatusCode != 201 {
+		t.Fatalf("retry after completion: want the stored response, got %+v", replayed)
+	}
+
+	released := uuid.NewString()
+	first, _ := begin(released, `{}`)
+	if errException := service.Release(ctx, &model.ReleaseIdempotencyReq{ID: first.Id}); errException != nil {
+		t.Fatal(errException.Message)
+	}
+	if again, failed := begin(released, `{}`); failed || again.Replay {
+		t.Fatalf("retry after release: want a fresh reservation, got %+v", again)
+	}
+}
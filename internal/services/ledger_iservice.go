@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+)
+
+type LedgerService interface {
+	// Posting operations, they run inside the caller's database transaction
+	WalletAccount(ctx context.Context, tx *gorm.DB, wallet *entity.Wallet) (*entity.LedgerAccount, *exception.Exception)
+	SystemAccount(ctx context.Context, tx *gorm.DB, code, currency string) (*entity.LedgerAccount, *exception.Exception)
+	Exchange(ctx context.Context, tx *gorm.DB, entry *entity.JournalEntry, from, to money.Money) *exception.Exception
+	Post(ctx context.Context, tx *gorm.DB, entry *entity.JournalEntry) *exception.Exception
+	EntryOfTransaction(ctx context.Context, tx *gorm.DB, transactionId string) (*entity.JournalEntry, *exception.Exception)
+
+	// Read operations for auditing
+	FindAccounts(ctx context.Context, req *model.GetAllLedgerAccountReq) (
+		*model.GetAllLedgerAccountRes, *exception.Exception,
+	)
+	FindEntries(ctx context.Context, req *model.GetAllJournalEntryReq) (
+		*model.GetAllJournalEntryRes, *exception.Exception,
+	)
+	DetailEntry(ctx context.Context, req *model.GetJournalEntryByIDReq) (
+		*model.GetJournalEntryByIDRes, *exception.Exception,
+	)
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+	"sort"
+	"strings"
+)
+
+var ledgerAccountNames = map[string]string{
+	entity.SystemFundingAccountCode: "Funding source",
+	entity.SystemOpeningAccountCode: "Opening balances",
+	entity.MerchantSalesAccountCode: "Merchant sales",
+	entity.SystemFXAccountCode:      "FX position",
+	entity.SystemGatewayAccountCode: "Payment gateway clearing",
+	entity.SystemPayoutAccountCode:  "Payouts in flight",
+	entity.SystemRewardsAccountCode: "Promotional rewards",
+}
+
+type LedgerServiceImpl struct {
+	db                *gorm.DB
+	accountRepository repository.LedgerAccountRepository
+	journalRepository repository.JournalEntryRepository
+	walletRepository  repository.WalletRepository
+	validate          *xvalidator.Validator
+}
+
+func NewLedgerService(
+	db *gorm.DB,
+	accountRepository repository.LedgerAccountRepository,
+	journalRepository repository.JournalEntryRepository,
+	walletRepository repository.WalletRepository,
+	validate *xvalidator.Validator,
+) LedgerService {
+	return &LedgerServiceImpl{
+		db:                db,
+		accountRepository: accountRepository,
+		journalRepository: journalRepository,
+		walletRepository:  walletRepository,
+		validate:          validate,
+	}
+}
+
+// WalletAccount returns the ledger account of a wallet, opening it on first use.
+// Wallets funded before the ledger existed get their current balance booked as an
+// opening entry so the projection keeps matching.
+func (s *LedgerServiceImpl) WalletAccount(
+	ctx context.Context, tx *gorm.DB, wallet *entity.Wallet,
+) (*entity.LedgerAccount, *exception.Exception) {
+	code := entity.WalletAccountCode(wallet.Id)
+	account, err := s.accountRepository.FindByCode(ctx, tx, code)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet ledger account", err)
+	}
+	if account != nil {
+		return account, nil
+	}
+	account = &entity.LedgerAccount{
+		Id:       uuid.NewString(),
+		Code:     code,
+		Name:     wallet.Name,
+		Type:     entity.LedgerAccountTypeWallet,
+		WalletId: &wallet.Id,
+		Balance:  money.Zero(wallet.CurrencyCode()),
+	}
+	if err := s.accountRepository.CreateTx(ctx, tx, account); err != nil {
+		return nil, exception.Internal("failed creating wallet ledger account", err)
+	}
+	if wallet.Balance.IsPositive() {
+		opening, errException := s.SystemAccount(ctx, tx, entity.SystemOpeningAccountCode, wallet.CurrencyCode())
+		if errException != nil {
+			return nil, errException
+		}
+		entry := entity.NewJournalEntry("Opening balance of "+wallet.Name).
+			Debit(opening.Id, wallet.Balance, nil).
+			Credit(account.Id, wallet.Balance, nil)
+		if errException := s.Post(ctx, tx, entry); errException != nil {
+			return nil, errException
+		}
+		account.Balance = wallet.Balance
+	}
+	return account, nil
+}
+
+func (s *LedgerServiceImpl) SystemAccount(
+	ctx context.Context, tx *gorm.DB, code, currency string,
+) (*entity.LedgerAccount, *exception.Exception) {
+	name, ok := ledgerAccountNames[code]
+	if !ok {
+		return nil, exception.Internal("unknown ledger account", errors.New(code))
+	}
+	currency = money.Zero(currency).Currency
+	account, err := s.accountRepository.FindByCode(ctx, tx, entity.SystemAccountCode(code, currency))
+	if err != nil {
+		return nil, exception.Internal("failed getting ledger account", err)
+	}
+	if account != nil {
+		return account, nil
+	}
+	account = &entity.LedgerAccount{
+		Id:      uuid.NewString(),
+		Code:    entity.SystemAccountCode(code, currency),
+		Name:    name + " " + currency,
+		Type:    strings.SplitN(code, ":", 2)[0],
+		Balance: money.Zero(currency),
+	}
+	if err := s.accountRepository.CreateTx(ctx, tx, account); err != nil {
+		return nil, exception.Internal("failed creating ledger account", err)
+	}
+	return account, nil
+}
+
+// Exchange books a currency conversion on entry: from leaves through the FX position
+// of its currency and to enters through the FX position of the other one, so the
+// entry stays balanced currency by currency.
+func (s *LedgerServiceImpl) Exchange(
+	ctx context.Context, tx *gorm.DB, entry *entity.JournalEntry, from, to money.Money,
+) *exception.Exception {
+	if from.SameCurrency(to) {
+		return nil
+	}
+	fromAccount, errException := s.SystemAccount(ctx, tx, entity.SystemFXAccountCode, from.Normalize().Currency)
+	if errException != nil {
+		return errException
+	}
+	toAccount, errException := s.SystemAccount(ctx, tx, entity.SystemFXAccountCode, to.Normalize().Currency)
+	if errException != nil {
+		return errException
+	}
+	entry.Credit(fromAccount.Id, from, nil).
+		Debit(toAccount.Id, to, nil)
+	return nil
+}
+
+// Post books a balanced journal entry and refreshes the balance of every wallet it touches.
+func (s *LedgerServiceImpl) Post(ctx context.Context, tx *gorm.DB, entry *entity.JournalEntry) *exception.Exception {
+	if !entry.IsBalanced() {
+		return exception.Internal("journal entry is not balanced", errors.New(entry.Description))
+	}
+	if err := s.journalRepository.CreateWithLinesTx(ctx, tx, entry); err != nil {
+		return exception.Internal("failed creating journal entry", err)
+	}
+	// accounts are updated in id order, the same order every posting locks them in
+	lines := make([]entity.JournalLine, len(entry.Lines))
+	copy(lines, entry.Lines)
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].AccountId < lines[j].AccountId
+	})
+	for _, line := range lines {
+		if err := s.accountRepository.ApplyTx(ctx, tx, line.AccountId, line.Debit, line.Credit); err != nil {
+			return exception.Internal("failed posting journal line", err)
+		}
+		account, err := s.accountRepository.FindByID(ctx, tx, line.AccountId)
+		if err != nil {
+			return exception.Internal("failed getting ledger account", err)
+		}
+		if account == nil {
+			return exception.NotFound("ledger account not found")
+		}
+		if account.WalletId == nil {
+			continue
+		}
+		if err := s.walletRepository.UpdateBalanceTx(ctx, tx, *account.WalletId, account.Balance); err != nil {
+			return exception.Internal("failed updating wallet", err)
+		}
+	}
+	return nil
+}
+
+// EntryOfTransaction returns the entry a wallet transaction was booked with.
+func (s *LedgerServiceImpl) EntryOfTransaction(
+	ctx context.Context, tx *gorm.DB, transactionId string,
+) (*entity.JournalEntry, *exception.Exception) {
+	entry, err := s.journalRepository.FindByTransactionId(ctx, tx, transactionId)
+	if err != nil {
+		return nil, exception.Internal("failed getting journal entry", err)
+	}
+	if entry == nil {
+		return nil, exception.PermissionDenied("transaction was booked before the ledger and cannot be reversed")
+	}
+	return entry, nil
+}
+
+func (s *LedgerServiceImpl) FindAccounts(ctx context.Context, req *model.GetAllLedgerAccountReq) (
+	*model.GetAllLedgerAccountRes, *exception.Exception,
+) {
+	result, err := s.accountRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, req.Filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllLedgerAccountRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *LedgerServiceImpl) FindEntries(ctx context.Context, req *model.GetAllJournalEntryReq) (
+	*model.GetAllJournalEntryRes, *exception.Exception,
+) {
+	result, err := s.journalRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, req.Filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllJournalEntryRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *LedgerServiceImpl) DetailEntry(ctx context.Context, req *model.GetJournalEntryByIDReq) (
+	*model.GetJournalEntryByIDRes, *exception.Exception,
+) {
+	result, err := s.journalRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil {
+		return nil, exception.NotFound("journal entry not found")
+	}
+
+	return &model.GetJournalEntryByIDRes{
+		JournalEntry: *result,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type PaymentRequestService interface {
+	// A requester asks a payer for money, the payer pays or declines and the requester may cancel
+	Create(ctx context.Context, req *model.CreatePaymentRequestReq) (*model.CreatePaymentRequestRes, *exception.Exception)
+	Pay(ctx context.Context, req *model.PayPaymentRequestReq) (*model.PayPaymentRequestRes, *exception.Exception)
+	Decline(ctx context.Context, req *model.DeclinePaymentRequestReq) (*model.DeclinePaymentRequestRes, *exception.Exception)
+	Cancel(ctx context.Context, req *model.CancelPaymentRequestReq) (*model.CancelPaymentRequestRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllPaymentRequestReq) (*model.GetAllPaymentRequestRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetPaymentRequestByIDReq) (*model.GetPaymentRequestByIDRes, *exception.Exception)
+	FindStatusChanges(ctx context.Context, req *model.GetAllPaymentRequestStatusChangeReq) (
+		*model.GetAllPaymentRequestStatusChangeRes, *exception.Exception,
+	)
+
+	// CreateTx runs inside the caller's database transaction
+	CreateTx(ctx context.Context, tx *gorm.DB, req *model.CreatePaymentRequestReq) (*entity.PaymentRequest, *exception.Exception)
+
+	// Expire closes the pending requests past their expiry and returns how many were closed
+	Expire(ctx context.Context) (int64, *exception.Exception)
+}
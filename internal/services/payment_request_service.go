@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+// paymentRequestExpiryBatch is how many requests Expire closes per database transaction.
+const paymentRequestExpiryBatch = 100
+
+type PaymentRequestServiceImpl struct {
+	db                       *gorm.DB
+	paymentRequestRepository repository.PaymentRequestRepository
+	statusChangeRepository   repository.PaymentRequestStatusChangeRepository
+	userRepository           repository.UserRepository
+	walletRepository         repository.WalletRepository
+	memberRepository         repository.WalletMemberRepository
+	transactionService       TransactionService
+	validate                 *xvalidator.Validator
+	defaultTTL               time.Duration
+	maxTTL                   time.Duration
+}
+
+func NewPaymentRequestService(
+	db *gorm.DB,
+	repo repository.PaymentRequestRepository,
+	statusChangeRepository repository.PaymentRequestStatusChangeRepository,
+	userRepository repository.UserRepository,
+	walletRepository repository.WalletRepository,
+	memberRepository repository.WalletMemberRepository,
+	transactionService TransactionService,
+	validate *xvalidator.Validator,
+	defaultTTL, maxTTL time.Duration,
+) PaymentRequestService {
+	return &PaymentRequestServiceImpl{
+		db:                       db,
+		paymentRequestRepository: repo,
+		statusChangeRepository:   statusChangeRepository,
+		userRepository:           userRepository,
+		walletRepository:         walletRepository,
+		memberRepository:         memberRepository,
+		transactionService:       transactionService,
+		validate:                 validate,
+		defaultTTL:               defaultTTL,
+		maxTTL:                   maxTTL,
+	}
+}
+
+func (s *PaymentRequestServiceImpl) Create(
+	ctx context.Context, req *model.CreatePaymentRequestReq,
+) (*model.CreatePaymentRequestRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	body, errException := s.CreateTx(ctx, tx, req)
+	if errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CreatePaymentRequestRes{
+		PaymentRequest: *body,
+	}, nil
+}
+
+// CreateTx is Create within tx, committing is left to the caller.
+func (s *PaymentRequestServiceImpl) CreateTx(
+	ctx context.Context, tx *gorm.DB, req *model.CreatePaymentRequestReq,
+) (*entity.PaymentRequest, *exception.Exception) {
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, exception.InvalidArgument("amount must be greater than zero")
+	}
+	now := time.Now()
+	expiresAt := now.Add(s.defaultTTL)
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+	if !expiresAt.After(now) || expiresAt.Sub(now) > s.maxTTL {
+		return nil, exception.InvalidArgument("expires_at must be in the future and at most " + s.maxTTL.String() + " away")
+	}
+	requester, err := s.userRepository.FindByID(ctx, tx, req.UserId)
+	if err != nil {
+		return nil, exception.Internal("error finding user", err)
+	}
+	if requester == nil {
+		return nil, exception.NotFound("user not found")
+	}
+	payer, err := s.userRepository.FindByFilter(ctx, tx, model.FilterParams{
+		{
+			Field:    "username",
+			Value:    req.PayerUsername,
+			Operator: "=",
+		},
+	}, model.OrderParam{
+		Order:   "asc",
+		OrderBy: "username",
+	})
+	if err != nil {
+		return nil, exception.Internal("error finding user", err)
+	}
+	if payer == nil {
+		return nil, exception.NotFound("user " + req.PayerUsername + " not found")
+	}
+	if payer.Id == requester.Id {
+		return nil, exception.InvalidArgument("cannot request money from yourself")
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleSpender); errException != nil {
+		return nil, errException
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	if errException := checkCredit(wallet); errException != nil {
+		return nil, errException
+	}
+	req.Amount, err = req.Amount.WithCurrency(wallet.CurrencyCode())
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+
+	body := req.ToEntity(*requester, *payer, expiresAt)
+	if err := s.paymentRequestRepository.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("failed creating payment request", err)
+	}
+	return body, nil
+}
+
+// lockPending locks a request userId is a party to and checks it is still pending.
+func (s *PaymentRequestServiceImpl) lockPending(
+	ctx context.Context, tx *gorm.DB, id, userId string,
+) (*entity.PaymentRequest, *exception.Exception) {
+	request, err := s.paymentRequestRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting payment request detail", err)
+	}
+	if request == nil || !isPaymentRequestParty(request, userId) {
+		return nil, exception.NotFound("payment request not found")
+	}
+	if request.Status == entity.PaymentRequestStatusPending && !request.IsPayable(time.Now()) {
+		return nil, exception.PermissionDenied("payment request has expired")
+	}
+	if request.Status != entity.PaymentRequestStatusPending {
+		return nil, exception.PermissionDenied("payment request is already " + request.Status)
+	}
+	return request, nil
+}
+
+// isPaymentRequestParty reports whether userId made the request or is asked to pay it.
+func isPaymentRequestParty(request *entity.PaymentRequest, userId string) bool {
+	return request.RequesterId == userId || request.PayerId == userId
+}
+
+// changeStatus writes the new status of a locked request along with its audit record.
+func (s *PaymentRequestServiceImpl) changeStatus(
+	ctx context.Context, tx *gorm.DB, request *entity.PaymentRequest, status, reason string, changedBy *string,
+) *exception.Exception {
+	change := model.NewPaymentRequestStatusChange(*request, status, reason, changedBy)
+	request.Status = status
+	if err := s.paymentRequestRepository.UpdateTx(ctx, tx, request); err != nil {
+		return exception.Internal("failed updating payment request", err)
+	}
+	if err := s.statusChangeRepository.CreateTx(ctx, tx, change); err != nil {
+		return exception.Internal("failed recording payment request status change", err)
+	}
+	return nil
+}
+
+// Pay transfers the requested amount out of the wallet the payer chose, the
+// transfer and the request settle together or not at all.
+func (s *PaymentRequestServiceImpl) Pay(
+	ctx context.Context, req *model.PayPaymentRequestReq,
+) (*model.PayPaymentRequestRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	request, errException := s.lockPending(ctx, tx, req.ID, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	if request.PayerId != req.UserId {
+		return nil, exception.PermissionDenied("only the payer can pay a payment request")
+	}
+	transfer, errException := s.transactionService.TransferTx(ctx, tx, req.ToTransferReq(*request))
+	if errException != nil {
+		return nil, errException
+	}
+	request.PaidFromWalletId = &req.WalletId
+	request.SenderTransactionId = &transfer.SenderTransaction.Id
+	request.ReceiverTransactionId = &transfer.ReceiverTransaction.Id
+	if errException := s.changeStatus(ctx, tx, request, entity.PaymentRequestStatusPaid, "", &req.UserId); errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.PayPaymentRequestRes{
+		Request:  *request,
+		Transfer: *transfer,
+	}, nil
+}
+
+func (s *PaymentRequestServiceImpl) Decline(
+	ctx context.Context, req *model.DeclinePaymentRequestReq,
+) (*model.DeclinePaymentRequestRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	request, errException := s.lockPending(ctx, tx, req.ID, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	if request.PayerId != req.UserId {
+		return nil, exception.PermissionDenied("only the payer can decline a payment request")
+	}
+	if errException := s.changeStatus(ctx, tx, request, entity.PaymentRequestStatusDeclined, req.Reason, &req.UserId); errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.DeclinePaymentRequestRes{
+		PaymentRequest: *request,
+	}, nil
+}
+
+func (s *PaymentRequestServiceImpl) Cancel(
+	ctx context.Context, req *model.CancelPaymentRequestReq,
+) (*model.CancelPaymentRequestRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	request, errException := s.lockPending(ctx, tx, req.ID, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	if request.RequesterId != req.UserId {
+		return nil, exception.PermissionDenied("only the requester can cancel a payment request")
+	}
+	if errException := s.changeStatus(ctx, tx, request, entity.PaymentRequestStatusCancelled, req.Reason, &req.UserId); errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CancelPaymentRequestRes{
+		PaymentRequest: *request,
+	}, nil
+}
+
+func (s *PaymentRequestServiceImpl) Find(ctx context.Context, req *model.GetAllPaymentRequestReq) (
+	*model.GetAllPaymentRequestRes, *exception.Exception,
+) {
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	field := "payer_id"
+	if req.Direction == model.PaymentRequestOutgoing {
+		field = "requester_id"
+	}
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    field,
+		Value:    req.UserId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.paymentRequestRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllPaymentRequestRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *PaymentRequestServiceImpl) Detail(ctx context.Context, req *model.GetPaymentRequestByIDReq) (
+	*model.GetPaymentRequestByIDRes, *exception.Exception,
+) {
+	result, err := s.paymentRequestRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil || !isPaymentRequestParty(result, req.UserId) {
+		return nil, exception.NotFound("payment request not found")
+	}
+
+	return &model.GetPaymentRequestByIDRes{
+		PaymentRequest: *result,
+	}, nil
+}
+
+func (s *PaymentRequestServiceImpl) FindStatusChanges(ctx context.Context, req *model.GetAllPaymentRequestStatusChangeReq) (
+	*model.GetAllPaymentRequestStatusChangeRes, *exception.Exception,
+) {
+	if _, errException := s.Detail(ctx, &model.GetPaymentRequestByIDReq{ID: req.RequestId, UserId: req.UserId}); errException != nil {
+		return nil, errException
+	}
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "request_id",
+		Value:    req.RequestId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.statusChangeRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllPaymentRequestStatusChangeRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *PaymentRequestServiceImpl) Expire(ctx context.Context) (int64, *exception.Exception) {
+	var expired int64
+	for {
+		count, errException := s.expireBatch(ctx)
+		expired += count
+		if errException != nil || count < paymentRequestExpiryBatch {
+			return expired, errException
+		}
+	}
+}
+
+// expireBatch expires one batch of requests, recording each change without a user.
+func (s *PaymentRequestServiceImpl) expireBatch(ctx context.Context) (int64, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	requests, err := s.paymentRequestRepository.FindExpiredForUpdate(ctx, tx, time.Now(), paymentRequestExpiryBatch)
+	if err != nil {
+		return 0, exception.Internal("failed getting expired payment requests", err)
+	}
+	for i := range *requests {
+		if errException := s.changeStatus(ctx, tx, &(*requests)[i], entity.PaymentRequestStatusExpired, "", nil); errException != nil {
+			return 0, errException
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, exception.Internal("commit transaction", err)
+	}
+	return int64(len(*requests)), nil
+}
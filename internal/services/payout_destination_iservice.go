@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type PayoutDestinationService interface {
+	// Bank accounts a user withdraws to, verified with the payout provider
+	Create(
+		ctx context.Context, req *model.CreatePayoutDestinationReq,
+	) (*model.CreatePayoutDestinationRes, *exception.Exception)
+	Verify(
+		ctx context.Context, req *model.VerifyPayoutDestinationReq,
+	) (*model.VerifyPayoutDestinationRes, *exception.Exception)
+	Delete(
+		ctx context.Context, req *model.DeletePayoutDestinationReq,
+	) (*model.DeletePayoutDestinationRes, *exception.Exception)
+	Find(
+		ctx context.Context, req *model.GetAllPayoutDestinationReq,
+	) (*model.GetAllPayoutDestinationRes, *exception.Exception)
+	Detail(
+		ctx context.Context, req *model.GetPayoutDestinationByIDReq,
+	) (*model.GetPayoutDestinationByIDRes, *exception.Exception)
+}
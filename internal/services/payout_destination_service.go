@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/gateway/externalapi"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+type PayoutDestinationServiceImpl struct {
+	db                          *gorm.DB
+	payoutDestinationRepository repository.PayoutDestinationRepository
+	payoutProvider              externalapi.PayoutProvider
+	validate                    *xvalidator.Validator
+}
+
+func NewPayoutDestinationService(
+	db *gorm.DB,
+	repo repository.PayoutDestinationRepository,
+	payoutProvider externalapi.PayoutProvider,
+	validate *xvalidator.Validator,
+) PayoutDestinationService {
+	return &PayoutDestinationServiceImpl{
+		db:                          db,
+		payoutDestinationRepository: repo,
+		payoutProvider:              payoutProvider,
+		validate:                    validate,
+	}
+}
+
+// Create saves a destination and verifies it right away. When the provider cannot
+// be reached it is saved pending and can be verified again later.
+func (s *PayoutDestinationServiceImpl) Create(
+	ctx context.Context, req *model.CreatePayoutDestinationReq,
+) (*model.CreatePayoutDestinationRes, *exception.Exception) {
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	destination := req.ToEntity()
+	existing, err := s.payoutDestinationRepository.FindByFilter(ctx, s.db, model.FilterParams{
+		{
+			Field:    "user_id",
+			Value:    req.UserId,
+			Operator: "=",
+		},
+		{
+			Field:    "bank_code",
+			Value:    destination.BankCode,
+			Operator: "=",
+		},
+		{
+			Field:    "account_number",
+			Value:    destination.AccountNumber,
+			Operator: "=",
+		},
+	}, model.OrderParam{
+		Order:   "asc",
+		OrderBy: "created_at",
+	})
+	if err != nil {
+		return nil, exception.Internal("failed getting payout destinations", err)
+	}
+	if existing != nil {
+		return nil, exception.Conflict("the account is already saved as " + existing.Id)
+	}
+	if err := s.verify(ctx, destination); err != nil {
+		slog.Error("failed to verify payout destination", "destination_id", destination.Id, "error", err)
+	}
+
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if err := s.payoutDestinationRepository.CreateTx(ctx, tx, destination); err != nil {
+		return nil, exception.Internal("failed creating payout destination", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CreatePayoutDestinationRes{
+		PayoutDestination: *destination,
+	}, nil
+}
+
+// verify asks the provider about destination, leaving it pending when it cannot.
+func (s *PayoutDestinationServiceImpl) verify(ctx context.Context, destination *entity.PayoutDestination) error {
+	verification, err := s.payoutProvider.VerifyAccount(ctx, externalapi.BankAccount{
+		BankCode:      destination.BankCode,
+		AccountNumber: destination.AccountNumber,
+		HolderName:    destination.HolderName,
+	})
+	if err != nil {
+		return err
+	}
+	if !verification.Valid {
+		destination.Status = entity.PayoutDestinationStatusRejected
+		destination.RejectionReason = verification.Reason
+		return nil
+	}
+	now := time.Now()
+	destination.Status = entity.PayoutDestinationStatusVerified
+	destination.HolderName = verification.HolderName
+	destination.VerifiedAt = &now
+	return nil
+}
+
+func (s *PayoutDestinationServiceImpl) Verify(
+	ctx context.Context, req *model.VerifyPayoutDestinationReq,
+) (*model.VerifyPayoutDestinationRes, *exception.Exception) {
+	destination, errException := s.findOwn(ctx, s.db, req.ID, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	if destination.Status != entity.PayoutDestinationStatusPending {
+		return nil, exception.InvalidArgument("the destination is already " + destination.Status)
+	}
+	if err := s.verify(ctx, destination); err != nil {
+		return nil, exception.Internal("failed verifying payout destination", err)
+	}
+	if err := s.payoutDestinationRepository.UpdateTx(ctx, s.db, destination); err != nil {
+		return nil, exception.Internal("failed updating payout destination", err)
+	}
+	return &model.VerifyPayoutDestinationRes{
+		PayoutDestination: *destination,
+	}, nil
+}
+
+// Delete forgets a destination, the payouts sent to it keep a copy of the account.
+func (s *PayoutDestinationServiceImpl) Delete(
+	ctx context.Context, req *model.DeletePayoutDestinationReq,
+) (*model.DeletePayoutDestinationRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	destination, errException := s.findOwn(ctx, tx, req.ID, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	if err := s.payoutDestinationRepository.DeleteByIDTx(ctx, tx, destination.Id); err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.DeletePayoutDestinationRes{
+		ID: destination.Id,
+	}, nil
+}
+
+func (s *PayoutDestinationServiceImpl) Find(ctx context.Context, req *model.GetAllPayoutDestinationReq) (
+	*model.GetAllPayoutDestinationRes, *exception.Exception,
+) {
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "user_id",
+		Value:    req.UserId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.payoutDestinationRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllPayoutDestinationRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *PayoutDestinationServiceImpl) Detail(ctx context.Context, req *model.GetPayoutDestinationByIDReq) (
+	*model.GetPayoutDestinationByIDRes, *exception.Exception,
+) {
+	result, errException := s.findOwn(ctx, s.db, req.ID, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+
+	return &model.GetPayoutDestinationByIDRes{
+		PayoutDestination: *result,
+	}, nil
+}
+
+// findOwn returns a destination of userId, the ones of other users are not found.
+func (s *PayoutDestinationServiceImpl) findOwn(
+	ctx context.Context, tx *gorm.DB, id, userId string,
+) (*entity.PayoutDestination, *exception.Exception) {
+	destination, err := s.payoutDestinationRepository.FindByID(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting payout destination", err)
+	}
+	if destination == nil || destination.UserId != userId {
+		return nil, exception.NotFound("payout destination not found")
+	}
+	return destination, nil
+}
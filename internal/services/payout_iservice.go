@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type PayoutService interface {
+	// Withdrawals from a wallet to a verified payout destination
+	Create(ctx context.Context, req *model.CreatePayoutReq) (*model.CreatePayoutRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllPayoutReq) (*model.GetAllPayoutRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetPayoutByIDReq) (*model.GetPayoutByIDRes, *exception.Exception)
+
+	// Sync brings the payouts in flight up to date with the provider and returns how many changed
+	Sync(ctx context.Context) (int64, *exception.Exception)
+}
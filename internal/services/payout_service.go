@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/gateway/externalapi"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+const (
+	// payoutSyncBatch is how many payouts Sync reads at a time.
+	payoutSyncBatch = 100
+	// payoutSubmitGrace is how long Create has to hand a payout to the provider
+	// before Sync submits it instead.
+	payoutSubmitGrace = time.Minute
+)
+
+type PayoutServiceImpl struct {
+	db                          *gorm.DB
+	payoutRepository            repository.PayoutRepository
+	payoutDestinationRepository repository.PayoutDestinationRepository
+	walletRepository            repository.WalletRepository
+	transactionService          TransactionService
+	ledgerService               LedgerService
+	payoutProvider              externalapi.PayoutProvider
+	validate                    *xvalidator.Validator
+	returnWindow                time.Duration
+}
+
+func NewPayoutService(
+	db *gorm.DB,
+	repo repository.PayoutRepository,
+	payoutDestinationRepository repository.PayoutDestinationRepository,
+	walletRepository repository.WalletRepository,
+	transactionService TransactionService,
+	ledgerService LedgerService,
+	payoutProvider externalapi.PayoutProvider,
+	validate *xvalidator.Validator,
+	returnWindow time.Duration,
+) PayoutService {
+	return &PayoutServiceImpl{
+		db:                          db,
+		payoutRepository:            repo,
+		payoutDestinationRepository: payoutDestinationRepository,
+		walletRepository:            walletRepository,
+		transactionService:          transactionService,
+		ledgerService:               ledgerService,
+		payoutProvider:              payoutProvider,
+		validate:                    validate,
+		returnWindow:                returnWindow,
+	}
+}
+
+// Create debits the wallet and hands the payout to the provider. The debit is
+// committed first so the money cannot be spent twice while the provider is
+// called, a payout the provider refuses fails and its money is given back while
+// one it could not be handed stays pending for Sync.
+func (s *PayoutServiceImpl) Create(
+	ctx context.Context, req *model.CreatePayoutReq,
+) (*model.CreatePayoutRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, exception.InvalidArgument("amount must be greater than zero")
+	}
+	destination, err := s.payoutDestinationRepository.FindByID(ctx, tx, req.DestinationId)
+	if err != nil {
+		return nil, exception.Internal("failed getting payout destination", err)
+	}
+	if destination == nil || destination.UserId != req.UserId {
+		return nil, exception.NotFound("payout destination not found")
+	}
+	if destination.Status != entity.PayoutDestinationStatusVerified {
+		return nil, exception.PermissionDenied("payouts are only sent to verified destinations, this one is " + destination.Status)
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	req.Amount, err = req.Amount.WithCurrency(wallet.CurrencyCode())
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	req.Amount = req.Amount.Normalize()
+
+	payout := req.ToEntity(*destination, s.payoutProvider.Name())
+	transaction, errException := s.transactionService.PayoutTx(ctx, tx, payout)
+	if errException != nil {
+		return nil, errException
+	}
+	payout.TransactionId = &transaction.Id
+	if err := s.payoutRepository.CreateTx(ctx, tx, payout); err != nil {
+		return nil, exception.Internal("failed creating payout", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+
+	payout, errException = s.submit(ctx, payout)
+	if errException != nil {
+		return nil, errException
+	}
+	return &model.CreatePayoutRes{
+		Payout: *payout,
+	}, nil
+}
+
+// submit hands a pending payout to the provider under its id, the same on every
+// retry so the provider makes it once. The payout fails only when the provider
+// refuses it, when the provider cannot be reached it stays pending and Sync
+// submits it again.
+func (s *PayoutServiceImpl) submit(ctx context.Context, payout *entity.Payout) (*entity.Payout, *exception.Exception) {
+	submission, err := s.payoutProvider.SubmitPayout(ctx, externalapi.PayoutReq{
+		OrderId: payout.Id,
+		Amount:  payout.Amount,
+		Account: externalapi.BankAccount{
+			BankCode:      payout.BankCode,
+			AccountNumber: payout.AccountNumber,
+			HolderName:    payout.HolderName,
+		},
+		Description: "Withdrawal " + payout.Id,
+	})
+	if errors.Is(err, externalapi.ErrPayoutRejected) {
+		if _, errException := s.apply(ctx, payout.Id, &externalapi.PayoutResult{
+			Status: externalapi.PayoutStatusFailed,
+			Reason: err.Error(),
+		}); errException != nil {
+			return nil, errException
+		}
+		return nil, exception.PermissionDenied(err.Error())
+	}
+	if err != nil {
+		slog.Error("failed to submit payout, left pending", "payout_id", payout.Id, "error", err)
+		return payout, nil
+	}
+
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	payout, err = s.payoutRepository.FindByIDForUpdate(ctx, tx, payout.Id)
+	if err != nil {
+		return nil, exception.Internal("failed getting payout", err)
+	}
+	if payout == nil {
+		return nil, exception.NotFound("payout not found")
+	}
+	if payout.Status == entity.PayoutStatusPending {
+		now := time.Now()
+		payout.Status = entity.PayoutStatusSubmitted
+		payout.ProviderReference = &submission.Reference
+		payout.SubmittedAt = &now
+		if err := s.payoutRepository.UpdateTx(ctx, tx, payout); err != nil {
+			return nil, exception.Internal("failed updating payout", err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return payout, nil
+}
+
+// apply moves a payout to the status the provider reports. A paid payout leaves
+// the payouts in flight account, a failed or returned one gives the wallet its
+// money back. It reports whether the payout changed.
+func (s *PayoutServiceImpl) apply(
+	ctx context.Context, id string, result *externalapi.PayoutResult,
+) (bool, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	payout, err := s.payoutRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return false, exception.Internal("failed getting payout", err)
+	}
+	if payout == nil {
+		return false, exception.NotFound("payout not found")
+	}
+	now := time.Now()
+	inFlight := payout.Status == entity.PayoutStatusPending || payout.Status == entity.PayoutStatusSubmitted
+	switch {
+	case result.Status == externalapi.PayoutStatusPaid && inFlight:
+		if errException := s.settle(ctx, tx, payout, false); errException != nil {
+			return false, errException
+		}
+		payout.Status = entity.PayoutStatusPaid
+		payout.PaidAt = &now
+	case result.Status == externalapi.PayoutStatusFailed && inFlight,
+		result.Status == externalapi.PayoutStatusReturned && (inFlight || payout.Status == entity.PayoutStatusPaid):
+		if payout.Status == entity.PayoutStatusPaid {
+			if errException := s.settle(ctx, tx, payout, true); errException != nil {
+				return false, errException
+			}
+		}
+		payout.Status = entity.PayoutStatusFailed
+		if result.Status == externalapi.PayoutStatusReturned {
+			payout.Status = entity.PayoutStatusReturned
+		}
+		restore, errException := s.transactionService.RestorePayoutTx(ctx, tx, payout)
+		if errException != nil {
+			return false, errException
+		}
+		payout.RestoreTransactionId = &restore.Id
+		payout.FailureReason = result.Reason
+		payout.ClosedAt = &now
+	default:
+		return false, nil
+	}
+	if err := s.payoutRepository.UpdateTx(ctx, tx, payout); err != nil {
+		return false, exception.Internal("failed updating payout", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, exception.Internal("commit transaction", err)
+	}
+	return true, nil
+}
+
+// settle moves the money of a paid payout from the payouts in flight account out
+// to the funding source, or back when the bank returned it.
+func (s *PayoutServiceImpl) settle(
+	ctx context.Context, tx *gorm.DB, payout *entity.Payout, returned bool,
+) *exception.Exception {
+	payoutAccount, errException := s.ledgerService.SystemAccount(ctx, tx, entity.SystemPayoutAccountCode, payout.Amount.Currency)
+	if errException != nil {
+		return errException
+	}
+	fundingAccount, errException := s.ledgerService.SystemAccount(ctx, tx, entity.SystemFundingAccountCode, payout.Amount.Currency)
+	if errException != nil {
+		return errException
+	}
+	entry := entity.NewJournalEntry("Payout paid to "+payout.BankCode+" "+payout.AccountNumber).
+		Debit(payoutAccount.Id, payout.Amount, nil).
+		Credit(fundingAccount.Id, payout.Amount, nil)
+	if returned {
+		entry = entity.NewJournalEntry("Payout returned by "+payout.BankCode+" "+payout.AccountNumber).
+			Debit(fundingAccount.Id, payout.Amount, nil).
+			Credit(payoutAccount.Id, payout.Amount, nil)
+	}
+	return s.ledgerService.Post(ctx, tx, entry)
+}
+
+// Sync submits the payouts left pending and polls the provider for the ones
+// submitted or recently paid. A payout that cannot be brought up to date is
+// logged and tried again on the next run.
+func (s *PayoutServiceImpl) Sync(ctx context.Context) (int64, *exception.Exception) {
+	var changed int64
+	after := ""
+	now := time.Now()
+	for {
+		payouts, err := s.payoutRepository.FindToSync(ctx, s.db, after, now.Add(-payoutSubmitGrace), now.Add(-s.returnWindow), payoutSyncBatch)
+		if err != nil {
+			return changed, exception.Internal("failed getting payouts to sync", err)
+		}
+		for i := range *payouts {
+			payout := &(*payouts)[i]
+			after = payout.Id
+			if payout.Status == entity.PayoutStatusPending {
+				submitted, errException := s.submit(ctx, payout)
+				switch {
+				case errException == nil && submitted.Status != entity.PayoutStatusPending:
+					changed++
+				case errException != nil && errException.Code == exception.PermissionDeniedCode:
+					// refused by the provider, the payout failed
+					changed++
+				case errException != nil:
+					slog.Error("failed to submit payout", "payout_id", payout.Id, "error", errException.Error)
+				}
+				continue
+			}
+			result, err := s.payoutProvider.PayoutStatus(ctx, *payout.ProviderReference)
+			if err != nil {
+				slog.Error("failed to get payout status", "payout_id", payout.Id, "error", err)
+				continue
+			}
+			applied, errException := s.apply(ctx, payout.Id, result)
+			if errException != nil {
+				slog.Error("failed to update payout", "payout_id", payout.Id, "error", errException.Error)
+				continue
+			}
+			if applied {
+				changed++
+			}
+		}
+		if len(*payouts) < payoutSyncBatch {
+			return changed, nil
+		}
+	}
+}
+
+func (s *PayoutServiceImpl) Find(ctx context.Context, req *model.GetAllPayoutReq) (
+	*model.GetAllPayoutRes, *exception.Exception,
+) {
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "user_id",
+		Value:    req.UserId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.payoutRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllPayoutRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *PayoutServiceImpl) Detail(ctx context.Context, req *model.GetPayoutByIDReq) (
+	*model.GetPayoutByIDRes, *exception.Exception,
+) {
+	result, err := s.payoutRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil || result.UserId != req.UserId {
+		return nil, exception.NotFound("payout not found")
+	}
+
+	return &model.GetPayoutByIDRes{
+		Payout: *result,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/gateway/externalapi"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/money"
+	"testing"
+	"time"
+)
+
+// failingPayoutProvider answers every submission with err.
+type failingPayoutProvider struct {
+	externalapi.PayoutProvider
+	err error
+}
+
+func (p *failingPayoutProvider) Name() string {
+	return "failing"
+}
+
+func (p *failingPayoutProvider) SubmitPayout(ctx context.Context, req externalapi.PayoutReq) (*externalapi.PayoutSubmission, error) {
+	return nil, p.err
+}
+
+func TestPayoutSubmitFailure(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	destinations := repository.NewPayoutDestinationSQLRepository()
+	tests := []struct {
+		name        string
+		err         error
+		wantErr     bool
+		wantStatus  string
+		wantBalance int64
+	}{
+		{name: "unreachable", err: errors.New("connection reset by peer"), wantStatus: entity.PayoutStatusPending, wantBalance: 60000},
+		{name: "rejected", err: fmt.Errorf("%w: account closed", externalapi.ErrPayoutRejected), wantErr: true, wantStatus: entity.PayoutStatusFailed, wantBalance: 100000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, wallet := env.user(t, 100000)
+			now := time.Now()
+			destination := &entity.PayoutDestination{
+				Id: uuid.NewString(), UserId: owner.Id, Label: "salary", BankCode: "BCA", AccountNumber: "1234567890",
+				HolderName: "JOHN DOE", Status: entity.PayoutDestinationStatusVerified, VerifiedAt: &now,
+			}
+			if err := env.db.Create(destination).Error; err != nil {
+				t.Fatal(err)
+			}
+			payouts := repository.NewPayoutSQLRepository()
+			service := NewPayoutService(
+				env.db, payouts, destinations, env.walletRepository, env.transactionService, env.ledgerService,
+				&failingPayoutProvider{err: tt.err}, env.validate, time.Hour,
+			)
+			_, errException := service.Create(ctx, &model.CreatePayoutReq{
+				UserId: owner.Id, WalletId: wallet.Id, DestinationId: destination.Id, Amount: money.New(40000, "IDR"),
+			})
+			if (errException != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", errException, tt.wantErr)
+			}
+			var payout entity.Payout
+			if err := env.db.Where("wallet_id = ?", wallet.Id).First(&payout).Error; err != nil {
+				t.Fatal(err)
+			}
+			if payout.Status != tt.wantStatus {
+				t.Errorf("payout status = %s, want %s", payout.Status, tt.wantStatus)
+			}
+			if got := env.wallet(t, wallet.Id).Balance.Units; got != tt.wantBalance {
+				t.Errorf("balance = %d, want %d", got, tt.wantBalance)
+			}
+			if _, errException := env.transactionService.Reverse(ctx, &model.ReverseTransactionReq{
+				ID: *payout.TransactionId, UserId: owner.Id,
+			}); errException == nil {
+				t.Error("reversing a withdrawal: want permission denied")
+			}
+		})
+	}
+}
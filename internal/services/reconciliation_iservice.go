@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type ReconciliationService interface {
+	// Run compares the balance of every wallet with the sum of its transactions and
+	// records a finding for each mismatch
+	Run(ctx context.Context, req *model.RunReconciliationReq) (*model.RunReconciliationRes, *exception.Exception)
+	Correct(ctx context.Context, req *model.CorrectReconciliationFindingReq) (
+		*model.CorrectReconciliationFindingRes, *exception.Exception,
+	)
+	Find(ctx context.Context, req *model.GetAllReconciliationFindingReq) (
+		*model.GetAllReconciliationFindingRes, *exception.Exception,
+	)
+	Detail(ctx context.Context, req *model.GetReconciliationFindingByIDReq) (
+		*model.GetReconciliationFindingByIDRes, *exception.Exception,
+	)
+}
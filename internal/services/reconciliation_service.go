@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+type ReconciliationServiceImpl struct {
+	db                    *gorm.DB
+	findingRepository     repository.ReconciliationFindingRepository
+	walletRepository      repository.WalletRepository
+	transactionRepository repository.TransactionRepository
+	ledgerService         LedgerService
+	validate              *xvalidator.Validator
+	batchSize             int
+}
+
+func NewReconciliationService(
+	db *gorm.DB,
+	repo repository.ReconciliationFindingRepository,
+	walletRepository repository.WalletRepository,
+	transactionRepository repository.TransactionRepository,
+	ledgerService LedgerService,
+	validate *xvalidator.Validator,
+	batchSize int,
+) ReconciliationService {
+	return &ReconciliationServiceImpl{
+		db:                    db,
+		findingRepository:     repo,
+		walletRepository:      walletRepository,
+		transactionRepository: transactionRepository,
+		ledgerService:         ledgerService,
+		validate:              validate,
+		batchSize:             batchSize,
+	}
+}
+
+// reconcile compares a wallet with its transactions and its ledger account while
+// holding its lock, so no booking lands between the reads. It returns the finding of
+// the wallet, nil when the balances agree and nothing was open, and the adjustment
+// when one was booked.
+func (s *ReconciliationServiceImpl) reconcile(
+	ctx context.Context, walletId string, autoCorrect bool,
+) (*entity.ReconciliationFinding, *entity.Transaction, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	wallet, err := s.walletRepository.FindByIDForUpdate(ctx, tx, walletId)
+	if err != nil {
+		return nil, nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, nil, exception.NotFound("wallet detail not found")
+	}
+	total, err := s.transactionRepository.SumWalletBalanceTx(ctx, tx, wallet.Id)
+	if err != nil {
+		return nil, nil, exception.Internal("failed summing wallet transactions", err)
+	}
+	account, errException := s.ledgerService.WalletAccount(ctx, tx, wallet)
+	if errException != nil {
+		return nil, nil, errException
+	}
+	now := time.Now()
+	currency := wallet.CurrencyCode()
+	found := model.NewReconciliationFinding(wallet.Id, money.New(total, currency),
+		money.New(wallet.Balance.Normalize().Units, currency), money.New(account.Balance.Normalize().Units, currency), now)
+	agree := found.Difference.IsZero() && found.LedgerDifference.IsZero()
+
+	finding, err := s.findingRepository.FindOpenByWalletId(ctx, tx, wallet.Id)
+	if err != nil {
+		return nil, nil, exception.Internal("failed getting reconciliation finding", err)
+	}
+	if finding == nil {
+		if agree {
+			return nil, nil, nil
+		}
+		finding = found
+	} else {
+		finding.ExpectedBalance = found.ExpectedBalance
+		finding.ActualBalance = found.ActualBalance
+		finding.Difference = found.Difference
+		finding.LedgerBalance = found.LedgerBalance
+		finding.LedgerDifference = found.LedgerDifference
+		finding.CheckedAt = now
+		if agree {
+			finding.Status = entity.ReconciliationFindingStatusResolved
+			finding.ClosedAt = &now
+		}
+	}
+
+	var adjustment *entity.Transaction
+	if autoCorrect && !agree {
+		// the ledger is the book of record and is never corrected, the wallet balance is
+		// rebuilt from it and the adjustment only completes the transaction history
+		if !finding.LedgerDifference.IsZero() {
+			if err := s.walletRepository.UpdateBalanceTx(ctx, tx, wallet.Id, finding.LedgerBalance); err != nil {
+				return nil, nil, exception.Internal("failed updating wallet", err)
+			}
+		}
+		if !model.HistoryDifference(*finding).IsZero() {
+			adjustment = model.ToAdjustmentEntity(*finding)
+			if err := s.transactionRepository.CreateTx(ctx, tx, adjustment); err != nil {
+				return nil, nil, exception.Internal("failed creating adjustment transaction", err)
+			}
+			finding.AdjustmentId = &adjustment.Id
+		}
+		finding.Status = entity.ReconciliationFindingStatusCorrected
+		finding.ClosedAt = &now
+	}
+	if err := s.findingRepository.CreateTx(ctx, tx, finding); err != nil {
+		return nil, nil, exception.Internal("failed saving reconciliation finding", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, nil, exception.Internal("commit transaction", err)
+	}
+	return finding, adjustment, nil
+}
+
+func (s *ReconciliationServiceImpl) Run(
+	ctx context.Context, req *model.RunReconciliationReq,
+) (*model.RunReconciliationRes, *exception.Exception) {
+	result := &model.RunReconciliationRes{}
+	afterId := ""
+	for {
+		walletIds, err := s.walletRepository.FindIdsAfter(ctx, s.db, afterId, s.batchSize)
+		if err != nil {
+			return nil, exception.Internal("failed getting wallets", err)
+		}
+		if len(walletIds) == 0 {
+			return result, nil
+		}
+		for _, walletId := range walletIds {
+			finding, _, errException := s.reconcile(ctx, walletId, req.AutoCorrect)
+			if errException != nil {
+				slog.Error("failed to reconcile wallet", "wallet_id", walletId, "error", errException.Message)
+				continue
+			}
+			result.Checked++
+			if finding != nil && finding.Status != entity.ReconciliationFindingStatusResolved {
+				result.Mismatched++
+			}
+			if finding != nil && finding.Status == entity.ReconciliationFindingStatusCorrected {
+				result.Corrected++
+			}
+		}
+		afterId = walletIds[len(walletIds)-1]
+	}
+}
+
+func (s *ReconciliationServiceImpl) Correct(
+	ctx context.Context, req *model.CorrectReconciliationFindingReq,
+) (*model.CorrectReconciliationFindingRes, *exception.Exception) {
+	finding, err := s.findingRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("failed getting reconciliation finding", err)
+	}
+	if finding == nil {
+		return nil, exception.NotFound("reconciliation finding not found")
+	}
+	if finding.Status != entity.ReconciliationFindingStatusOpen {
+		return nil, exception.PermissionDenied("reconciliation finding is already " + finding.Status)
+	}
+	result, adjustment, errException := s.reconcile(ctx, finding.WalletId, true)
+	if errException != nil {
+		return nil, errException
+	}
+	if result == nil {
+		return nil, exception.PermissionDenied("reconciliation finding was closed in the meantime")
+	}
+	return &model.CorrectReconciliationFindingRes{
+		Finding:    *result,
+		Adjustment: adjustment,
+	}, nil
+}
+
+func (s *ReconciliationServiceImpl) Find(ctx context.Context, req *model.GetAllReconciliationFindingReq) (
+	*model.GetAllReconciliationFindingRes, *exception.Exception,
+) {
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "detected_at",
+		}
+	}
+	result, err := s.findingRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, req.Filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllReconciliationFindingRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *ReconciliationServiceImpl) Detail(ctx context.Context, req *model.GetReconciliationFindingByIDReq) (
+	*model.GetReconciliationFindingByIDRes, *exception.Exception,
+) {
+	result, err := s.findingRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil {
+		return nil, exception.NotFound("reconciliation finding not found")
+	}
+
+	return &model.GetReconciliationFindingByIDRes{
+		ReconciliationFinding: *result,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/money"
+	"testing"
+)
+
+func TestReconcile(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	service := &ReconciliationServiceImpl{
+		db:                    env.db,
+		findingRepository:     repository.NewReconciliationFindingSQLRepository(),
+		walletRepository:      env.walletRepository,
+		transactionRepository: repository.NewTransactionSQLRepository(),
+		ledgerService:         env.ledgerService,
+		validate:              env.validate,
+		batchSize:             10,
+	}
+
+	tests := []struct {
+		name string
+		// tamper breaks the wallet the way a bug or a manual fix in the database would
+		tamper               func(t *testing.T, wallet *entity.Wallet)
+		wantFinding          bool
+		wantDifference       int64
+		wantLedgerDifference int64
+		wantAdjustment       int64 // signed, 0 when none is booked
+	}{
+		{
+			name:   "in balance",
+			tamper: func(t *testing.T, wallet *entity.Wallet) {},
+		},
+		{
+			name: "wallet balance drifted from the ledger",
+			tamper: func(t *testing.T, wallet *entity.Wallet) {
+				if err := env.walletRepository.UpdateBalanceTx(ctx, env.db, wallet.Id, money.New(15000, "IDR")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantFinding:          true,
+			wantDifference:       5000,
+			wantLedgerDifference: 5000,
+		},
+		{
+			name: "transaction missing from the history",
+			tamper: func(t *testing.T, wallet *entity.Wallet) {
+				if err := env.db.Unscoped().Where("wallet_id = ?", wallet.Id).Delete(&entity.Transaction{}).Error; err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantFinding:    true,
+			wantDifference: 10000,
+			wantAdjustment: 10000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, wallet := env.user(t, 10000)
+			tt.tamper(t, wallet)
+
+			finding, adjustment, errException := service.reconcile(ctx, wallet.Id, false)
+			if errException != nil {
+				t.Fatal(errException.Message)
+			}
+			if (finding != nil) != tt.wantFinding {
+				t.Fatalf("finding = %v, want one %v", finding, tt.wantFinding)
+			}
+			if adjustment != nil {
+				t.Errorf("adjustment booked without auto correction")
+			}
+			if finding == nil {
+				return
+			}
+			if finding.Difference.Units != tt.wantDifference || finding.LedgerDifference.Units != tt.wantLedgerDifference {
+				t.Errorf("differences = %d, %d, want %d, %d",
+					finding.Difference.Units, finding.LedgerDifference.Units, tt.wantDifference, tt.wantLedgerDifference)
+			}
+			if finding.LedgerBalance.Units != 10000 {
+				t.Errorf("ledger balance = %d, want 10000", finding.LedgerBalance.Units)
+			}
+
+			corrected, adjustment, errException := service.reconcile(ctx, wallet.Id, true)
+			if errException != nil {
+				t.Fatal(errException.Message)
+			}
+			if corrected == nil || corrected.Id != finding.Id || corrected.Status != entity.ReconciliationFindingStatusCorrected {
+				t.Fatalf("finding = %v, want %s corrected", corrected, finding.Id)
+			}
+			var booked int64
+			if adjustment != nil {
+				booked = adjustment.SignedAmount().Units
+			}
+			if booked != tt.wantAdjustment {
+				t.Errorf("adjustment = %d, want %d", booked, tt.wantAdjustment)
+			}
+			if balance := env.wallet(t, wallet.Id).Balance.Units; balance != 10000 {
+				t.Errorf("wallet balance = %d, want it rebuilt from the ledger to 10000", balance)
+			}
+
+			again, _, errException := service.reconcile(ctx, wallet.Id, false)
+			if errException != nil {
+				t.Fatal(errException.Message)
+			}
+			if again != nil {
+				t.Errorf("finding = %v after the correction, want the balances to agree", again)
+			}
+		})
+	}
+}
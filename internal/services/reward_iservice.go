@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+)
+
+type RewardService interface {
+	// Find and Detail show a user the rewards they were granted
+	Find(ctx context.Context, req *model.GetAllRewardReq) (*model.GetAllRewardRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetRewardByIDReq) (*model.GetRewardByIDRes, *exception.Exception)
+
+	// EvaluateTx grants the rewards of the campaigns running for trigger on a transaction just booked
+	// into wallet, inside the caller's database transaction
+	EvaluateTx(ctx context.Context, tx *gorm.DB, trigger string, wallet *entity.Wallet, transaction *entity.Transaction) (
+		[]entity.Reward, *exception.Exception,
+	)
+
+	// CreditedTx returns the rewards paid out on a transaction, ClawBackTx takes amount of one
+	// back from the user once its credit was reversed, returning it to the campaign budget
+	CreditedTx(ctx context.Context, tx *gorm.DB, sourceTransactionId string) ([]entity.Reward, *exception.Exception)
+	ClawBackTx(ctx context.Context, tx *gorm.DB, reward *entity.Reward, amount money.Money) *exception.Exception
+
+	// Release credits the rewards whose clearing period passed, returning how many were settled
+	Release(ctx context.Context) (int64, *exception.Exception)
+}
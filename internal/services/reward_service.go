@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"math/big"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+// rewardReleaseBatch is how many rewards Release reads at a time.
+const rewardReleaseBatch = 100
+
+// rewardTransactionTypes are the types of the transactions a trigger rewards,
+// used to tell a user's first one.
+var rewardTransactionTypes = map[string]string{
+	entity.CampaignTriggerPurchase: "expense",
+	entity.CampaignTriggerTopUp:    "income",
+}
+
+type RewardServiceImpl struct {
+	db                    *gorm.DB
+	rewardRepository      repository.RewardRepository
+	campaignRepository    repository.CampaignRepository
+	walletRepository      repository.WalletRepository
+	transactionRepository repository.TransactionRepository
+	categoryRepository    repository.CategoryRepository
+	ledgerService         LedgerService
+	validate              *xvalidator.Validator
+}
+
+func NewRewardService(
+	db *gorm.DB,
+	repo repository.RewardRepository,
+	campaignRepository repository.CampaignRepository,
+	walletRepository repository.WalletRepository,
+	transactionRepository repository.TransactionRepository,
+	categoryRepository repository.CategoryRepository,
+	ledgerService LedgerService,
+	validate *xvalidator.Validator,
+) RewardService {
+	return &RewardServiceImpl{
+		db:                    db,
+		rewardRepository:      repo,
+		campaignRepository:    campaignRepository,
+		walletRepository:      walletRepository,
+		transactionRepository: transactionRepository,
+		categoryRepository:    categoryRepository,
+		ledgerService:         ledgerService,
+		validate:              validate,
+	}
+}
+
+func (s *RewardServiceImpl) Find(ctx context.Context, req *model.GetAllRewardReq) (
+	*model.GetAllRewardRes, *exception.Exception,
+) {
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "user_id",
+		Value:    req.UserId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.rewardRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllRewardRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *RewardServiceImpl) Detail(ctx context.Context, req *model.GetRewardByIDReq) (
+	*model.GetRewardByIDRes, *exception.Exception,
+) {
+	result, err := s.rewardRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil || result.UserId != req.UserId {
+		return nil, exception.NotFound("reward not found")
+	}
+
+	return &model.GetRewardByIDRes{
+		Reward: *result,
+	}, nil
+}
+
+// EvaluateTx rewards the user who made transaction. The running campaigns stay
+// locked until the caller commits, so their budgets and per-user caps cannot be
+// overrun by concurrent transactions. A reward with no clearing period is
+// credited right away, the others are left to Release.
+func (s *RewardServiceImpl) EvaluateTx(
+	ctx context.Context, tx *gorm.DB, trigger string, wallet *entity.Wallet, transaction *entity.Transaction,
+) ([]entity.Reward, *exception.Exception) {
+	if transaction.InitiatedBy == nil {
+		return nil, nil
+	}
+	userId := *transaction.InitiatedBy
+	now := time.Now()
+	running, err := s.campaignRepository.FindRunning(ctx, tx, trigger, transaction.Amount.Normalize().Currency, now)
+	if err != nil {
+		return nil, exception.Internal("failed getting campaigns", err)
+	}
+	// only the campaigns the transaction matches are locked, so transactions earning
+	// from different campaigns do not wait on each other
+	var ids []string
+	for i := range *running {
+		if (*running)[i].Matches(transaction) {
+			ids = append(ids, (*running)[i].Id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	campaigns, err := s.campaignRepository.FindByIDsForUpdate(ctx, tx, ids)
+	if err != nil {
+		return nil, exception.Internal("failed getting campaigns", err)
+	}
+	var rewards []entity.Reward
+	var first *bool
+	for i := range *campaigns {
+		campaign := &(*campaigns)[i]
+		// checked again as the campaign may have been changed before it was locked
+		if !campaign.Runs(trigger, now) || !campaign.Matches(transaction) {
+			continue
+		}
+		if campaign.FirstOnly {
+			if first == nil {
+				earlier, err := s.transactionRepository.CountByInitiatorTx(ctx, tx, userId, rewardTransactionTypes[trigger], transaction.Id)
+				if err != nil {
+					return nil, exception.Internal("failed counting user transactions", err)
+				}
+				isFirst := earlier == 0
+				first = &isFirst
+			}
+			if !*first {
+				continue
+			}
+		}
+		amount := campaign.Reward(transaction.Amount.Normalize())
+		amount.Units = min(amount.Units, campaign.Budget.Units-campaign.Spent.Units)
+		if campaign.UserCap.IsPositive() {
+			granted, err := s.rewardRepository.SumByUserTx(ctx, tx, campaign.Id, userId, campaign.CapSince(now))
+			if err != nil {
+				return nil, exception.Internal("failed getting user rewards", err)
+			}
+			amount.Units = min(amount.Units, campaign.UserCap.Units-granted)
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+
+		reward := model.NewReward(*campaign, userId, *transaction, amount, now)
+		campaign.Spent = money.New(campaign.Spent.Units+amount.Units, campaign.Currency)
+		if err := s.campaignRepository.UpdateTx(ctx, tx, campaign); err != nil {
+			return nil, exception.Internal("failed updating campaign", err)
+		}
+		if !reward.AvailableAt.After(now) {
+			if errException := s.credit(ctx, tx, reward, wallet, campaign, now); errException != nil {
+				return nil, errException
+			}
+		}
+		if err := s.rewardRepository.CreateTx(ctx, tx, reward); err != nil {
+			return nil, exception.Internal("failed creating reward", err)
+		}
+		rewards = append(rewards, *reward)
+	}
+	return rewards, nil
+}
+
+func (s *RewardServiceImpl) CreditedTx(
+	ctx context.Context, tx *gorm.DB, sourceTransactionId string,
+) ([]entity.Reward, *exception.Exception) {
+	rewards, err := s.rewardRepository.FindCreditedBySourceTx(ctx, tx, sourceTransactionId)
+	if err != nil {
+		return nil, exception.Internal("failed getting rewards", err)
+	}
+	return *rewards, nil
+}
+
+// ClawBackTx shrinks a credited reward by amount, whose credit the caller reversed, and
+// gives amount back to the budget of the campaign. A reward taken back in full is
+// cancelled.
+func (s *RewardServiceImpl) ClawBackTx(
+	ctx context.Context, tx *gorm.DB, reward *entity.Reward, amount money.Money,
+) *exception.Exception {
+	campaign, err := s.campaignRepository.FindByIDForUpdate(ctx, tx, reward.CampaignId)
+	if err != nil {
+		return exception.Internal("failed getting campaign detail", err)
+	}
+	if campaign == nil {
+		return exception.Internal("reward lost its campaign", errors.New(reward.Id))
+	}
+	amount = amount.Normalize()
+	campaign.Spent = money.New(campaign.Spent.Units-amount.Units, campaign.Currency)
+	if err := s.campaignRepository.UpdateTx(ctx, tx, campaign); err != nil {
+		return exception.Internal("failed updating campaign", err)
+	}
+	reward.Amount = money.New(reward.Amount.Normalize().Units-amount.Units, amount.Currency)
+	if !reward.Amount.IsPositive() {
+		now := time.Now()
+		reward.Status = entity.RewardStatusCancelled
+		reward.CancelledAt = &now
+	}
+	if err := s.rewardRepository.UpdateTx(ctx, tx, reward); err != nil {
+		return exception.Internal("failed updating reward", err)
+	}
+	return nil
+}
+
+// Release settles the pending rewards that cleared, each in its own database
+// transaction so one failing does not hold the others back.
+func (s *RewardServiceImpl) Release(ctx context.Context) (int64, *exception.Exception) {
+	var released int64
+	after := ""
+	now := time.Now()
+	for {
+		rewards, err := s.rewardRepository.FindDue(ctx, s.db, after, now, rewardReleaseBatch)
+		if err != nil {
+			return released, exception.Internal("failed getting due rewards", err)
+		}
+		for i := range *rewards {
+			after = (*rewards)[i].Id
+			settled, errException := s.release(ctx, (*rewards)[i].Id, now)
+			if errException != nil {
+				slog.Error("failed to release reward", "reward_id", (*rewards)[i].Id, "error", errException.Error)
+				continue
+			}
+			if settled {
+				released++
+			}
+		}
+		if len(*rewards) < rewardReleaseBatch {
+			return released, nil
+		}
+	}
+}
+
+// release credits a reward, shrunk in proportion to what was refunded of its
+// transaction meanwhile. It is cancelled when the transaction was reversed or the
+// wallet closed, and waits while the wallet cannot take credits. What is not paid
+// goes back to the budget of the campaign.
+func (s *RewardServiceImpl) release(ctx context.Context, id string, now time.Time) (bool, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	reward, err := s.rewardRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return false, exception.Internal("failed getting reward detail", err)
+	}
+	if reward == nil || reward.Status != entity.RewardStatusPending {
+		return false, nil
+	}
+	source, err := s.transactionRepository.FindByIDForUpdate(ctx, tx, reward.SourceTransactionId)
+	if err != nil {
+		return false, exception.Internal("failed getting transaction detail", err)
+	}
+	wallet, err := s.walletRepository.FindByIDForUpdate(ctx, tx, reward.WalletId)
+	if err != nil {
+		return false, exception.Internal("failed getting wallet detail", err)
+	}
+	campaign, err := s.campaignRepository.FindByIDForUpdate(ctx, tx, reward.CampaignId)
+	if err != nil {
+		return false, exception.Internal("failed getting campaign detail", err)
+	}
+	if wallet == nil || campaign == nil {
+		return false, exception.Internal("reward lost its wallet or campaign", errors.New(reward.Id))
+	}
+
+	amount := money.Zero(reward.Amount.Currency)
+	if source != nil && source.Amount.IsPositive() && wallet.StatusCode() != entity.WalletStatusClosed {
+		if errException := checkCredit(wallet); errException != nil {
+			return false, nil
+		}
+		ratio := big.NewRat(source.RemainingAmount().Units, source.Amount.Normalize().Units)
+		amount = reward.Amount.Normalize().MulRat(ratio, money.DefaultRounding)
+	}
+	if unpaid := reward.Amount.Units - amount.Units; unpaid > 0 {
+		campaign.Spent = money.New(campaign.Spent.Units-unpaid, campaign.Currency)
+		if err := s.campaignRepository.UpdateTx(ctx, tx, campaign); err != nil {
+			return false, exception.Internal("failed updating campaign", err)
+		}
+	}
+	if amount.IsPositive() {
+		reward.Amount = amount
+		if errException := s.credit(ctx, tx, reward, wallet, campaign, now); errException != nil {
+			return false, errException
+		}
+	} else {
+		reward.Status = entity.RewardStatusCancelled
+		reward.CancelledAt = &now
+	}
+	if err := s.rewardRepository.UpdateTx(ctx, tx, reward); err != nil {
+		return false, exception.Internal("failed updating reward", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, exception.Internal("commit transaction", err)
+	}
+	return true, nil
+}
+
+// credit pays a reward into its locked wallet out of the promotional rewards
+// account, saving the reward is left to the caller.
+func (s *RewardServiceImpl) credit(
+	ctx context.Context, tx *gorm.DB, reward *entity.Reward, wallet *entity.Wallet, campaign *entity.Campaign, now time.Time,
+) *exception.Exception {
+	transaction := model.NewRewardTransaction(*reward, campaign.Name)
+	category, err := s.categoryRepository.FindByName(ctx, tx, nil, entity.CategoryRewards)
+	if err != nil {
+		return exception.Internal("failed getting category detail", err)
+	}
+	if category != nil {
+		transaction.CategoryId = &category.Id
+	}
+	if err := s.transactionRepository.CreateTx(ctx, tx, transaction); err != nil {
+		return exception.Internal("failed creating transaction", err)
+	}
+	walletAccount, errException := s.ledgerService.WalletAccount(ctx, tx, wallet)
+	if errException != nil {
+		return errException
+	}
+	rewardsAccount, errException := s.ledgerService.SystemAccount(ctx, tx, entity.SystemRewardsAccountCode, reward.Amount.Currency)
+	if errException != nil {
+		return errException
+	}
+	entry := entity.NewJournalEntry(transaction.Description).
+		Debit(rewardsAccount.Id, reward.Amount, nil).
+		Credit(walletAccount.Id, reward.Amount, &transaction.Id)
+	if errException := s.ledgerService.Post(ctx, tx, entry); errException != nil {
+		return errException
+	}
+	reward.Status = entity.RewardStatusCredited
+	reward.TransactionId = &transaction.Id
+	reward.CreditedAt = &now
+	return nil
+}
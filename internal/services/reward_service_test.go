@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"testing"
+	"time"
+)
+
+func TestEvaluateTopUpCampaigns(t *testing.T) {
+	env := newTestEnv(t)
+	now := time.Now()
+	campaign := func(name string, minAmount int64, endsAt time.Time) *entity.Campaign {
+		t.Helper()
+		campaign := &entity.Campaign{
+			Id:          uuid.NewString(),
+			Name:        name,
+			Trigger:     entity.CampaignTriggerTopUp,
+			Currency:    "IDR",
+			MinAmount:   idr(minAmount),
+			BasisPoints: 1000,
+			Budget:      idr(1000000),
+			Spent:       idr(0),
+			StartsAt:    now.Add(-time.Hour),
+			EndsAt:      endsAt,
+			Active:      true,
+		}
+		if err := env.db.Create(campaign).Error; err != nil {
+			t.Fatal(err)
+		}
+		// stopped rather than deleted, the rewards it granted refer to it
+		t.Cleanup(func() {
+			env.db.Model(&entity.Campaign{}).Where("id = ?", campaign.Id).Update("active", false)
+		})
+		return campaign
+	}
+	matching := campaign("matching", 1000, now.Add(time.Hour))
+	tooSmall := campaign("minimum not reached", 10000000, now.Add(time.Hour))
+	ended := campaign("ended", 1000, now.Add(-time.Minute))
+
+	// a self-serve credit is not a top up the provider confirmed
+	owner, wallet := env.user(t, 5000)
+	if rewards := env.rewards(t, wallet.Id); len(rewards) != 0 {
+		t.Fatalf("rewards of a credit = %+v, want none", rewards)
+	}
+	env.topUp(t, owner, wallet, 5000)
+	rewards := env.rewards(t, wallet.Id)
+	if len(rewards) != 1 || rewards[0].CampaignId != matching.Id || rewards[0].Amount.Units != 500 ||
+		rewards[0].Status != entity.RewardStatusCredited {
+		t.Fatalf("rewards = %+v, want 500 from %s credited", rewards, matching.Id)
+	}
+	for _, tt := range []struct {
+		campaign  *entity.Campaign
+		wantSpent int64
+	}{
+		{matching, 500},
+		{tooSmall, 0},
+		{ended, 0},
+	} {
+		var stored entity.Campaign
+		if err := env.db.First(&stored, "id = ?", tt.campaign.Id).Error; err != nil {
+			t.Fatal(err)
+		}
+		if stored.Spent.Units != tt.wantSpent {
+			t.Errorf("spent of %s = %d, want %d", stored.Name, stored.Spent.Units, tt.wantSpent)
+		}
+	}
+}
+
+func TestClawBackReward(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	now := time.Now()
+	campaign := &entity.Campaign{
+		Id: uuid.NewString(), Name: "clawback", Trigger: entity.CampaignTriggerTopUp, Currency: "IDR",
+		MinAmount: idr(1000), BasisPoints: 1000, Budget: idr(1000000), Spent: idr(0),
+		StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), Active: true,
+	}
+	if err := env.db.Create(campaign).Error; err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		env.db.Model(&entity.Campaign{}).Where("id = ?", campaign.Id).Update("active", false)
+	})
+	owner, wallet := env.user(t, 0)
+	topUp := env.topUp(t, owner, wallet, 8000)
+	if got := env.wallet(t, wallet.Id).Balance.Units; got != 8800 {
+		t.Fatalf("balance after the top up = %d, want 8800 with the reward", got)
+	}
+
+	if _, errException := env.transactionService.Reverse(ctx, &model.ReverseTransactionReq{
+		ID: topUp.Id, UserId: owner.Id,
+	}); errException != nil {
+		t.Fatal(errException.Message)
+	}
+	if got := env.wallet(t, wallet.Id).Balance.Units; got != 0 {
+		t.Errorf("balance after the reversal = %d, want 0", got)
+	}
+	rewards := env.rewards(t, wallet.Id)
+	if len(rewards) != 1 || rewards[0].Status != entity.RewardStatusCancelled {
+		t.Errorf("rewards = %+v, want one cancelled", rewards)
+	}
+	var stored entity.Campaign
+	if err := env.db.First(&stored, "id = ?", campaign.Id).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.Spent.Units != 0 {
+		t.Errorf("spent = %d, want the reward back in the budget", stored.Spent.Units)
+	}
+}
+
+// topUp books a top up the provider confirmed into wallet and returns its income.
+func (env *testEnv) topUp(t *testing.T, user *entity.User, wallet *entity.Wallet, units int64) *entity.Transaction {
+	t.Helper()
+	tx := env.db.Begin()
+	defer tx.Rollback()
+	transaction, errException := env.transactionService.TopUpTx(context.Background(), tx, &entity.TopUp{
+		Id: uuid.NewString(), WalletId: wallet.Id, UserId: user.Id, Amount: idr(units), Provider: "stub",
+	})
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+	if err := tx.Commit().Error; err != nil {
+		t.Fatal(err)
+	}
+	return transaction
+}
+
+func (env *testEnv) rewards(t *testing.T, walletId string) []entity.Reward {
+	t.Helper()
+	var rewards []entity.Reward
+	if err := env.db.Where("wallet_id = ?", walletId).Find(&rewards).Error; err != nil {
+		t.Fatal(err)
+	}
+	return rewards
+}
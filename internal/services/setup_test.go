@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/migration"
+	"product-wallet/pkg/database"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+	"sync"
+	"testing"
+)
+
+// testEnv wires the services over an in-memory sqlite database shared by the tests
+// of the package, each test works on users and wallets of its own.
+type testEnv struct {
+	db                   *gorm.DB
+	validate             *xvalidator.Validator
+	walletRepository     repository.WalletRepository
+	memberRepository     repository.WalletMemberRepository
+	feeRuleRepository    repository.FeeRuleRepository
+	ledgerService        LedgerService
+	exchangeRateService  ExchangeRateService
+	feeService           FeeService
+	spendingLimitService SpendingLimitService
+	rewardService        RewardService
+	transactionService   TransactionService
+	walletService        WalletService
+}
+
+var (
+	testEnvOnce sync.Once
+	sharedEnv   *testEnv
+)
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	testEnvOnce.Do(func() {
+		conn := database.NewDatabase("sqlite", &database.Config{})
+		migration.AutoMigration(conn)
+		migration.MigrateCategories(conn)
+		db := conn.GetDB()
+		db.Logger = logger.Default.LogMode(logger.Silent)
+		validate, err := xvalidator.NewValidator()
+		if err != nil {
+			panic(err)
+		}
+		walletRepository := repository.NewWalletSQLRepository()
+		transactionRepository := repository.NewTransactionSQLRepository()
+		memberRepository := repository.NewWalletMemberSQLRepository()
+		categoryRepository := repository.NewCategorySQLRepository()
+		holdRepository := repository.NewHoldSQLRepository()
+		feeRuleRepository := repository.NewFeeRuleSQLRepository()
+		campaignRepository := repository.NewCampaignSQLRepository()
+		ledgerService := NewLedgerService(db, repository.NewLedgerAccountSQLRepository(), repository.NewJournalEntrySQLRepository(), walletRepository, validate)
+		exchangeRateService := NewExchangeRateService(db, repository.NewExchangeRateSQLRepository(), validate)
+		spendingLimitService := NewSpendingLimitService(db, repository.NewSpendingLimitSQLRepository(), walletRepository, memberRepository, transactionRepository, exchangeRateService, validate, entity.SpendingLimit{})
+		feeService := NewFeeService(db, feeRuleRepository, walletRepository, memberRepository, validate, "")
+		rewardService := NewRewardService(db, repository.NewRewardSQLRepository(), campaignRepository, walletRepository, transactionRepository, categoryRepository, ledgerService, validate)
+		sharedEnv = &testEnv{
+			db:                   db,
+			validate:             validate,
+			walletRepository:     walletRepository,
+			memberRepository:     memberRepository,
+			feeRuleRepository:    feeRuleRepository,
+			ledgerService:        ledgerService,
+			exchangeRateService:  exchangeRateService,
+			feeService:           feeService,
+			spendingLimitService: spendingLimitService,
+			rewardService:        rewardService,
+		}
+		sharedEnv.transactionService = sharedEnv.newTransactionService(feeService)
+		sharedEnv.walletService = NewWalletService(db, walletRepository, repository.NewUserSQLRepository(), transactionRepository, holdRepository, repository.NewWalletStatusChangeSQLRepository(), memberRepository, repository.NewStandingOrderSQLRepository(), sharedEnv.transactionService, validate)
+	})
+	return sharedEnv
+}
+
+// newTransactionService wires a transaction service charging fees with feeService.
+func (env *testEnv) newTransactionService(feeService FeeService) TransactionService {
+	return NewTransactionService(
+		env.db, repository.NewTransactionSQLRepository(), repository.NewProductSQLRepository(), env.walletRepository,
+		repository.NewHoldSQLRepository(), env.ledgerService, env.exchangeRateService, env.spendingLimitService, feeService,
+		env.rewardService, env.memberRepository, repository.NewCategorySQLRepository(), repository.NewCategoryRuleSQLRepository(),
+		repository.NewUserSQLRepository(), repository.NewPaymentRequestSQLRepository(), repository.NewPaymentRequestStatusChangeSQLRepository(),
+		env.validate,
+	)
+}
+
+// user creates a user and a wallet it owns holding balance minor units of IDR.
+func (env *testEnv) user(t *testing.T, balance int64) (*entity.User, *entity.Wallet) {
+	t.Helper()
+	ctx := context.Background()
+	user := &entity.User{Id: uuid.NewString(), Username: "user-" + uuid.NewString()[:8], Password: "x"}
+	if err := env.db.Create(user).Error; err != nil {
+		t.Fatal(err)
+	}
+	created, errException := env.walletService.Create(ctx, &model.CreateWalletReq{
+		BaseWalletReq: model.BaseWalletReq{Name: "personal", UserId: user.Id},
+	})
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+	if balance > 0 {
+		if _, errException := env.transactionService.Credit(ctx, &model.CreditTransactionReq{
+			UserId: user.Id, WalletId: created.Id, Amount: money.New(balance, "IDR"),
+		}); errException != nil {
+			t.Fatal(errException.Message)
+		}
+	}
+	return user, env.wallet(t, created.Id)
+}
+
+func (env *testEnv) wallet(t *testing.T, id string) *entity.Wallet {
+	t.Helper()
+	wallet, err := env.walletRepository.FindByID(context.Background(), env.db, id)
+	if err != nil || wallet == nil {
+		t.Fatalf("wallet %s not found: %v", id, err)
+	}
+	return wallet
+}
+
+// member creates a user and adds it to wallet with role, invited by owner.
+func (env *testEnv) member(t *testing.T, wallet *entity.Wallet, owner *entity.User, role string) *entity.User {
+	t.Helper()
+	user := &entity.User{Id: uuid.NewString(), Username: "user-" + uuid.NewString()[:8], Password: "x"}
+	if err := env.db.Create(user).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, errException := env.walletService.AddMember(context.Background(), &model.AddWalletMemberReq{
+		WalletId: wallet.Id, InvitedBy: owner.Id, Username: user.Username, Role: role,
+	}); errException != nil {
+		t.Fatal(errException.Message)
+	}
+	return user
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+)
+
+type SpendingLimitService interface {
+	Detail(ctx context.Context, req *model.GetSpendingLimitReq) (*model.GetSpendingLimitRes, *exception.Exception)
+	Update(ctx context.Context, req *model.UpdateSpendingLimitReq) (*model.UpdateSpendingLimitRes, *exception.Exception)
+
+	// Check denies amount leaving wallet when it breaches one of the wallet's limits,
+	// tx must hold the lock of wallet so concurrent outflows are counted
+	Check(ctx context.Context, tx *gorm.DB, wallet *entity.Wallet, amount money.Money) *exception.Exception
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/utils/converter"
+	"product-wallet/pkg/xvalidator"
+	"strings"
+	"time"
+)
+
+type SpendingLimitServiceImpl struct {
+	db                      *gorm.DB
+	spendingLimitRepository repository.SpendingLimitRepository
+	walletRepository        repository.WalletRepository
+	memberRepository        repository.WalletMemberRepository
+	transactionRepository   repository.TransactionRepository
+	exchangeRateService     ExchangeRateService
+	validate                *xvalidator.Validator
+	defaults                entity.SpendingLimit
+}
+
+// NewSpendingLimitService takes the limits applying to wallets that did not set
+// their own as defaults, they are converted into the currency of each wallet.
+func NewSpendingLimitService(
+	db *gorm.DB,
+	repo repository.SpendingLimitRepository,
+	walletRepository repository.WalletRepository,
+	memberRepository repository.WalletMemberRepository,
+	transactionRepository repository.TransactionRepository,
+	exchangeRateService ExchangeRateService,
+	validate *xvalidator.Validator,
+	defaults entity.SpendingLimit,
+) SpendingLimitService {
+	return &SpendingLimitServiceImpl{
+		db:                      db,
+		spendingLimitRepository: repo,
+		walletRepository:        walletRepository,
+		memberRepository:        memberRepository,
+		transactionRepository:   transactionRepository,
+		exchangeRateService:     exchangeRateService,
+		validate:                validate,
+		defaults:                defaults,
+	}
+}
+
+// defaultLimit is the configured default of period in currency, zero when there is none.
+func (s *SpendingLimitServiceImpl) defaultLimit(ctx context.Context, tx *gorm.DB, period, currency string) money.Money {
+	limit := s.defaults.Limit(period)
+	if !limit.IsPositive() {
+		return money.Zero(currency)
+	}
+	converted, _, errException := s.exchangeRateService.Convert(ctx, tx, limit, currency)
+	if errException != nil {
+		slog.Warn("default spending limit does not apply", "period", period, "currency", currency, "error", errException.Message)
+		return money.Zero(currency)
+	}
+	return converted
+}
+
+// allowances works out the limits of wallet and what is left of each.
+func (s *SpendingLimitServiceImpl) allowances(
+	ctx context.Context, tx *gorm.DB, wallet *entity.Wallet,
+) ([]model.SpendingAllowance, *exception.Exception) {
+	limits, err := s.spendingLimitRepository.FindByWalletId(ctx, tx, wallet.Id)
+	if err != nil {
+		return nil, exception.Internal("failed getting spending limits", err)
+	}
+	if limits == nil {
+		limits = &entity.SpendingLimit{}
+	}
+	currency := wallet.CurrencyCode()
+	now := time.Now()
+	var allowances []model.SpendingAllowance
+	for _, period := range entity.SpendingPeriods {
+		allowance := model.SpendingAllowance{
+			Period: period.Period,
+			Used:   money.Zero(currency),
+		}
+		if period.Window > 0 {
+			used, err := s.transactionRepository.SumOutflowTx(ctx, tx, wallet.Id, now.Add(-period.Window))
+			if err != nil {
+				return nil, exception.Internal("failed getting wallet outflows", err)
+			}
+			allowance.Used = money.New(used, currency)
+		}
+		limit := limits.Limit(period.Period).Normalize()
+		if !limit.IsPositive() {
+			limit = s.defaultLimit(ctx, tx, period.Period, currency)
+			allowance.Default = true
+		}
+		if limit.IsPositive() {
+			remaining := money.New(max(limit.Units-allowance.Used.Units, 0), currency)
+			allowance.Limit = &limit
+			allowance.Remaining = &remaining
+		}
+		allowances = append(allowances, allowance)
+	}
+	return allowances, nil
+}
+
+func (s *SpendingLimitServiceImpl) Check(
+	ctx context.Context, tx *gorm.DB, wallet *entity.Wallet, amount money.Money,
+) *exception.Exception {
+	allowances, errException := s.allowances(ctx, tx, wallet)
+	if errException != nil {
+		return errException
+	}
+	for _, allowance := range allowances {
+		if allowance.Remaining == nil || !allowance.Remaining.LessThan(amount) {
+			continue
+		}
+		if allowance.Period == entity.SpendingLimitPerTransaction {
+			return exception.PermissionDenied("amount exceeds the per transaction limit of " + converter.ToString(*allowance.Limit))
+		}
+		return exception.PermissionDenied(strings.ToUpper(allowance.Period[:1]) + allowance.Period[1:] +
+			" spending limit of " + converter.ToString(*allowance.Limit) + " reached, remaining allowance: " + converter.ToString(*allowance.Remaining))
+	}
+	return nil
+}
+
+func (s *SpendingLimitServiceImpl) Detail(ctx context.Context, req *model.GetSpendingLimitReq) (
+	*model.GetSpendingLimitRes, *exception.Exception,
+) {
+	wallet, err := s.walletRepository.FindByID(ctx, s.db, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, wallet.Id, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	allowances, errException := s.allowances(ctx, s.db, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+
+	return &model.GetSpendingLimitRes{
+		WalletId: wallet.Id,
+		Limits:   allowances,
+	}, nil
+}
+
+func (s *SpendingLimitServiceImpl) Update(ctx context.Context, req *model.UpdateSpendingLimitReq) (
+	*model.UpdateSpendingLimitRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	wallet, err := s.walletRepository.FindByIDForUpdate(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, wallet.Id, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	for period, limit := range req.Limits() {
+		if limit == nil {
+			continue
+		}
+		converted, err := limit.WithCurrency(wallet.CurrencyCode())
+		if err != nil {
+			return nil, exception.InvalidArgument(period + ": " + err.Error())
+		}
+		if !converted.IsPositive() {
+			return nil, exception.InvalidArgument(period + " limit must be greater than zero, leave it out to use the default")
+		}
+		*limit = converted
+	}
+
+	existing, err := s.spendingLimitRepository.FindByWalletId(ctx, tx, wallet.Id)
+	if err != nil {
+		return nil, exception.Internal("failed getting spending limits", err)
+	}
+	if existing == nil {
+		err = s.spendingLimitRepository.CreateTx(ctx, tx, req.ToEntity("", wallet.CurrencyCode()))
+	} else {
+		err = s.spendingLimitRepository.UpdateTx(ctx, tx, req.ToEntity(existing.Id, wallet.CurrencyCode()))
+	}
+	if err != nil {
+		return nil, exception.Internal("failed saving spending limits", err)
+	}
+	allowances, errException := s.allowances(ctx, tx, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.UpdateSpendingLimitRes{
+		GetSpendingLimitRes: model.GetSpendingLimitRes{
+			WalletId: wallet.Id,
+			Limits:   allowances,
+		},
+	}, nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/money"
+	"testing"
+)
+
+func TestSpendingLimitUpdateAuthorization(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	owner, wallet := env.user(t, 0)
+	coOwner := env.member(t, wallet, owner, entity.WalletRoleOwner)
+	spender := env.member(t, wallet, owner, entity.WalletRoleSpender)
+	viewer := env.member(t, wallet, owner, entity.WalletRoleViewer)
+	stranger, _ := env.user(t, 0)
+	tests := []struct {
+		name    string
+		userId  string
+		wantErr bool
+	}{
+		{name: "owner", userId: owner.Id},
+		{name: "another owner", userId: coOwner.Id},
+		{name: "spender", userId: spender.Id, wantErr: true},
+		{name: "viewer", userId: viewer.Id, wantErr: true},
+		{name: "not a member", userId: stranger.Id, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			daily := money.New(500000, "IDR")
+			_, errException := env.spendingLimitService.Update(ctx, &model.UpdateSpendingLimitReq{
+				WalletId: wallet.Id,
+				UserId:   tt.userId,
+				Daily:    &daily,
+			})
+			if (errException != nil) != tt.wantErr {
+				t.Errorf("Update() error = %v, wantErr %v", errException, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSpendingLimitDetailAuthorization(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	owner, wallet := env.user(t, 0)
+	viewer := env.member(t, wallet, owner, entity.WalletRoleViewer)
+	stranger, _ := env.user(t, 0)
+	tests := []struct {
+		name    string
+		userId  string
+		wantErr bool
+	}{
+		{name: "owner", userId: owner.Id},
+		{name: "viewer", userId: viewer.Id},
+		{name: "not a member", userId: stranger.Id, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errException := env.spendingLimitService.Detail(ctx, &model.GetSpendingLimitReq{WalletId: wallet.Id, UserId: tt.userId})
+			if (errException != nil) != tt.wantErr {
+				t.Errorf("Detail() error = %v, wantErr %v", errException, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type StandingOrderService interface {
+	// Standing orders belong to their source wallet, every call is scoped by WalletId
+	Create(ctx context.Context, req *model.CreateStandingOrderReq) (*model.CreateStandingOrderRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllStandingOrderReq) (*model.GetAllStandingOrderRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetStandingOrderByIDReq) (*model.GetStandingOrderByIDRes, *exception.Exception)
+	FindRuns(ctx context.Context, req *model.GetAllStandingOrderRunReq) (*model.GetAllStandingOrderRunRes, *exception.Exception)
+	Pause(ctx context.Context, req *model.PauseStandingOrderReq) (*model.PauseStandingOrderRes, *exception.Exception)
+	Resume(ctx context.Context, req *model.ResumeStandingOrderReq) (*model.ResumeStandingOrderRes, *exception.Exception)
+	Cancel(ctx context.Context, req *model.CancelStandingOrderReq) (*model.CancelStandingOrderRes, *exception.Exception)
+
+	// RunDue executes the standing orders whose next run has passed and returns how many ran
+	RunDue(ctx context.Context) (int, *exception.Exception)
+}
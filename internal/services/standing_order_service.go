@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/schedule"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+type StandingOrderServiceImpl struct {
+	db                         *gorm.DB
+	standingOrderRepository    repository.StandingOrderRepository
+	standingOrderRunRepository repository.StandingOrderRunRepository
+	walletRepository           repository.WalletRepository
+	memberRepository           repository.WalletMemberRepository
+	transactionService         TransactionService
+	validate                   *xvalidator.Validator
+	batchSize                  int
+	maxRetries                 int
+	retryDelay                 time.Duration
+}
+
+func NewStandingOrderService(
+	db *gorm.DB,
+	repo repository.StandingOrderRepository,
+	runRepository repository.StandingOrderRunRepository,
+	walletRepository repository.WalletRepository,
+	memberRepository repository.WalletMemberRepository,
+	transactionService TransactionService,
+	validate *xvalidator.Validator,
+	batchSize, maxRetries int,
+	retryDelay time.Duration,
+) StandingOrderService {
+	return &StandingOrderServiceImpl{
+		db:                         db,
+		standingOrderRepository:    repo,
+		standingOrderRunRepository: runRepository,
+		walletRepository:           walletRepository,
+		memberRepository:           memberRepository,
+		transactionService:         transactionService,
+		validate:                   validate,
+		batchSize:                  batchSize,
+		maxRetries:                 maxRetries,
+		retryDelay:                 retryDelay,
+	}
+}
+
+// nextRun is the first run of order after t, nil once the schedule is over.
+func nextRun(order *entity.StandingOrder, t time.Time) (*time.Time, error) {
+	sched, err := schedule.Parse(order.Schedule, order.StartAt)
+	if err != nil {
+		return nil, err
+	}
+	next := sched.Next(t)
+	if next.IsZero() || (order.EndAt != nil && next.After(*order.EndAt)) {
+		return nil, nil
+	}
+	return &next, nil
+}
+
+func (s *StandingOrderServiceImpl) Create(
+	ctx context.Context, req *model.CreateStandingOrderReq,
+) (*model.CreateStandingOrderRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, exception.InvalidArgument("amount must be greater than zero")
+	}
+	sender, err := s.walletRepository.FindByID(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if sender == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	// checked now rather than on the first run, which would only fail
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, sender.Id, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	receiver, err := s.walletRepository.FindByID(ctx, tx, req.ReceiverId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if receiver == nil {
+		return nil, exception.NotFound("receiver wallet detail not found")
+	}
+	if receiver.Id == sender.Id {
+		return nil, exception.InvalidArgument("receiver must be another wallet")
+	}
+	if req.Amount.Currency == "" {
+		req.Amount, err = req.Amount.WithCurrency(sender.CurrencyCode())
+		if err != nil {
+			return nil, exception.InvalidArgument(err.Error())
+		}
+	}
+	req.Amount = req.Amount.Normalize()
+	if req.Amount.Currency != sender.CurrencyCode() && req.Amount.Currency != receiver.CurrencyCode() {
+		return nil, exception.InvalidArgument("amount must be in the currency of the sender or the receiver wallet")
+	}
+
+	now := time.Now()
+	startAt := now
+	if req.StartAt != nil {
+		startAt = *req.StartAt
+	}
+	if req.EndAt != nil && req.EndAt.Before(startAt) {
+		return nil, exception.InvalidArgument("end_at must be after start_at")
+	}
+	body := req.ToEntity(startAt)
+	next, err := nextRun(body, now.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	if next == nil {
+		return nil, exception.InvalidArgument("schedule has no run left before end_at")
+	}
+	body.NextRunAt = next
+	if err := s.standingOrderRepository.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("failed creating standing order", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CreateStandingOrderRes{
+		StandingOrder: *body,
+	}, nil
+}
+
+// find returns the standing order id of walletId once userId is checked to have role
+// on the wallet, locked for update when lock is set.
+func (s *StandingOrderServiceImpl) find(
+	ctx context.Context, tx *gorm.DB, walletId, userId, role, id string, lock bool,
+) (*entity.StandingOrder, *exception.Exception) {
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, walletId, userId, role); errException != nil {
+		return nil, errException
+	}
+	find := s.standingOrderRepository.FindByID
+	if lock {
+		find = s.standingOrderRepository.FindByIDForUpdate
+	}
+	order, err := find(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting standing order", err)
+	}
+	if order == nil || order.WalletId != walletId {
+		return nil, exception.NotFound("standing order not found")
+	}
+	return order, nil
+}
+
+func (s *StandingOrderServiceImpl) Find(ctx context.Context, req *model.GetAllStandingOrderReq) (
+	*model.GetAllStandingOrderRes, *exception.Exception,
+) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "wallet_id",
+		Value:    req.WalletId,
+		Operator: "=",
+	})
+	result, err := s.standingOrderRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllStandingOrderRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *StandingOrderServiceImpl) Detail(ctx context.Context, req *model.GetStandingOrderByIDReq) (
+	*model.GetStandingOrderByIDRes, *exception.Exception,
+) {
+	order, errException := s.find(ctx, s.db, req.WalletId, req.UserId, entity.WalletRoleViewer, req.ID, false)
+	if errException != nil {
+		return nil, errException
+	}
+
+	return &model.GetStandingOrderByIDRes{
+		StandingOrder: *order,
+	}, nil
+}
+
+func (s *StandingOrderServiceImpl) FindRuns(ctx context.Context, req *model.GetAllStandingOrderRunReq) (
+	*model.GetAllStandingOrderRunRes, *exception.Exception,
+) {
+	if _, errException := s.find(ctx, s.db, req.WalletId, req.UserId, entity.WalletRoleViewer, req.ID, false); errException != nil {
+		return nil, errException
+	}
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "standing_order_id",
+		Value:    req.ID,
+		Operator: "=",
+	})
+	result, err := s.standingOrderRunRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllStandingOrderRunRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *StandingOrderServiceImpl) Pause(ctx context.Context, req *model.PauseStandingOrderReq) (
+	*model.PauseStandingOrderRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	order, errException := s.find(ctx, tx, req.WalletId, req.UserId, entity.WalletRoleOwner, req.ID, true)
+	if errException != nil {
+		return nil, errException
+	}
+	if order.Status != entity.StandingOrderStatusActive {
+		return nil, exception.PermissionDenied("standing order is " + order.Status)
+	}
+	order.Status = entity.StandingOrderStatusPaused
+	if err := s.standingOrderRepository.UpdateTx(ctx, tx, order); err != nil {
+		return nil, exception.Internal("failed updating standing order", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.PauseStandingOrderRes{
+		StandingOrder: *order,
+	}, nil
+}
+
+func (s *StandingOrderServiceImpl) Resume(ctx context.Context, req *model.ResumeStandingOrderReq) (
+	*model.ResumeStandingOrderRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	order, errException := s.find(ctx, tx, req.WalletId, req.UserId, entity.WalletRoleOwner, req.ID, true)
+	if errException != nil {
+		return nil, errException
+	}
+	if order.Status != entity.StandingOrderStatusPaused {
+		return nil, exception.PermissionDenied("standing order is " + order.Status)
+	}
+	next, err := nextRun(order, time.Now())
+	if err != nil {
+		return nil, exception.Internal("failed parsing schedule", err)
+	}
+	if next == nil {
+		return nil, exception.PermissionDenied("standing order has no run left before its end")
+	}
+	order.Status = entity.StandingOrderStatusActive
+	order.NextRunAt = next
+	order.Attempts = 0
+	if err := s.standingOrderRepository.UpdateTx(ctx, tx, order); err != nil {
+		return nil, exception.Internal("failed updating standing order", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.ResumeStandingOrderRes{
+		StandingOrder: *order,
+	}, nil
+}
+
+func (s *StandingOrderServiceImpl) Cancel(ctx context.Context, req *model.CancelStandingOrderReq) (
+	*model.CancelStandingOrderRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	order, errException := s.find(ctx, tx, req.WalletId, req.UserId, entity.WalletRoleOwner, req.ID, true)
+	if errException != nil {
+		return nil, errException
+	}
+	if order.Status != entity.StandingOrderStatusActive && order.Status != entity.StandingOrderStatusPaused {
+		return nil, exception.PermissionDenied("standing order is already " + order.Status)
+	}
+	order.Status = entity.StandingOrderStatusCancelled
+	order.NextRunAt = nil
+	if err := s.standingOrderRepository.UpdateTx(ctx, tx, order); err != nil {
+		return nil, exception.Internal("failed updating standing order", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.CancelStandingOrderRes{
+		StandingOrder: *order,
+	}, nil
+}
+
+func (s *StandingOrderServiceImpl) RunDue(ctx context.Context) (int, *exception.Exception) {
+	due, err := s.standingOrderRepository.FindDue(ctx, s.db, time.Now(), s.batchSize)
+	if err != nil {
+		return 0, exception.Internal("failed getting due standing orders", err)
+	}
+	ran := 0
+	for _, order := range *due {
+		claimed, errException := s.claim(ctx, order.Id)
+		if errException != nil {
+			slog.Error("failed to claim standing order", "id", order.Id, "error", errException.Message)
+			continue
+		}
+		if claimed == nil {
+			continue
+		}
+		transfer, failure := s.transactionService.Transfer(ctx, model.ToStandingOrderTransferReq(*claimed))
+		if errException := s.record(ctx, claimed.Id, transfer, failure); errException != nil {
+			slog.Error("failed to record standing order run", "id", order.Id, "error", errException.Message)
+			continue
+		}
+		ran++
+	}
+	return ran, nil
+}
+
+// claim moves a due standing order on to its next run before it is executed, so
+// another scheduler cannot pick the same run and a crash skips the run rather
+// than repeating the transfer. It returns nil when the order is no longer due.
+func (s *StandingOrderServiceImpl) claim(ctx context.Context, id string) (*entity.StandingOrder, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	order, err := s.standingOrderRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting standing order", err)
+	}
+	now := time.Now()
+	if order == nil || order.Status != entity.StandingOrderStatusActive || order.NextRunAt == nil || order.NextRunAt.After(now) {
+		return nil, nil
+	}
+	next, err := nextRun(order, now)
+	if err != nil {
+		return nil, exception.Internal("failed parsing schedule", err)
+	}
+	order.NextRunAt = next
+	if next == nil {
+		order.Status = entity.StandingOrderStatusCompleted
+	}
+	order.LastRunAt = &now
+	if err := s.standingOrderRepository.UpdateTx(ctx, tx, order); err != nil {
+		return nil, exception.Internal("failed updating standing order", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return order, nil
+}
+
+// record stores the outcome of a run. A run failing for lack of balance is
+// retried after the retry delay, up to the retry limit and as long as the retry
+// comes before the next scheduled run.
+func (s *StandingOrderServiceImpl) record(
+	ctx context.Context, id string, transfer *model.TransferTransactionRes, failure *exception.Exception,
+) *exception.Exception {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	order, err := s.standingOrderRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return exception.Internal("failed getting standing order", err)
+	}
+	if order == nil {
+		return exception.NotFound("standing order not found")
+	}
+	attempt := order.Attempts + 1
+	var run *entity.StandingOrderRun
+	if failure == nil {
+		run = model.NewStandingOrderRun(*order, attempt, &transfer.SenderTransaction.Id, "")
+		order.Attempts = 0
+	} else {
+		run = model.NewStandingOrderRun(*order, attempt, nil, fmt.Sprint(failure.Message))
+		retryAt := time.Now().Add(s.retryDelay)
+		// a paused or cancelled order stays as its owner left it
+		retry := errors.Is(failure.Error, ErrInsufficientBalance) &&
+			(order.Status == entity.StandingOrderStatusActive || order.Status == entity.StandingOrderStatusCompleted) &&
+			schedule.Retry(attempt, s.maxRetries, retryAt, order.NextRunAt, order.EndAt)
+		order.Attempts = 0
+		if retry {
+			order.Attempts = attempt
+			order.NextRunAt = &retryAt
+			order.Status = entity.StandingOrderStatusActive
+		}
+	}
+	if err := s.standingOrderRunRepository.CreateTx(ctx, tx, run); err != nil {
+		return exception.Internal("failed creating standing order run", err)
+	}
+	if err := s.standingOrderRepository.UpdateTx(ctx, tx, order); err != nil {
+		return exception.Internal("failed updating standing order", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return exception.Internal("commit transaction", err)
+	}
+	return nil
+}
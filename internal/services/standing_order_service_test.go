@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"testing"
+	"time"
+)
+
+func TestStandingOrderRunDue(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	orders := repository.NewStandingOrderSQLRepository()
+	runs := repository.NewStandingOrderRunSQLRepository()
+	service := NewStandingOrderService(
+		env.db, orders, runs, env.walletRepository, env.memberRepository, env.transactionService, env.validate,
+		100, 2, time.Minute,
+	)
+	owner, sender := env.user(t, 0)
+	_, receiver := env.user(t, 0)
+	created, errException := service.Create(ctx, &model.CreateStandingOrderReq{
+		UserId:     owner.Id,
+		WalletId:   sender.Id,
+		ReceiverId: receiver.Id,
+		Amount:     money.New(1000, "IDR"),
+		Schedule:   "daily",
+	})
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+	order := created.StandingOrder
+
+	// due moves the order's next run to the past, as the scheduler would find it
+	due := func(ago time.Duration) {
+		t.Helper()
+		at := time.Now().Add(-ago)
+		if err := env.db.Model(&entity.StandingOrder{}).Where("id = ?", order.Id).Update("next_run_at", at).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	reload := func() entity.StandingOrder {
+		t.Helper()
+		found, err := orders.FindByID(ctx, env.db, order.Id)
+		if err != nil || found == nil {
+			t.Fatalf("standing order not found: %v", err)
+		}
+		return *found
+	}
+	runDue := func() {
+		t.Helper()
+		if _, errException := service.RunDue(ctx); errException != nil {
+			t.Fatal(errException.Message)
+		}
+	}
+	countRuns := func(status string) int64 {
+		t.Helper()
+		var count int64
+		env.db.Model(&entity.StandingOrderRun{}).Where("standing_order_id = ? AND status = ?", order.Id, status).Count(&count)
+		return count
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		wantAttempts int
+		wantRetry    bool // the next run is the retry delay away rather than a day
+		wantFailed   int64
+		wantDone     int64
+	}{
+		{
+			name:         "a run without balance is retried",
+			setup:        func() { due(time.Minute) },
+			wantAttempts: 1,
+			wantRetry:    true,
+			wantFailed:   1,
+		},
+		{
+			name:         "a claimed retry is not run again before it is due",
+			setup:        func() {},
+			wantAttempts: 1,
+			wantRetry:    true,
+			wantFailed:   1,
+		},
+		{
+			name:         "the second retry is the last one",
+			setup:        func() { due(time.Minute) },
+			wantAttempts: 2,
+			wantRetry:    true,
+			wantFailed:   2,
+		},
+		{
+			name:         "retries exhausted fall back to the schedule",
+			setup:        func() { due(time.Minute) },
+			wantAttempts: 0,
+			wantFailed:   3,
+		},
+		{
+			name: "a late run transfers once and skips the runs it missed",
+			setup: func() {
+				if _, errException := env.transactionService.Credit(ctx, &model.CreditTransactionReq{
+					UserId: owner.Id, WalletId: sender.Id, Amount: money.New(5000, "IDR"),
+				}); errException != nil {
+					t.Fatal(errException.Message)
+				}
+				due(72 * time.Hour)
+			},
+			wantAttempts: 0,
+			wantFailed:   3,
+			wantDone:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			runDue()
+			got := reload()
+			if got.Attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got.Attempts, tt.wantAttempts)
+			}
+			if got.Status != entity.StandingOrderStatusActive || got.NextRunAt == nil {
+				t.Fatalf("order is %s with next run %v, want it active and scheduled", got.Status, got.NextRunAt)
+			}
+			wait := time.Until(*got.NextRunAt)
+			if wait <= 0 {
+				t.Errorf("next run %s is not in the future", got.NextRunAt)
+			}
+			if retried := wait <= time.Minute; retried != tt.wantRetry {
+				t.Errorf("next run in %s, want retry %v", wait, tt.wantRetry)
+			}
+			if failed := countRuns(entity.StandingOrderRunFailed); failed != tt.wantFailed {
+				t.Errorf("failed runs = %d, want %d", failed, tt.wantFailed)
+			}
+			if done := countRuns(entity.StandingOrderRunSucceeded); done != tt.wantDone {
+				t.Errorf("succeeded runs = %d, want %d", done, tt.wantDone)
+			}
+		})
+	}
+	if balance := env.wallet(t, receiver.Id).Balance.Units; balance != 1000 {
+		t.Errorf("receiver balance = %d, want a single transfer of 1000", balance)
+	}
+}
+
+func TestStandingOrderCreateAuthorization(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	service := NewStandingOrderService(
+		env.db, repository.NewStandingOrderSQLRepository(), repository.NewStandingOrderRunSQLRepository(),
+		env.walletRepository, env.memberRepository, env.transactionService, env.validate, 100, 2, time.Minute,
+	)
+	owner, sender := env.user(t, 0)
+	spender := env.member(t, sender, owner, entity.WalletRoleSpender)
+	stranger, receiver := env.user(t, 0)
+	tests := []struct {
+		name     string
+		userId   string
+		receiver string
+		wantErr  bool
+	}{
+		{name: "owner", userId: owner.Id, receiver: receiver.Id},
+		{name: "spender", userId: spender.Id, receiver: receiver.Id, wantErr: true},
+		{name: "not a member of the sender", userId: stranger.Id, receiver: receiver.Id, wantErr: true},
+		{name: "same wallet", userId: owner.Id, receiver: sender.Id, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errException := service.Create(ctx, &model.CreateStandingOrderReq{
+				UserId:     tt.userId,
+				WalletId:   sender.Id,
+				ReceiverId: tt.receiver,
+				Amount:     money.New(1000, "IDR"),
+				Schedule:   "monthly",
+			})
+			if (errException != nil) != tt.wantErr {
+				t.Errorf("Create() error = %v, wantErr %v", errException, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStandingOrderAuthorization(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	service := NewStandingOrderService(
+		env.db, repository.NewStandingOrderSQLRepository(), repository.NewStandingOrderRunSQLRepository(),
+		env.walletRepository, env.memberRepository, env.transactionService, env.validate, 100, 2, time.Minute,
+	)
+	owner, sender := env.user(t, 0)
+	spender := env.member(t, sender, owner, entity.WalletRoleSpender)
+	viewer := env.member(t, sender, owner, entity.WalletRoleViewer)
+	stranger, receiver := env.user(t, 0)
+	created, errException := service.Create(ctx, &model.CreateStandingOrderReq{
+		UserId:     owner.Id,
+		WalletId:   sender.Id,
+		ReceiverId: receiver.Id,
+		Amount:     money.New(1000, "IDR"),
+		Schedule:   "monthly",
+	})
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+	id := created.Id
+	page := model.PaginationParam{Page: 1, PageSize: 10}
+
+	reads := map[string]func(userId string) *exception.Exception{
+		"Find": func(userId string) *exception.Exception {
+			_, errException := service.Find(ctx, &model.GetAllStandingOrderReq{WalletId: sender.Id, UserId: userId, Page: page})
+			return errException
+		},
+		"Detail": func(userId string) *exception.Exception {
+			_, errException := service.Detail(ctx, &model.GetStandingOrderByIDReq{WalletId: sender.Id, UserId: userId, ID: id})
+			return errException
+		},
+		"FindRuns": func(userId string) *exception.Exception {
+			_, errException := service.FindRuns(ctx, &model.GetAllStandingOrderRunReq{WalletId: sender.Id, UserId: userId, ID: id, Page: page})
+			return errException
+		},
+	}
+	// pausing then resuming leaves the order active for the next user
+	writes := []struct {
+		method string
+		call   func(userId string) *exception.Exception
+	}{
+		{"Pause", func(userId string) *exception.Exception {
+			_, errException := service.Pause(ctx, &model.PauseStandingOrderReq{WalletId: sender.Id, UserId: userId, ID: id})
+			return errException
+		}},
+		{"Resume", func(userId string) *exception.Exception {
+			_, errException := service.Resume(ctx, &model.ResumeStandingOrderReq{WalletId: sender.Id, UserId: userId, ID: id})
+			return errException
+		}},
+	}
+
+	tests := []struct {
+		name         string
+		userId       string
+		wantReadErr  bool
+		wantWriteErr bool
+	}{
+		{name: "not a member", userId: stranger.Id, wantReadErr: true, wantWriteErr: true},
+		{name: "viewer", userId: viewer.Id, wantWriteErr: true},
+		{name: "spender", userId: spender.Id, wantWriteErr: true},
+		{name: "owner", userId: owner.Id},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for method, read := range reads {
+				if errException := read(tt.userId); (errException != nil) != tt.wantReadErr {
+					t.Errorf("%s() error = %v, wantErr %v", method, errException, tt.wantReadErr)
+				}
+			}
+			for _, write := range writes {
+				if errException := write.call(tt.userId); (errException != nil) != tt.wantWriteErr {
+					t.Errorf("%s() error = %v, wantErr %v", write.method, errException, tt.wantWriteErr)
+				}
+			}
+			_, errException := service.Cancel(ctx, &model.CancelStandingOrderReq{WalletId: sender.Id, UserId: tt.userId, ID: id})
+			if (errException != nil) != tt.wantWriteErr {
+				t.Errorf("Cancel() error = %v, wantErr %v", errException, tt.wantWriteErr)
+			}
+		})
+	}
+}
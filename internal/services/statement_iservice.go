@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type StatementService interface {
+	// Generate returns the statement of a closed month, building it on first request
+	Generate(ctx context.Context, req *model.GenerateStatementReq) (*model.GenerateStatementRes, *exception.Exception)
+	// Regenerate rebuilds a statement from the current transactions, owners only
+	Regenerate(ctx context.Context, req *model.RegenerateStatementReq) (*model.RegenerateStatementRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllStatementReq) (*model.GetAllStatementRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetStatementByIDReq) (*model.GetStatementByIDRes, *exception.Exception)
+
+	// CloseMonth generates the statements of the month just closed for the wallets
+	// that have none yet and returns how many were generated
+	CloseMonth(ctx context.Context) (int, *exception.Exception)
+}
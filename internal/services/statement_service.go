@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/xvalidator"
+	"sort"
+	"time"
+)
+
+type StatementServiceImpl struct {
+	db                    *gorm.DB
+	statementRepository   repository.StatementRepository
+	walletRepository      repository.WalletRepository
+	memberRepository      repository.WalletMemberRepository
+	transactionRepository repository.TransactionRepository
+	validate              *xvalidator.Validator
+	batchSize             int
+}
+
+func NewStatementService(
+	db *gorm.DB,
+	repo repository.StatementRepository,
+	walletRepository repository.WalletRepository,
+	memberRepository repository.WalletMemberRepository,
+	transactionRepository repository.TransactionRepository,
+	validate *xvalidator.Validator,
+	batchSize int,
+) StatementService {
+	return &StatementServiceImpl{
+		db:                    db,
+		statementRepository:   repo,
+		walletRepository:      walletRepository,
+		memberRepository:      memberRepository,
+		transactionRepository: transactionRepository,
+		validate:              validate,
+		batchSize:             batchSize,
+	}
+}
+
+// build works out the balances and lines of statement from the transactions of wallet.
+func (s *StatementServiceImpl) build(
+	ctx context.Context, tx *gorm.DB, wallet *entity.Wallet, statement *entity.Statement,
+) ([]entity.StatementLine, *exception.Exception) {
+	currency := wallet.CurrencyCode()
+	opening, err := s.transactionRepository.SumBalanceTx(ctx, tx, wallet.Id, statement.PeriodStart)
+	if err != nil {
+		return nil, exception.Internal("failed getting opening balance", err)
+	}
+	balance := money.New(opening, currency)
+	credits, debits := money.Zero(currency), money.Zero(currency)
+	var lines []entity.StatementLine
+	err = s.transactionRepository.StreamByWallet(ctx, tx, wallet.Id, statement.PeriodStart, statement.PeriodEnd,
+		func(transaction *entity.Transaction) error {
+			amount := money.New(transaction.SignedAmount().Units, currency)
+			balance.Units += amount.Units
+			if amount.IsNegative() {
+				debits.Units -= amount.Units
+			} else {
+				credits.Units += amount.Units
+			}
+			line := entity.StatementLine{
+				Id:            uuid.NewString(),
+				StatementId:   statement.Id,
+				Position:      len(lines) + 1,
+				TransactionId: transaction.Id,
+				Type:          transaction.Type,
+				Description:   transaction.Description,
+				Amount:        amount,
+				Balance:       balance,
+			}
+			if transaction.TransactionTime != nil {
+				line.TransactionTime = *transaction.TransactionTime
+			}
+			lines = append(lines, line)
+			return nil
+		})
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet transactions", err)
+	}
+	statement.OpeningBalance = money.New(opening, currency)
+	statement.TotalCredits = credits
+	statement.TotalDebits = debits
+	statement.ClosingBalance = balance
+	statement.TransactionCount = len(lines)
+	statement.GeneratedAt = time.Now()
+	return lines, nil
+}
+
+func (s *StatementServiceImpl) Generate(
+	ctx context.Context, req *model.GenerateStatementReq,
+) (*model.GenerateStatementRes, *exception.Exception) {
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	return s.generate(ctx, req)
+}
+
+// generate builds the statement of req.Period for req.WalletId, on behalf of a
+// member already authorized or of the month end close.
+func (s *StatementServiceImpl) generate(
+	ctx context.Context, req *model.GenerateStatementReq,
+) (*model.GenerateStatementRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	start, err := time.Parse(entity.StatementPeriodLayout, req.Period)
+	if err != nil {
+		return nil, exception.InvalidArgument("period must be a month formatted as YYYY-MM")
+	}
+	if start.AddDate(0, 1, 0).After(time.Now()) {
+		return nil, exception.PermissionDenied("period " + req.Period + " is not closed yet")
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	existing, err := s.statementRepository.FindByPeriod(ctx, tx, wallet.Id, req.Period)
+	if err != nil {
+		return nil, exception.Internal("failed getting statement", err)
+	}
+	if existing != nil {
+		statement, errException := s.detail(ctx, tx, wallet.Id, existing.Id)
+		if errException != nil {
+			return nil, errException
+		}
+		return &model.GenerateStatementRes{
+			Statement: *statement,
+		}, nil
+	}
+
+	body := req.ToEntity(start)
+	lines, errException := s.build(ctx, tx, wallet, body)
+	if errException != nil {
+		return nil, errException
+	}
+	if err := s.statementRepository.CreateTx(ctx, tx, body); err != nil {
+		return nil, exception.Internal("failed creating statement", err)
+	}
+	if err := s.statementRepository.ReplaceLinesTx(ctx, tx, body.Id, lines); err != nil {
+		return nil, exception.Internal("failed creating statement lines", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	body.Lines = lines
+	return &model.GenerateStatementRes{
+		Statement: *body,
+	}, nil
+}
+
+func (s *StatementServiceImpl) Regenerate(
+	ctx context.Context, req *model.RegenerateStatementReq,
+) (*model.RegenerateStatementRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	statement, err := s.statementRepository.FindByIDForUpdate(ctx, tx, req.ID)
+	if err != nil {
+		return nil, exception.Internal("failed getting statement", err)
+	}
+	if statement == nil || statement.WalletId != req.WalletId {
+		return nil, exception.NotFound("statement not found")
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, statement.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+
+	lines, errException := s.build(ctx, tx, wallet, statement)
+	if errException != nil {
+		return nil, errException
+	}
+	statement.Version++
+	if err := s.statementRepository.UpdateTx(ctx, tx, statement); err != nil {
+		return nil, exception.Internal("failed updating statement", err)
+	}
+	if err := s.statementRepository.ReplaceLinesTx(ctx, tx, statement.Id, lines); err != nil {
+		return nil, exception.Internal("failed updating statement lines", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	statement.Lines = lines
+	return &model.RegenerateStatementRes{
+		Statement: *statement,
+	}, nil
+}
+
+func (s *StatementServiceImpl) Find(ctx context.Context, req *model.GetAllStatementReq) (
+	*model.GetAllStatementRes, *exception.Exception,
+) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "wallet_id",
+		Value:    req.WalletId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "period",
+		}
+	}
+	result, err := s.statementRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllStatementRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *StatementServiceImpl) Detail(ctx context.Context, req *model.GetStatementByIDReq) (
+	*model.GetStatementByIDRes, *exception.Exception,
+) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	result, errException := s.detail(ctx, s.db, req.WalletId, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+
+	return &model.GetStatementByIDRes{
+		Statement: *result,
+	}, nil
+}
+
+// detail finds a statement of walletId with its lines in order.
+func (s *StatementServiceImpl) detail(
+	ctx context.Context, tx *gorm.DB, walletId, id string,
+) (*entity.Statement, *exception.Exception) {
+	result, err := s.statementRepository.FindByID(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil || result.WalletId != walletId {
+		return nil, exception.NotFound("statement not found")
+	}
+	sort.Slice(result.Lines, func(i, j int) bool {
+		return result.Lines[i].Position < result.Lines[j].Position
+	})
+	return result, nil
+}
+
+func (s *StatementServiceImpl) CloseMonth(ctx context.Context) (int, *exception.Exception) {
+	period := time.Now().UTC().AddDate(0, -1, 0).Format(entity.StatementPeriodLayout)
+	generated := 0
+	afterId := ""
+	for {
+		walletIds, err := s.statementRepository.FindWalletsWithout(ctx, s.db, period, afterId, s.batchSize)
+		if err != nil {
+			return generated, exception.Internal("failed getting wallets without statement", err)
+		}
+		if len(walletIds) == 0 {
+			return generated, nil
+		}
+		for _, walletId := range walletIds {
+			if _, errException := s.generate(ctx, &model.GenerateStatementReq{WalletId: walletId, Period: period}); errException != nil {
+				// a failing wallet is passed over and retried by the next run
+				slog.Error("failed to generate statement", "wallet_id", walletId, "period", period, "error", errException.Message)
+				continue
+			}
+			generated++
+		}
+		afterId = walletIds[len(walletIds)-1]
+	}
+}
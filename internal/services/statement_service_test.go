@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"testing"
+	"time"
+)
+
+func TestStatementAuthorization(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	service := NewStatementService(
+		env.db, repository.NewStatementSQLRepository(), env.walletRepository, env.memberRepository,
+		repository.NewTransactionSQLRepository(), env.validate, 10,
+	)
+	owner, wallet := env.user(t, 10000)
+	viewer := env.member(t, wallet, owner, entity.WalletRoleViewer)
+	stranger, _ := env.user(t, 0)
+	period := time.Now().UTC().AddDate(0, -2, 0).Format(entity.StatementPeriodLayout)
+	generated, errException := service.Generate(ctx, &model.GenerateStatementReq{
+		WalletId: wallet.Id, UserId: owner.Id, Period: period,
+	})
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+	id := generated.Id
+
+	tests := []struct {
+		name     string
+		userId   string
+		wantErr  bool
+		readOnly bool // may read statements but not rebuild them
+	}{
+		{name: "owner", userId: owner.Id},
+		{name: "viewer", userId: viewer.Id, readOnly: true},
+		{name: "not a member", userId: stranger.Id, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := map[string]func() *exception.Exception{
+				"Generate": func() *exception.Exception {
+					_, errException := service.Generate(ctx, &model.GenerateStatementReq{WalletId: wallet.Id, UserId: tt.userId, Period: period})
+					return errException
+				},
+				"Regenerate": func() *exception.Exception {
+					_, errException := service.Regenerate(ctx, &model.RegenerateStatementReq{WalletId: wallet.Id, UserId: tt.userId, ID: id})
+					return errException
+				},
+				"Find": func() *exception.Exception {
+					_, errException := service.Find(ctx, &model.GetAllStatementReq{WalletId: wallet.Id, UserId: tt.userId, Page: model.PaginationParam{Page: 1, PageSize: 10}})
+					return errException
+				},
+				"Detail": func() *exception.Exception {
+					_, errException := service.Detail(ctx, &model.GetStatementByIDReq{WalletId: wallet.Id, UserId: tt.userId, ID: id})
+					return errException
+				},
+			}
+			for method, call := range calls {
+				wantErr := tt.wantErr || (tt.readOnly && method == "Regenerate")
+				if errException := call(); (errException != nil) != wantErr {
+					t.Errorf("%s() error = %v, wantErr %v", method, errException, wantErr)
+				}
+			}
+		})
+	}
+}
+
+// failingStatementRepository cannot store the statement of one wallet.
+type failingStatementRepository struct {
+	repository.StatementRepository
+	walletId string
+}
+
+func (r *failingStatementRepository) CreateTx(ctx context.Context, tx *gorm.DB, data *entity.Statement) error {
+	if data.WalletId == r.walletId {
+		return errors.New("statement storage failed")
+	}
+	return r.StatementRepository.CreateTx(ctx, tx, data)
+}
+
+func TestCloseMonthSkipsFailingWallet(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	_, first := env.user(t, 1000)
+	_, second := env.user(t, 1000)
+	if second.Id < first.Id {
+		first, second = second, first
+	}
+	statements := repository.NewStatementSQLRepository()
+	service := NewStatementService(
+		env.db, &failingStatementRepository{StatementRepository: statements, walletId: first.Id},
+		env.walletRepository, env.memberRepository, repository.NewTransactionSQLRepository(), env.validate, 1,
+	)
+	if _, errException := service.CloseMonth(ctx); errException != nil {
+		t.Fatal(errException.Message)
+	}
+	period := time.Now().UTC().AddDate(0, -1, 0).Format(entity.StatementPeriodLayout)
+	if statement, err := statements.FindByPeriod(ctx, env.db, first.Id, period); err != nil || statement != nil {
+		t.Errorf("failing wallet: statement = %v, err = %v, want none", statement, err)
+	}
+	if statement, err := statements.FindByPeriod(ctx, env.db, second.Id, period); err != nil || statement == nil {
+		t.Errorf("wallet after the failing one: statement = %v, err = %v, want one", statement, err)
+	}
+}
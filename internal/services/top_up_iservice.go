@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	"product-wallet/internal/model"
+	"product-wallet/pkg/exception"
+)
+
+type TopUpService interface {
+	// A top up is paid at the provider's checkout and settled by the provider's webhook
+	Create(ctx context.Context, req *model.CreateTopUpReq) (*model.CreateTopUpRes, *exception.Exception)
+	HandleWebhook(ctx context.Context, req *model.TopUpWebhookReq) (*model.TopUpWebhookRes, *exception.Exception)
+	Find(ctx context.Context, req *model.GetAllTopUpReq) (*model.GetAllTopUpRes, *exception.Exception)
+	Detail(ctx context.Context, req *model.GetTopUpByIDReq) (*model.GetTopUpByIDRes, *exception.Exception)
+
+	// Expire closes the pending top ups past their expiry and returns how many were closed
+	Expire(ctx context.Context) (int64, *exception.Exception)
+}
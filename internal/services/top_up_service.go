@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"log/slog"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/gateway/externalapi"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/exception"
+	"product-wallet/pkg/xvalidator"
+	"time"
+)
+
+type TopUpServiceImpl struct {
+	db                 *gorm.DB
+	topUpRepository    repository.TopUpRepository
+	walletRepository   repository.WalletRepository
+	memberRepository   repository.WalletMemberRepository
+	transactionService TransactionService
+	paymentProvider    externalapi.PaymentProvider
+	validate           *xvalidator.Validator
+	ttl                time.Duration
+}
+
+func NewTopUpService(
+	db *gorm.DB,
+	repo repository.TopUpRepository,
+	walletRepository repository.WalletRepository,
+	memberRepository repository.WalletMemberRepository,
+	transactionService TransactionService,
+	paymentProvider externalapi.PaymentProvider,
+	validate *xvalidator.Validator,
+	ttl time.Duration,
+) TopUpService {
+	return &TopUpServiceImpl{
+		db:                 db,
+		topUpRepository:    repo,
+		walletRepository:   walletRepository,
+		memberRepository:   memberRepository,
+		transactionService: transactionService,
+		paymentProvider:    paymentProvider,
+		validate:           validate,
+		ttl:                ttl,
+	}
+}
+
+// Create records a pending top up and opens a checkout for it at the provider.
+// The top up is stored before the provider is called so a webhook can never
+// arrive for a checkout we do not know, if the provider fails it is marked failed.
+func (s *TopUpServiceImpl) Create(
+	ctx context.Context, req *model.CreateTopUpReq,
+) (*model.CreateTopUpRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, exception.InvalidArgument("amount must be greater than zero")
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, wallet.Id, req.UserId, entity.WalletRoleSpender); errException != nil {
+		return nil, errException
+	}
+	if errException := checkCredit(wallet); errException != nil {
+		return nil, errException
+	}
+	req.Amount, err = req.Amount.WithCurrency(wallet.CurrencyCode())
+	if err != nil {
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	req.Amount = req.Amount.Normalize()
+
+	topUp := req.ToEntity(s.paymentProvider.Name(), time.Now().Add(s.ttl))
+	if err := s.topUpRepository.CreateTx(ctx, tx, topUp); err != nil {
+		return nil, exception.Internal("failed creating top up", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+
+	checkout, err := s.paymentProvider.CreateCheckout(ctx, externalapi.CheckoutReq{
+		OrderId:     topUp.Id,
+		Amount:      topUp.Amount,
+		Description: "Top up of wallet " + wallet.Name,
+		ExpiresAt:   topUp.ExpiresAt,
+	})
+	if err != nil {
+		topUp.Status = entity.TopUpStatusFailed
+		topUp.FailureReason = err.Error()
+		if err := s.topUpRepository.UpdateTx(ctx, s.db, topUp); err != nil {
+			slog.Error("failed marking top up as failed", "top_up_id", topUp.Id, "error", err)
+		}
+		return nil, exception.Internal("failed creating checkout", err)
+	}
+	topUp.CheckoutReference = &checkout.Reference
+	topUp.CheckoutURL = checkout.URL
+	if err := s.topUpRepository.UpdateTx(ctx, s.db, topUp); err != nil {
+		return nil, exception.Internal("failed updating top up", err)
+	}
+	return &model.CreateTopUpRes{
+		TopUp: *topUp,
+	}, nil
+}
+
+// HandleWebhook applies what the provider reports about a checkout. Providers
+// deliver webhooks at least once, so an event that does not change the top up
+// any more is acknowledged without effect. A payment that succeeds after its top
+// up failed or expired is still credited, the provider has taken the money.
+func (s *TopUpServiceImpl) HandleWebhook(
+	ctx context.Context, req *model.TopUpWebhookReq,
+) (*model.TopUpWebhookRes, *exception.Exception) {
+	event, err := s.paymentProvider.ParseWebhook(req.Payload, req.Signature)
+	if err != nil {
+		if errors.Is(err, externalapi.ErrInvalidSignature) {
+			return nil, exception.Unauthenticated(err.Error())
+		}
+		return nil, exception.InvalidArgument(err.Error())
+	}
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	topUp, err := s.topUpRepository.FindByReferenceForUpdate(ctx, tx, s.paymentProvider.Name(), event.Reference)
+	if err != nil {
+		return nil, exception.Internal("failed getting top up", err)
+	}
+	if topUp == nil {
+		return nil, exception.NotFound("top up not found")
+	}
+
+	switch event.Type {
+	case externalapi.WebhookPaymentSucceeded:
+		if topUp.Status == entity.TopUpStatusSucceeded {
+			break
+		}
+		paid, err := event.Amount.WithCurrency(topUp.Amount.Currency)
+		if err != nil || paid.Units != topUp.Amount.Units {
+			return nil, exception.InvalidArgument("the paid amount does not match the top up")
+		}
+		transaction, errException := s.transactionService.TopUpTx(ctx, tx, topUp)
+		if errException != nil {
+			return nil, errException
+		}
+		now := time.Now()
+		topUp.Status = entity.TopUpStatusSucceeded
+		topUp.FailureReason = ""
+		topUp.SettledAt = &now
+		topUp.TransactionId = &transaction.Id
+	case externalapi.WebhookPaymentFailed, externalapi.WebhookPaymentExpired:
+		if topUp.Status != entity.TopUpStatusPending {
+			break
+		}
+		topUp.Status = entity.TopUpStatusFailed
+		if event.Type == externalapi.WebhookPaymentExpired {
+			topUp.Status = entity.TopUpStatusExpired
+		}
+		topUp.FailureReason = event.Reason
+	default:
+		return nil, exception.InvalidArgument("unknown webhook event " + event.Type)
+	}
+	if err := s.topUpRepository.UpdateTx(ctx, tx, topUp); err != nil {
+		return nil, exception.Internal("failed updating top up", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.TopUpWebhookRes{
+		TopUp: *topUp,
+	}, nil
+}
+
+func (s *TopUpServiceImpl) Find(ctx context.Context, req *model.GetAllTopUpReq) (
+	*model.GetAllTopUpRes, *exception.Exception,
+) {
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "user_id",
+		Value:    req.UserId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.topUpRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllTopUpRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *TopUpServiceImpl) Detail(ctx context.Context, req *model.GetTopUpByIDReq) (
+	*model.GetTopUpByIDRes, *exception.Exception,
+) {
+	result, err := s.topUpRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+	if result == nil || result.UserId != req.UserId {
+		return nil, exception.NotFound("top up not found")
+	}
+
+	return &model.GetTopUpByIDRes{
+		TopUp: *result,
+	}, nil
+}
+
+func (s *TopUpServiceImpl) Expire(ctx context.Context) (int64, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	expired, err := s.topUpRepository.ExpireTx(ctx, tx, time.Now())
+	if err != nil {
+		return 0, exception.Internal("failed expiring top ups", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, exception.Internal("commit transaction", err)
+	}
+	return expired, nil
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"gorm.io/gorm"
+	"product-wallet/internal/entity"
 	"product-wallet/internal/model"
 	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
 )
 
 type TransactionService interface {
@@ -21,5 +24,23 @@ type TransactionService interface {
 	Transfer(
 		ctx context.Context, req *model.TransferTransactionReq,
 	) (*model.TransferTransactionRes, *exception.Exception)
-	Delete(ctx context.Context, req *model.DeleteTransactionReq) (*model.DeleteTransactionRes, *exception.Exception)
+	PreviewTransfer(ctx context.Context, req *model.TransferTransactionReq) (*model.PreviewTransferRes, *exception.Exception)
+	Reverse(ctx context.Context, req *model.ReverseTransactionReq) (*model.ReverseTransactionRes, *exception.Exception)
+	Refund(ctx context.Context, req *model.RefundTransactionReq) (*model.RefundTransactionRes, *exception.Exception)
+	Categorize(ctx context.Context, req *model.CategorizeTransactionReq) (*model.CategorizeTransactionRes, *exception.Exception)
+
+	// TransferTx, SweepTx, TopUpTx, PayoutTx, RestorePayoutTx and CaptureTx run inside the caller's database transaction
+	TransferTx(
+		ctx context.Context, tx *gorm.DB, req *model.TransferTransactionReq,
+	) (*model.TransferTransactionRes, *exception.Exception)
+	SweepTx(ctx context.Context, tx *gorm.DB, userId string, sender, receiver *entity.Wallet) (
+		*model.TransferTransactionRes, *exception.Exception,
+	)
+	TopUpTx(ctx context.Context, tx *gorm.DB, topUp *entity.TopUp) (*entity.Transaction, *exception.Exception)
+	PayoutTx(ctx context.Context, tx *gorm.DB, payout *entity.Payout) (*entity.Transaction, *exception.Exception)
+	RestorePayoutTx(ctx context.Context, tx *gorm.DB, payout *entity.Payout) (*entity.Transaction, *exception.Exception)
+	// CaptureTx books amount of a hold the caller locked as a purchase of userId
+	CaptureTx(ctx context.Context, tx *gorm.DB, hold *entity.Hold, userId string, amount money.Money) (
+		*model.CreateTransactionRes, *exception.Exception,
+	)
 }
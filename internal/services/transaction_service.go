@@ -2,22 +2,40 @@ package service
 
 import (
 	"context"
+	"errors"
 	"gorm.io/gorm"
+	"math/big"
+	"product-wallet/internal/entity"
 	"product-wallet/internal/model"
 	"product-wallet/internal/repository"
+	"product-wallet/pkg/money"
 	"product-wallet/pkg/utils/converter"
 	"product-wallet/pkg/xvalidator"
+	"strings"
+	"time"
 
 	//"product-wallet/pkg/exception"
 	"product-wallet/pkg/exception"
 )
 
 type TransactionServiceImpl struct {
-	db                    *gorm.DB
-	transactionRepository repository.TransactionRepository
-	productRepository     repository.ProductRepository
-	walletRepository      repository.WalletRepository
-	validate              *xvalidator.Validator
+	db                       *gorm.DB
+	transactionRepository    repository.TransactionRepository
+	productRepository        repository.ProductRepository
+	walletRepository         repository.WalletRepository
+	holdRepository           repository.HoldRepository
+	ledgerService            LedgerService
+	exchangeRateService      ExchangeRateService
+	spendingLimitService     SpendingLimitService
+	feeService               FeeService
+	rewardService            RewardService
+	memberRepository         repository.WalletMemberRepository
+	categoryRepository       repository.CategoryRepository
+	ruleRepository           repository.CategoryRuleRepository
+	userRepository           repository.UserRepository
+	paymentRequestRepository repository.PaymentRequestRepository
+	requestChangeRepository  repository.PaymentRequestStatusChangeRepository
+	validate                 *xvalidator.Validator
 }
 
 func NewTransactionService(
@@ -25,14 +43,38 @@ func NewTransactionService(
 	repo repository.TransactionRepository,
 	productRepository repository.ProductRepository,
 	walletRepository repository.WalletRepository,
+	holdRepository repository.HoldRepository,
+	ledgerService LedgerService,
+	exchangeRateService ExchangeRateService,
+	spendingLimitService SpendingLimitService,
+	feeService FeeService,
+	rewardService RewardService,
+	memberRepository repository.WalletMemberRepository,
+	categoryRepository repository.CategoryRepository,
+	ruleRepository repository.CategoryRuleRepository,
+	userRepository repository.UserRepository,
+	paymentRequestRepository repository.PaymentRequestRepository,
+	requestChangeRepository repository.PaymentRequestStatusChangeRepository,
 	validate *xvalidator.Validator,
 ) TransactionService {
 	return &TransactionServiceImpl{
-		db:                    db,
-		transactionRepository: repo,
-		productRepository:     productRepository,
-		walletRepository:      walletRepository,
-		validate:              validate,
+		db:                       db,
+		transactionRepository:    repo,
+		productRepository:        productRepository,
+		walletRepository:         walletRepository,
+		holdRepository:           holdRepository,
+		ledgerService:            ledgerService,
+		exchangeRateService:      exchangeRateService,
+		spendingLimitService:     spendingLimitService,
+		feeService:               feeService,
+		rewardService:            rewardService,
+		memberRepository:         memberRepository,
+		categoryRepository:       categoryRepository,
+		ruleRepository:           ruleRepository,
+		userRepository:           userRepository,
+		paymentRequestRepository: paymentRequestRepository,
+		requestChangeRepository:  requestChangeRepository,
+		validate:                 validate,
 	}
 }
 
@@ -45,13 +87,24 @@ func (s *TransactionServiceImpl) Create(
 	if errs := s.validate.Struct(req); errs != nil {
 		return nil, exception.InvalidArgument(errs)
 	}
-	wallet, err := s.walletRepository.FindByID(ctx, s.db, req.WalletId)
-	if err != nil {
-		return nil, exception.Internal("failed getting wallet detail", err)
+	wallets, errException := s.lockWallets(ctx, tx, req.WalletId)
+	if errException != nil {
+		return nil, errException
 	}
+	wallet := wallets[req.WalletId]
 	if wallet == nil {
 		return nil, exception.NotFound("wallet detail not found")
 	}
+	member, errException := authorizeMember(ctx, tx, s.memberRepository, wallet.Id, req.UserId, entity.WalletRoleSpender)
+	if errException != nil {
+		return nil, errException
+	}
+	if errException := checkDebit(wallet); errException != nil {
+		return nil, errException
+	}
+	if req.CategoryId, errException = s.category(ctx, tx, req.CategoryId, req.UserId); errException != nil {
+		return nil, errException
+	}
 	body := req.ToEntity()
 	product, err := s.productRepository.FindByID(ctx, s.db, *req.ProductId)
 	if err != nil {
@@ -60,39 +113,89 @@ func (s *TransactionServiceImpl) Create(
 	if product == nil {
 		return nil, exception.PermissionDenied("product does not exists")
 	}
-	if !product.Available || product.Quantity < 1 || *req.ProductQuantity > product.Quantity {
-		return nil, exception.PermissionDenied("product does not have enough quantity/unavailable")
-	}
-	totalprice, err := converter.ToFloat64(*req.ProductQuantity)
+	totalprice, err := product.Price.Normalize().Mul(int64(*req.ProductQuantity))
 	if err != nil {
-		return nil, exception.Internal("error parsing total price", err)
+		return nil, exception.InvalidArgument("the quantity is too large for this product")
 	}
-	totalprice = totalprice * product.Price
-	if wallet.Balance < totalprice {
-		return nil, exception.PermissionDenied("wallet does not have enough balance to buy this product, balance: " + converter.ToString(wallet.Balance))
+	charge, rate, errException := s.exchangeRateService.Convert(ctx, s.db, totalprice, wallet.CurrencyCode())
+	if errException != nil {
+		return nil, errException
 	}
-
-	product = req.ToProductEntity(product)
-	if err := s.productRepository.UpdateTx(ctx, tx, product); err != nil {
-		return nil, exception.Internal("failed updating wallet", err)
+	quote, errException := s.feeService.QuoteTx(ctx, tx, entity.FeeOperationPurchase, wallet, charge)
+	if errException != nil {
+		return nil, errException
+	}
+	house, errException := s.lockHouse(ctx, tx, quote, wallets)
+	if errException != nil {
+		return nil, errException
+	}
+	available, errException := availableBalance(ctx, tx, s.holdRepository, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+	if available.LessThan(quote.Total) {
+		return nil, insufficientBalance("wallet does not have enough balance to buy this product, available balance: " + converter.ToString(available))
+	}
+	if errException := s.spendingLimitService.Check(ctx, tx, wallet, quote.Total); errException != nil {
+		return nil, errException
+	}
+	if errException := s.checkAllowance(ctx, tx, member, quote.Total); errException != nil {
+		return nil, errException
 	}
 
-	wallet.Decrease(totalprice)
-	if err := s.walletRepository.UpdateTx(ctx, tx, wallet); err != nil {
-		return nil, exception.Internal("failed updating wallet", err)
+	inStock, err := s.productRepository.DecrementStockTx(ctx, tx, product.Id, *req.ProductQuantity)
+	if err != nil {
+		return nil, exception.Internal("failed updating product stock", err)
+	}
+	if !inStock {
+		return nil, exception.PermissionDenied("product is out of stock")
 	}
 
 	body.Description = "Buying " + product.Name + ", quantity: " + converter.ToString(*req.ProductQuantity) + " for " + converter.ToString(totalprice)
-	body.Amount = totalprice
+	body.Amount = charge
+	body.OriginalAmount = charge
+	body.ConvertedAmount = totalprice
+	body.ExchangeRate = rate.Inverse()
+	if errException := s.file(ctx, tx, body, entity.CategoryShopping); errException != nil {
+		return nil, errException
+	}
 	if err := s.transactionRepository.CreateTx(ctx, tx, body); err != nil {
 		return nil, exception.Internal("err", err)
 	}
 
+	walletAccount, errException := s.ledgerService.WalletAccount(ctx, tx, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+	salesAccount, errException := s.ledgerService.SystemAccount(ctx, tx, entity.MerchantSalesAccountCode, totalprice.Currency)
+	if errException != nil {
+		return nil, errException
+	}
+	entry := entity.NewJournalEntry(body.Description).
+		Debit(walletAccount.Id, charge, &body.Id)
+	if errException := s.ledgerService.Exchange(ctx, tx, entry, charge, totalprice); errException != nil {
+		return nil, errException
+	}
+	entry.Credit(salesAccount.Id, totalprice, nil)
+	feeTransaction, errException := s.chargeFee(ctx, tx, entry, quote, wallet, house, body)
+	if errException != nil {
+		return nil, errException
+	}
+	if errException := s.ledgerService.Post(ctx, tx, entry); errException != nil {
+		return nil, errException
+	}
+	rewards, errException := s.rewardService.EvaluateTx(ctx, tx, entity.CampaignTriggerPurchase, wallet, body)
+	if errException != nil {
+		return nil, errException
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return nil, exception.Internal("commit transaction", err)
 	}
 	return &model.CreateTransactionRes{
-		Transaction: *body,
+		Transaction:    *body,
+		FeeTransaction: feeTransaction,
+		Rewards:        rewards,
 	}, nil
 }
 
@@ -103,6 +206,12 @@ func (s *TransactionServiceImpl) Detail(
 	if err != nil {
 		return nil, exception.Internal("err", err)
 	}
+	if result == nil {
+		return nil, exception.NotFound("transaction not found")
+	}
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, result.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
 	return &model.GetTransactionByIDRes{
 		Transaction: *result,
 	}, nil
@@ -111,7 +220,16 @@ func (s *TransactionServiceImpl) Detail(
 func (s *TransactionServiceImpl) Find(ctx context.Context, req *model.GetAllTransactionReq) (
 	*model.GetAllTransactionRes, *exception.Exception,
 ) {
-	result, err := s.transactionRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, req.Filter)
+	members, errException := memberWalletFilter(ctx, s.db, s.memberRepository, "wallet_id", req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	if members == nil {
+		return &model.GetAllTransactionRes{
+			PaginationData: model.NewEmptyPaginationData[entity.Transaction](req.Page),
+		}, nil
+	}
+	result, err := s.transactionRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, append(req.Filter, members))
 	if err != nil {
 		return nil, exception.Internal("err", err)
 	}
@@ -129,33 +247,61 @@ func (s *TransactionServiceImpl) Credit(
 	if errs := s.validate.Struct(req); errs != nil {
 		return nil, exception.InvalidArgument(errs)
 	}
-	if req.Amount < 1 {
+	if !req.Amount.IsPositive() {
 		return nil, exception.PermissionDenied("Input of amount must be greater than zero")
 	}
-	wallet, err := s.walletRepository.FindByID(ctx, s.db, req.WalletId)
+	wallet, err := s.walletRepository.FindByIDForUpdate(ctx, tx, req.WalletId)
 	if err != nil {
 		return nil, exception.Internal("failed getting wallet detail", err)
 	}
 	if wallet == nil {
 		return nil, exception.NotFound("wallet detail not found")
 	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, wallet.Id, req.UserId, entity.WalletRoleSpender); errException != nil {
+		return nil, errException
+	}
+	if errException := checkCredit(wallet); errException != nil {
+		return nil, errException
+	}
+	if req.Amount.Currency == "" {
+		req.Amount, err = req.Amount.WithCurrency(wallet.CurrencyCode())
+		if err != nil {
+			return nil, exception.InvalidArgument(err.Error())
+		}
+	}
+	req.Amount = req.Amount.Normalize()
+	credited, rate, errException := s.exchangeRateService.Convert(ctx, s.db, req.Amount, wallet.CurrencyCode())
+	if errException != nil {
+		return nil, errException
+	}
+	if req.CategoryId, errException = s.category(ctx, tx, req.CategoryId, req.UserId); errException != nil {
+		return nil, errException
+	}
 
-	//category, err := s.categoryRepository.FindByID(ctx, s.db, categoryid)
-	//if err != nil {
-	//	return exception.Internal("failed getting category detail", err)
-	//}
-	//if category == nil {
-	//	return exception.PermissionDenied("category does not exists")
-	//}
-
-	userTransaction := req.ToEntity()
+	userTransaction := req.ToEntity(credited, rate)
+	if errException := s.file(ctx, tx, userTransaction, entity.CategoryTopUp); errException != nil {
+		return nil, errException
+	}
 	if err := s.transactionRepository.CreateTx(ctx, tx, userTransaction); err != nil {
 		return nil, exception.Internal("failed creating transaction", err)
 	}
 
-	wallet.Increase(req.Amount)
-	if err := s.walletRepository.UpdateTx(ctx, tx, wallet); err != nil {
-		return nil, exception.Internal("failed updating wallet", err)
+	walletAccount, errException := s.ledgerService.WalletAccount(ctx, tx, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+	fundingAccount, errException := s.ledgerService.SystemAccount(ctx, tx, entity.SystemFundingAccountCode, req.Amount.Currency)
+	if errException != nil {
+		return nil, errException
+	}
+	entry := entity.NewJournalEntry(userTransaction.Description).
+		Debit(fundingAccount.Id, req.Amount, nil)
+	if errException := s.ledgerService.Exchange(ctx, tx, entry, req.Amount, credited); errException != nil {
+		return nil, errException
+	}
+	entry.Credit(walletAccount.Id, credited, &userTransaction.Id)
+	if errException := s.ledgerService.Post(ctx, tx, entry); errException != nil {
+		return nil, errException
 	}
 
 	if err := tx.Commit().Error; err != nil {
@@ -166,79 +312,1207 @@ func (s *TransactionServiceImpl) Credit(
 	}, nil
 }
 
+// TopUpTx books the income of a succeeded top up, the money comes in from the
+// payment gateway clearing account, and grants the top up campaigns' rewards.
+func (s *TransactionServiceImpl) TopUpTx(
+	ctx context.Context, tx *gorm.DB, topUp *entity.TopUp,
+) (*entity.Transaction, *exception.Exception) {
+	wallet, err := s.walletRepository.FindByIDForUpdate(ctx, tx, topUp.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	if errException := checkCredit(wallet); errException != nil {
+		return nil, errException
+	}
+
+	userTransaction := model.NewTopUpTransaction(*topUp)
+	if errException := s.file(ctx, tx, userTransaction, entity.CategoryTopUp); errException != nil {
+		return nil, errException
+	}
+	if err := s.transactionRepository.CreateTx(ctx, tx, userTransaction); err != nil {
+		return nil, exception.Internal("failed creating transaction", err)
+	}
+
+	walletAccount, errException := s.ledgerService.WalletAccount(ctx, tx, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+	gatewayAccount, errException := s.ledgerService.SystemAccount(ctx, tx, entity.SystemGatewayAccountCode, topUp.Amount.Currency)
+	if errException != nil {
+		return nil, errException
+	}
+	entry := entity.NewJournalEntry(userTransaction.Description).
+		Debit(gatewayAccount.Id, topUp.Amount, nil).
+		Credit(walletAccount.Id, topUp.Amount, &userTransaction.Id)
+	if errException := s.ledgerService.Post(ctx, tx, entry); errException != nil {
+		return nil, errException
+	}
+	if _, errException := s.rewardService.EvaluateTx(ctx, tx, entity.CampaignTriggerTopUp, wallet, userTransaction); errException != nil {
+		return nil, errException
+	}
+	return userTransaction, nil
+}
+
+// PayoutTx books the withdrawal of a payout and its fee, the money waits in the
+// payouts in flight account until the provider pays it out. It is capped like any
+// other outflow by the balance, the spending limits and the spender's allowance.
+func (s *TransactionServiceImpl) PayoutTx(
+	ctx context.Context, tx *gorm.DB, payout *entity.Payout,
+) (*entity.Transaction, *exception.Exception) {
+	wallets, errException := s.lockWallets(ctx, tx, payout.WalletId)
+	if errException != nil {
+		return nil, errException
+	}
+	wallet := wallets[payout.WalletId]
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	member, errException := authorizeMember(ctx, tx, s.memberRepository, wallet.Id, payout.UserId, entity.WalletRoleSpender)
+	if errException != nil {
+		return nil, errException
+	}
+	if errException := checkDebit(wallet); errException != nil {
+		return nil, errException
+	}
+	quote, errException := s.feeService.QuoteTx(ctx, tx, entity.FeeOperationWithdrawal, wallet, payout.Amount)
+	if errException != nil {
+		return nil, errException
+	}
+	house, errException := s.lockHouse(ctx, tx, quote, wallets)
+	if errException != nil {
+		return nil, errException
+	}
+	payout.Fee = quote.Fee
+	available, errException := availableBalance(ctx, tx, s.holdRepository, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+	if available.LessThan(quote.Total) {
+		return nil, insufficientBalance(wallet.Name + " does not have enough balance. Available: " + converter.ToString(available))
+	}
+	if errException := s.spendingLimitService.Check(ctx, tx, wallet, quote.Total); errException != nil {
+		return nil, errException
+	}
+	if errException := s.checkAllowance(ctx, tx, member, quote.Total); errException != nil {
+		return nil, errException
+	}
+
+	userTransaction := model.NewPayoutTransaction(*payout)
+	if errException := s.file(ctx, tx, userTransaction, entity.CategoryWithdrawal); errException != nil {
+		return nil, errException
+	}
+	if err := s.transactionRepository.CreateTx(ctx, tx, userTransaction); err != nil {
+		return nil, exception.Internal("failed creating transaction", err)
+	}
+
+	walletAccount, errException := s.ledgerService.WalletAccount(ctx, tx, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+	payoutAccount, errException := s.ledgerService.SystemAccount(ctx, tx, entity.SystemPayoutAccountCode, payout.Amount.Currency)
+	if errException != nil {
+		return nil, errException
+	}
+	entry := entity.NewJournalEntry(userTransaction.Description).
+		Debit(walletAccount.Id, payout.Amount, &userTransaction.Id).
+		Credit(payoutAccount.Id, payout.Amount, nil)
+	if _, errException := s.chargeFee(ctx, tx, entry, quote, wallet, house, userTransaction); errException != nil {
+		return nil, errException
+	}
+	if errException := s.ledgerService.Post(ctx, tx, entry); errException != nil {
+		return nil, errException
+	}
+	return userTransaction, nil
+}
+
+// RestorePayoutTx reverses the withdrawal of a failed or returned payout, giving
+// the money back to its wallet.
+func (s *TransactionServiceImpl) RestorePayoutTx(
+	ctx context.Context, tx *gorm.DB, payout *entity.Payout,
+) (*entity.Transaction, *exception.Exception) {
+	if payout.TransactionId == nil {
+		return nil, exception.Internal("payout was never debited", errors.New(payout.Id))
+	}
+	booking, errException := s.lockBooking(ctx, tx, *payout.TransactionId)
+	if errException != nil {
+		return nil, errException
+	}
+	prefix := "Failed payout: "
+	if payout.Status == entity.PayoutStatusReturned {
+		prefix = "Returned payout: "
+	}
+	response, errException := s.compensate(ctx, tx, booking, payout.UserId, booking.original.RemainingAmount(), 0, prefix)
+	if errException != nil {
+		return nil, errException
+	}
+	for i := range response.Compensations {
+		if *response.Compensations[i].ReversalOfId == booking.original.Id {
+			return &response.Compensations[i], nil
+		}
+	}
+	return nil, exception.Internal("payout was not restored", errors.New(payout.Id))
+}
+
+// CaptureTx books what is captured of a hold like a purchase, under the same limits,
+// allowance, filing, fees and rewards. The hold still reserves its amount, so it is
+// left out of the holds weighing on what the wallet has available, whether or not it
+// expired since it was locked.
+func (s *TransactionServiceImpl) CaptureTx(
+	ctx context.Context, tx *gorm.DB, hold *entity.Hold, userId string, amount money.Money,
+) (*model.CreateTransactionRes, *exception.Exception) {
+	wallets, errException := s.lockWallets(ctx, tx, hold.WalletId)
+	if errException != nil {
+		return nil, errException
+	}
+	wallet := wallets[hold.WalletId]
+	if wallet == nil {
+		return nil, exception.NotFound("wallet detail not found")
+	}
+	member, errException := authorizeMember(ctx, tx, s.memberRepository, wallet.Id, userId, entity.WalletRoleSpender)
+	if errException != nil {
+		return nil, errException
+	}
+	if errException := checkDebit(wallet); errException != nil {
+		return nil, errException
+	}
+	quote, errException := s.feeService.QuoteTx(ctx, tx, entity.FeeOperationPurchase, wallet, amount)
+	if errException != nil {
+		return nil, errException
+	}
+	house, errException := s.lockHouse(ctx, tx, quote, wallets)
+	if errException != nil {
+		return nil, errException
+	}
+	available, errException := availableBalance(ctx, tx, s.holdRepository, wallet, hold.Id)
+	if errException != nil {
+		return nil, errException
+	}
+	if available.LessThan(quote.Total) {
+		return nil, insufficientBalance(wallet.Name + " does not have enough balance. Available: " + converter.ToString(available))
+	}
+	if errException := s.spendingLimitService.Check(ctx, tx, wallet, quote.Total); errException != nil {
+		return nil, errException
+	}
+	if errException := s.checkAllowance(ctx, tx, member, quote.Total); errException != nil {
+		return nil, errException
+	}
+
+	transaction := model.NewCaptureTransaction(*hold, userId, amount)
+	if errException := s.file(ctx, tx, transaction, entity.CategoryShopping); errException != nil {
+		return nil, errException
+	}
+	if err := s.transactionRepository.CreateTx(ctx, tx, transaction); err != nil {
+		return nil, exception.Internal("failed creating transaction", err)
+	}
+	walletAccount, errException := s.ledgerService.WalletAccount(ctx, tx, wallet)
+	if errException != nil {
+		return nil, errException
+	}
+	salesAccount, errException := s.ledgerService.SystemAccount(ctx, tx, entity.MerchantSalesAccountCode, amount.Currency)
+	if errException != nil {
+		return nil, errException
+	}
+	entry := entity.NewJournalEntry(transaction.Description).
+		Debit(walletAccount.Id, amount, &transaction.Id).
+		Credit(salesAccount.Id, amount, nil)
+	feeTransaction, errException := s.chargeFee(ctx, tx, entry, quote, wallet, house, transaction)
+	if errException != nil {
+		return nil, errException
+	}
+	if errException := s.ledgerService.Post(ctx, tx, entry); errException != nil {
+		return nil, errException
+	}
+	rewards, errException := s.rewardService.EvaluateTx(ctx, tx, entity.CampaignTriggerPurchase, wallet, transaction)
+	if errException != nil {
+		return nil, errException
+	}
+	return &model.CreateTransactionRes{
+		Transaction:    *transaction,
+		FeeTransaction: feeTransaction,
+		Rewards:        rewards,
+	}, nil
+}
+
 func (s *TransactionServiceImpl) Transfer(
 	ctx context.Context, req *model.TransferTransactionReq,
 ) (*model.TransferTransactionRes, *exception.Exception) {
 	tx := s.db.Begin()
 	defer tx.Rollback()
+	response, errException := s.TransferTx(ctx, tx, req)
+	if errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return response, nil
+}
+
+// TransferTx is Transfer within tx, committing is left to the caller.
+func (s *TransactionServiceImpl) TransferTx(
+	ctx context.Context, tx *gorm.DB, req *model.TransferTransactionReq,
+) (*model.TransferTransactionRes, *exception.Exception) {
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, exception.PermissionDenied("Input of amount must be greater than zero")
+	}
+	if errException := s.resolveReceiver(ctx, tx, req); errException != nil {
+		return nil, errException
+	}
+	// both wallets stay locked until commit so the balance check below cannot go stale
+	wallets, errException := s.lockWallets(ctx, tx, req.SenderId, req.ReceiverId)
+	if errException != nil {
+		return nil, errException
+	}
+	sender, receiver := wallets[req.SenderId], wallets[req.ReceiverId]
+	if sender == nil {
+		return nil, exception.NotFound("sender wallet detail not found")
+	}
+	if receiver == nil {
+		return nil, exception.NotFound("receiver wallet detail not found")
+	}
+	member, errException := authorizeMember(ctx, tx, s.memberRepository, sender.Id, req.UserId, entity.WalletRoleSpender)
+	if errException != nil {
+		return nil, errException
+	}
+	if errException := checkDebit(sender); errException != nil {
+		return nil, errException
+	}
+	if errException := checkCredit(receiver); errException != nil {
+		return nil, errException
+	}
+	debit, credit, rate, errException := s.transferAmounts(ctx, req, sender, receiver)
+	if errException != nil {
+		return nil, errException
+	}
+	quote, errException := s.feeService.QuoteTx(ctx, tx, entity.FeeOperationTransfer, sender, debit)
+	if errException != nil {
+		return nil, errException
+	}
+	house, errException := s.lockHouse(ctx, tx, quote, wallets)
+	if errException != nil {
+		return nil, errException
+	}
+	available, errException := availableBalance(ctx, tx, s.holdRepository, sender)
+	if errException != nil {
+		return nil, errException
+	}
+	if available.LessThan(quote.Total) {
+		return nil, insufficientBalance(sender.Name + " does not have enough balance. Available: " + converter.ToString(available))
+	}
+	if errException := s.spendingLimitService.Check(ctx, tx, sender, quote.Total); errException != nil {
+		return nil, errException
+	}
+	if errException := s.checkAllowance(ctx, tx, member, quote.Total); errException != nil {
+		return nil, errException
+	}
+	if req.CategoryId, errException = s.category(ctx, tx, req.CategoryId, req.UserId); errException != nil {
+		return nil, errException
+	}
+	return s.bookTransfer(ctx, tx, req, sender, receiver, debit, credit, rate, quote, house)
+}
+
+// PreviewTransfer resolves who a transfer would pay, converts its amount and prices
+// its fee without booking anything, the receiver is shown by a masked username only.
+func (s *TransactionServiceImpl) PreviewTransfer(
+	ctx context.Context, req *model.TransferTransactionReq,
+) (*model.PreviewTransferRes, *exception.Exception) {
 	if errs := s.validate.Struct(req); errs != nil {
 		return nil, exception.InvalidArgument(errs)
 	}
-	if req.Amount < 1 {
+	if !req.Amount.IsPositive() {
 		return nil, exception.PermissionDenied("Input of amount must be greater than zero")
 	}
+	if errException := s.resolveReceiver(ctx, s.db, req); errException != nil {
+		return nil, errException
+	}
 	sender, err := s.walletRepository.FindByID(ctx, s.db, req.SenderId)
 	if err != nil {
-		return nil, exception.Internal("failed getting sender detail", err)
+		return nil, exception.Internal("failed getting wallet detail", err)
 	}
 	if sender == nil {
 		return nil, exception.NotFound("sender wallet detail not found")
 	}
 	receiver, err := s.walletRepository.FindByID(ctx, s.db, req.ReceiverId)
 	if err != nil {
-		return nil, exception.Internal("failed getting receiver detail", err)
+		return nil, exception.Internal("failed getting wallet detail", err)
 	}
 	if receiver == nil {
 		return nil, exception.NotFound("receiver wallet detail not found")
 	}
-	if sender.Balance < req.Amount {
-		return nil, exception.PermissionDenied(sender.Name + " does not have enough balance. Balance: " + converter.ToString(sender.Balance))
-	}
-	//category, err := s.categoryRepository.FindByName(ctx, s.db, "name", "Transfer")
-	//if err != nil {
-	//	return exception.Internal("failed getting category detail", err)
-	//}
-	//if category == nil {
-	//	return exception.PermissionDenied("category does not exists")
-	//}
-	senderTransaction := req.ToSenderEntity(receiver.Name, sender.Id)
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, sender.Id, req.UserId, entity.WalletRoleSpender); errException != nil {
+		return nil, errException
+	}
+	if errException := checkDebit(sender); errException != nil {
+		return nil, errException
+	}
+	if errException := checkCredit(receiver); errException != nil {
+		return nil, errException
+	}
+	debit, credit, rate, errException := s.transferAmounts(ctx, req, sender, receiver)
+	if errException != nil {
+		return nil, errException
+	}
+	quote, errException := s.feeService.QuoteTx(ctx, s.db, entity.FeeOperationTransfer, sender, debit)
+	if errException != nil {
+		return nil, errException
+	}
+	receiverName, errException := s.username(ctx, s.db, receiver.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	return &model.PreviewTransferRes{
+		ReceiverId:         receiver.Id,
+		ReceiverName:       entity.MaskName(receiverName),
+		ReceiverWalletName: receiver.Name,
+		Debit:              debit,
+		Credit:             credit,
+		ExchangeRate:       rate,
+		Fee:                quote.Fee,
+		Total:              quote.Total,
+	}, nil
+}
+
+// resolveReceiver sets the receiver of a transfer made out to a username: the open
+// wallet of that user named ReceiverWallet, else their default wallet, else their
+// only open wallet. Transfers to a wallet id are left alone.
+func (s *TransactionServiceImpl) resolveReceiver(
+	ctx context.Context, tx *gorm.DB, req *model.TransferTransactionReq,
+) *exception.Exception {
+	if req.ReceiverUsername == "" {
+		return s.checkReceiver(req)
+	}
+	user, err := s.userRepository.FindByFilter(ctx, tx, model.FilterParams{
+		{
+			Field:    "username",
+			Value:    req.ReceiverUsername,
+			Operator: "=",
+		},
+	}, model.OrderParam{
+		Order:   "asc",
+		OrderBy: "username",
+	})
+	if err != nil {
+		return exception.Internal("error finding user", err)
+	}
+	if user == nil {
+		return exception.NotFound("user " + req.ReceiverUsername + " not found")
+	}
+	wallets, err := s.walletRepository.FindOpenByUser(ctx, tx, user.Id)
+	if err != nil {
+		return exception.Internal("failed getting wallets of the receiver", err)
+	}
+	var receiver *entity.Wallet
+	for i := range wallets {
+		wallet := &wallets[i]
+		switch {
+		case req.ReceiverWallet != "":
+			if strings.EqualFold(wallet.Name, strings.TrimSpace(req.ReceiverWallet)) {
+				receiver = wallet
+			}
+		case user.DefaultWalletId != nil && *user.DefaultWalletId == wallet.Id:
+			receiver = wallet
+		}
+	}
+	if receiver == nil && req.ReceiverWallet == "" && len(wallets) == 1 {
+		receiver = &wallets[0]
+	}
+	if receiver == nil {
+		switch {
+		case req.ReceiverWallet != "":
+			return exception.NotFound(req.ReceiverUsername + " has no open wallet named " + req.ReceiverWallet)
+		case len(wallets) == 0:
+			return exception.NotFound(req.ReceiverUsername + " has no open wallet")
+		default:
+			return exception.InvalidArgument(req.ReceiverUsername + " has no default wallet, name the one to pay into with receiver_wallet")
+		}
+	}
+	req.ReceiverId = receiver.Id
+	return s.checkReceiver(req)
+}
+
+// checkReceiver refuses a transfer from a wallet into itself.
+func (s *TransactionServiceImpl) checkReceiver(req *model.TransferTransactionReq) *exception.Exception {
+	if req.ReceiverId == req.SenderId {
+		return exception.InvalidArgument("cannot transfer from a wallet into itself")
+	}
+	return nil
+}
+
+// transferAmounts converts the amount of a transfer, given in either wallet's
+// currency, into what leaves the sender and what reaches the receiver.
+func (s *TransactionServiceImpl) transferAmounts(
+	ctx context.Context, req *model.TransferTransactionReq, sender, receiver *entity.Wallet,
+) (debit, credit money.Money, rate money.Rate, errException *exception.Exception) {
+	if req.Amount.Currency == "" {
+		amount, err := req.Amount.WithCurrency(sender.CurrencyCode())
+		if err != nil {
+			return debit, credit, rate, exception.InvalidArgument(err.Error())
+		}
+		req.Amount = amount
+	}
+	req.Amount = req.Amount.Normalize()
+	switch req.Amount.Currency {
+	case sender.CurrencyCode():
+		debit = req.Amount
+		credit, rate, errException = s.exchangeRateService.Convert(ctx, s.db, req.Amount, receiver.CurrencyCode())
+	case receiver.CurrencyCode():
+		credit = req.Amount
+		debit, rate, errException = s.exchangeRateService.Convert(ctx, s.db, req.Amount, sender.CurrencyCode())
+		rate = rate.Inverse()
+	default:
+		errException = exception.InvalidArgument("amount must be in the currency of the sender or the receiver wallet")
+	}
+	return debit, credit, rate, errException
+}
+
+// SweepTx transfers the whole balance of sender to receiver within tx, both wallets
+// locked by the caller. Spending limits do not apply, the money stays with its owner.
+func (s *TransactionServiceImpl) SweepTx(
+	ctx context.Context, tx *gorm.DB, userId string, sender, receiver *entity.Wallet,
+) (*model.TransferTransactionRes, *exception.Exception) {
+	if errException := checkDebit(sender); errException != nil {
+		return nil, errException
+	}
+	if errException := checkCredit(receiver); errException != nil {
+		return nil, errException
+	}
+	debit := sender.Balance.Normalize()
+	credit, rate, errException := s.exchangeRateService.Convert(ctx, s.db, debit, receiver.CurrencyCode())
+	if errException != nil {
+		return nil, errException
+	}
+	req := &model.TransferTransactionReq{
+		UserId:     userId,
+		SenderId:   sender.Id,
+		ReceiverId: receiver.Id,
+		Amount:     debit,
+	}
+	return s.bookTransfer(ctx, tx, req, sender, receiver, debit, credit, rate, nil, nil)
+}
+
+// category checks that userId may file a booking under the category chosen, none
+// being chosen leaves the booking to file.
+func (s *TransactionServiceImpl) category(
+	ctx context.Context, tx *gorm.DB, categoryId *string, userId string,
+) (*string, *exception.Exception) {
+	if categoryId == nil {
+		return nil, nil
+	}
+	category, err := s.categoryRepository.FindByID(ctx, tx, *categoryId)
+	if err != nil {
+		return nil, exception.Internal("failed getting category detail", err)
+	}
+	if category == nil || !category.VisibleTo(userId) {
+		return nil, exception.NotFound("category not found")
+	}
+	return &category.Id, nil
+}
+
+// file runs the category rules of its wallet over a transaction about to be booked.
+// A category chosen by the user is kept, one chosen by neither the user nor a rule
+// falls back to the system category named fallback.
+func (s *TransactionServiceImpl) file(
+	ctx context.Context, tx *gorm.DB, transaction *entity.Transaction, fallback string,
+) *exception.Exception {
+	rules, err := s.ruleRepository.FindByWalletId(ctx, tx, transaction.WalletId)
+	if err != nil {
+		return exception.Internal("failed getting category rules", err)
+	}
+	fileByRules(rules, transaction, transaction.CategoryId != nil)
+	if transaction.CategoryId != nil {
+		return nil
+	}
+	categoryId, errException := s.systemCategory(ctx, tx, fallback)
+	if errException != nil {
+		return errException
+	}
+	transaction.CategoryId = categoryId
+	return nil
+}
+
+// systemCategory returns the id of a system category, none when it was not seeded
+// and the booking stays uncategorized.
+func (s *TransactionServiceImpl) systemCategory(ctx context.Context, tx *gorm.DB, name string) (*string, *exception.Exception) {
+	category, err := s.categoryRepository.FindByName(ctx, tx, nil, name)
+	if err != nil {
+		return nil, exception.Internal("failed getting category detail", err)
+	}
+	if category == nil {
+		return nil, nil
+	}
+	return &category.Id, nil
+}
+
+// checkAllowance refuses what would take a spender over the allowance the owners
+// gave them, owners and spenders without an allowance are not capped.
+func (s *TransactionServiceImpl) checkAllowance(
+	ctx context.Context, tx *gorm.DB, member *entity.WalletMember, amount money.Money,
+) *exception.Exception {
+	if member.Role != entity.WalletRoleSpender || member.Allowance.IsZero() {
+		return nil
+	}
+	allowance := member.Allowance.Normalize()
+	spent, err := s.transactionRepository.SumMemberOutflowTx(
+		ctx, tx, member.WalletId, member.UserId, time.Now().Add(-entity.WalletAllowanceWindow),
+	)
+	if err != nil {
+		return exception.Internal("failed getting member spending", err)
+	}
+	remaining := money.New(max(allowance.Units-spent, 0), allowance.Currency)
+	if remaining.LessThan(amount) {
+		return exception.PermissionDenied("member allowance of " + converter.ToString(allowance) +
+			" reached, remaining allowance: " + converter.ToString(remaining))
+	}
+	return nil
+}
+
+// username returns the username of a user, empty when there is no such user.
+func (s *TransactionServiceImpl) username(ctx context.Context, tx *gorm.DB, userId string) (string, *exception.Exception) {
+	user, err := s.userRepository.FindByID(ctx, tx, userId)
+	if err != nil {
+		return "", exception.Internal("error finding user", err)
+	}
+	if user == nil {
+		return "", nil
+	}
+	return user.Username, nil
+}
+
+// bookTransfer records both sides of a transfer and the fee quoted on it, if any,
+// and posts them to the ledger.
+func (s *TransactionServiceImpl) bookTransfer(
+	ctx context.Context, tx *gorm.DB, req *model.TransferTransactionReq,
+	sender, receiver *entity.Wallet, debit, credit money.Money, rate money.Rate, quote *model.FeeQuote, house *entity.Wallet,
+) (*model.TransferTransactionRes, *exception.Exception) {
+	// each side names the user on the other end, the receiver by who the wallet belongs to and the sender by who paid
+	receiverName, errException := s.username(ctx, tx, receiver.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	payerName, errException := s.username(ctx, tx, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	// the category the sender may have picked is theirs, the receiver side is filed by the rules of its own wallet
+	senderTransaction := req.ToSenderEntity(model.TransferParty(receiverName, receiver.Name), sender.Id, debit, credit, rate)
+	if errException := s.file(ctx, tx, senderTransaction, entity.CategoryTransfer); errException != nil {
+		return nil, errException
+	}
 	if err := s.transactionRepository.CreateTx(ctx, tx, senderTransaction); err != nil {
 		return nil, exception.Internal("failed creating transaction", err)
 	}
-	sender.Decrease(req.Amount)
-	if err := s.walletRepository.UpdateTx(ctx, tx, sender); err != nil {
-		return nil, exception.Internal("failed updating wallet", err)
+	receiverTransaction := req.ToReceiverEntity(model.TransferParty(payerName, sender.Name), receiver.Id, debit, credit, rate)
+	if errException := s.file(ctx, tx, receiverTransaction, entity.CategoryTransfer); errException != nil {
+		return nil, errException
 	}
-	receiverTransaction := req.ToReceiverEntity(sender.Name, receiver.Id)
 	if err := s.transactionRepository.CreateTx(ctx, tx, receiverTransaction); err != nil {
 		return nil, exception.Internal("failed creating transaction", err)
 	}
-	receiver.Increase(req.Amount)
-	if err := s.walletRepository.UpdateTx(ctx, tx, receiver); err != nil {
-		return nil, exception.Internal("failed updating wallet", err)
+	senderAccount, errException := s.ledgerService.WalletAccount(ctx, tx, sender)
+	if errException != nil {
+		return nil, errException
 	}
-	if err := tx.Commit().Error; err != nil {
-		return nil, exception.Internal("commit transaction", err)
+	receiverAccount, errException := s.ledgerService.WalletAccount(ctx, tx, receiver)
+	if errException != nil {
+		return nil, errException
+	}
+	entry := entity.NewJournalEntry(senderTransaction.Description).
+		Debit(senderAccount.Id, debit, &senderTransaction.Id)
+	if errException := s.ledgerService.Exchange(ctx, tx, entry, debit, credit); errException != nil {
+		return nil, errException
+	}
+	entry.Credit(receiverAccount.Id, credit, &receiverTransaction.Id)
+	feeTransaction, errException := s.chargeFee(ctx, tx, entry, quote, sender, house, senderTransaction)
+	if errException != nil {
+		return nil, errException
+	}
+	if errException := s.ledgerService.Post(ctx, tx, entry); errException != nil {
+		return nil, errException
 	}
 	return &model.TransferTransactionRes{
 		SenderTransaction:   *senderTransaction,
 		ReceiverTransaction: *receiverTransaction,
+		FeeTransaction:      feeTransaction,
 	}, nil
 }
 
-func (s *TransactionServiceImpl) Delete(
-	ctx context.Context, req *model.DeleteTransactionReq,
-) (*model.DeleteTransactionRes, *exception.Exception) {
+// lockWallets locks ids in id order and returns the wallets found by id. The house
+// wallet is left to lockHouse, every operation locks it last and only when it owes
+// it a fee, so the operations without a fee never wait on each other through it.
+func (s *TransactionServiceImpl) lockWallets(
+	ctx context.Context, tx *gorm.DB, ids ...string,
+) (map[string]*entity.Wallet, *exception.Exception) {
+	wallets, err := s.walletRepository.FindByIDsForUpdate(ctx, tx, ids)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	locked := make(map[string]*entity.Wallet, len(*wallets))
+	for i := range *wallets {
+		locked[(*wallets)[i].Id] = &(*wallets)[i]
+	}
+	return locked, nil
+}
+
+// lockHouse locks the house wallet a quote charges a fee into, after the wallets the
+// operation already locked, and nil for a quote without a fee.
+func (s *TransactionServiceImpl) lockHouse(
+	ctx context.Context, tx *gorm.DB, quote *model.FeeQuote, locked map[string]*entity.Wallet,
+) (*entity.Wallet, *exception.Exception) {
+	if !quote.Fee.IsPositive() {
+		return nil, nil
+	}
+	if house := locked[quote.HouseWalletId]; house != nil {
+		return house, nil
+	}
+	house, err := s.walletRepository.FindByIDForUpdate(ctx, tx, quote.HouseWalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting house wallet", err)
+	}
+	if house == nil {
+		return nil, exception.Internal("house wallet not found", errors.New(quote.HouseWalletId))
+	}
+	return house, nil
+}
+
+// chargeFee books the fee quoted on charged from payer into the house wallet, on
+// the entry of charged so that reversing or refunding it gives the fee back in the
+// same proportion. Nothing is booked for a quote without a fee. house must have been
+// locked by lockHouse.
+func (s *TransactionServiceImpl) chargeFee(
+	ctx context.Context, tx *gorm.DB, entry *entity.JournalEntry, quote *model.FeeQuote,
+	payer, house *entity.Wallet, charged *entity.Transaction,
+) (*entity.Transaction, *exception.Exception) {
+	if quote == nil || !quote.Fee.IsPositive() {
+		return nil, nil
+	}
+	if house == nil {
+		return nil, exception.Internal("house wallet not found", errors.New(quote.HouseWalletId))
+	}
+	if errException := checkCredit(house); errException != nil {
+		return nil, errException
+	}
+	credit, rate, errException := s.exchangeRateService.Convert(ctx, tx, quote.Fee, house.CurrencyCode())
+	if errException != nil {
+		return nil, errException
+	}
+	payerTransaction, houseTransaction := model.NewFeeTransactions(*quote, *charged, credit, rate)
+	if payerTransaction.CategoryId, errException = s.systemCategory(ctx, tx, entity.CategoryFees); errException != nil {
+		return nil, errException
+	}
+	if err := s.transactionRepository.CreateTx(ctx, tx, payerTransaction); err != nil {
+		return nil, exception.Internal("failed creating transaction", err)
+	}
+	if errException := s.file(ctx, tx, houseTransaction, entity.CategoryFees); errException != nil {
+		return nil, errException
+	}
+	if err := s.transactionRepository.CreateTx(ctx, tx, houseTransaction); err != nil {
+		return nil, exception.Internal("failed creating transaction", err)
+	}
+	payerAccount, errException := s.ledgerService.WalletAccount(ctx, tx, payer)
+	if errException != nil {
+		return nil, errException
+	}
+	houseAccount, errException := s.ledgerService.WalletAccount(ctx, tx, house)
+	if errException != nil {
+		return nil, errException
+	}
+	entry.Debit(payerAccount.Id, quote.Fee, &payerTransaction.Id)
+	if errException := s.ledgerService.Exchange(ctx, tx, entry, quote.Fee, credit); errException != nil {
+		return nil, errException
+	}
+	entry.Credit(houseAccount.Id, credit, &houseTransaction.Id)
+	return payerTransaction, nil
+}
+
+// Reverse undoes what is left of a transaction with compensating transactions, a
+// transfer is reversed on both wallets by its receiver and a purchase puts its items
+// back in stock.
+func (s *TransactionServiceImpl) Reverse(
+	ctx context.Context, req *model.ReverseTransactionReq,
+) (*model.ReverseTransactionRes, *exception.Exception) {
 	tx := s.db.Begin()
 	defer tx.Rollback()
+	booking, errException := s.lockReversible(ctx, tx, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	original := booking.original
+	if errException := s.authorizeCompensation(ctx, tx, booking, req.UserId); errException != nil {
+		return nil, errException
+	}
+	response, errException := s.compensate(
+		ctx, tx, booking, req.UserId, original.RemainingAmount(), original.ProductQuantity-original.RefundedQuantity, "Reversal of: ",
+	)
+	if errException != nil {
+		return nil, errException
+	}
+	if errException := s.unlink(ctx, tx, booking, req.UserId); errException != nil {
+		return nil, errException
+	}
+	if errException := s.clawBack(ctx, tx, booking, response, req.UserId); errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return response, nil
+}
 
-	if err := s.transactionRepository.DeleteByIDTx(ctx, tx, req.ID); err != nil {
-		return nil, exception.Internal("err", err)
+// Refund gives back part of a purchase, by quantity of items or by amount.
+func (s *TransactionServiceImpl) Refund(
+	ctx context.Context, req *model.RefundTransactionReq,
+) (*model.RefundTransactionRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if (req.Quantity == nil) == (req.Amount == nil) {
+		return nil, exception.InvalidArgument("either quantity or amount must be given")
+	}
+	booking, errException := s.lockReversible(ctx, tx, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	original := booking.original
+	if errException := s.authorizeCompensation(ctx, tx, booking, req.UserId); errException != nil {
+		return nil, errException
+	}
+	if original.Type != "expense" || original.ProductId == nil {
+		return nil, exception.PermissionDenied("only purchases can be refunded, reverse the transaction instead")
+	}
+	remaining := original.RemainingAmount()
+	var (
+		amount   money.Money
+		quantity uint
+	)
+	if req.Quantity != nil {
+		quantity = *req.Quantity
+		if original.ProductQuantity == 0 {
+			return nil, exception.PermissionDenied("quantity of this purchase is unknown, refund an amount instead")
+		}
+		if quantity > original.ProductQuantity-original.RefundedQuantity {
+			return nil, exception.PermissionDenied("cannot refund more items than were bought")
+		}
+		amount = original.Amount.Normalize().MulRat(
+			big.NewRat(int64(quantity), int64(original.ProductQuantity)), money.DefaultRounding,
+		)
+		if quantity == original.ProductQuantity-original.RefundedQuantity || remaining.LessThan(amount) {
+			amount = remaining
+		}
+	} else {
+		var err error
+		amount, err = req.Amount.WithCurrency(remaining.Currency)
+		if err != nil {
+			return nil, exception.InvalidArgument(err.Error())
+		}
+		if !amount.IsPositive() {
+			return nil, exception.InvalidArgument("amount must be greater than zero")
+		}
+		if remaining.LessThan(amount) {
+			return nil, exception.PermissionDenied("cannot refund more than " + converter.ToString(remaining))
+		}
+	}
+	response, errException := s.compensate(ctx, tx, booking, req.UserId, amount, quantity, "Refund of: ")
+	if errException != nil {
+		return nil, errException
+	}
+	if errException := s.unlink(ctx, tx, booking, req.UserId); errException != nil {
+		return nil, errException
+	}
+	if errException := s.clawBack(ctx, tx, booking, response, req.UserId); errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.RefundTransactionRes{
+		ReverseTransactionRes: *response,
+	}, nil
+}
+
+// Categorize files a transaction under another category, members who may spend
+// from its wallet may file its transactions.
+func (s *TransactionServiceImpl) Categorize(
+	ctx context.Context, req *model.CategorizeTransactionReq,
+) (*model.CategorizeTransactionRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	transaction, err := s.transactionRepository.FindByIDForUpdate(ctx, tx, req.ID)
+	if err != nil {
+		return nil, exception.Internal("failed getting transaction detail", err)
+	}
+	if transaction == nil {
+		return nil, exception.NotFound("transaction not found")
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, transaction.WalletId, req.UserId, entity.WalletRoleSpender); errException != nil {
+		return nil, errException
+	}
+	categoryId, errException := s.category(ctx, tx, &req.CategoryId, req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	transaction.CategoryId = categoryId
+	if err := s.transactionRepository.UpdateTx(ctx, tx, transaction); err != nil {
+		return nil, exception.Internal("failed updating transaction", err)
+	}
+	result, err := s.transactionRepository.FindByID(ctx, tx, transaction.Id)
+	if err != nil {
+		return nil, exception.Internal("failed getting transaction detail", err)
 	}
 	if err := tx.Commit().Error; err != nil {
 		return nil, exception.Internal("commit transaction", err)
 	}
-	return &model.DeleteTransactionRes{
-		ID: req.ID,
+	return &model.CategorizeTransactionRes{
+		Transaction: *result,
 	}, nil
 }
+
+// authorizeCompensation lets owners of the wallet of the original transaction undo it, as
+// long as they also own every wallet the money is taken back from. A transfer to someone
+// else is only reversed by its receiver, the sender cannot pull the money back alone.
+func (s *TransactionServiceImpl) authorizeCompensation(
+	ctx context.Context, tx *gorm.DB, booking *booking, userId string,
+) *exception.Exception {
+	walletIds := []string{booking.original.WalletId}
+	for _, line := range booking.entry.Lines {
+		if !line.Credit.IsPositive() || line.Account == nil || line.Account.WalletId == nil {
+			continue
+		}
+		if *line.Account.WalletId == s.feeService.HouseWalletId() {
+			continue
+		}
+		walletIds = append(walletIds, *line.Account.WalletId)
+	}
+	for _, walletId := range walletIds {
+		if _, errException := authorizeMember(ctx, tx, s.memberRepository, walletId, userId, entity.WalletRoleOwner); errException != nil {
+			if errException.Code == exception.PermissionDeniedCode && walletId != booking.original.WalletId {
+				return exception.PermissionDenied("only the receiver can reverse a transfer to a wallet you do not own")
+			}
+			return errException
+		}
+	}
+	return nil
+}
+
+// unlink moves what a fully undone transaction settled back, a payment request it paid
+// is pending again, and so is the share of a bill split it was, while the shares still
+// pending on a split of the expense are cancelled.
+func (s *TransactionServiceImpl) unlink(
+	ctx context.Context, tx *gorm.DB, booking *booking, userId string,
+) *exception.Exception {
+	original := booking.original
+	if original.Status != entity.TransactionStatusReversed {
+		return nil
+	}
+	transactionIds := make([]string, 0, len(booking.related))
+	for _, related := range booking.related {
+		transactionIds = append(transactionIds, related.Id)
+	}
+	paid, err := s.paymentRequestRepository.FindPaidByTransactionsForUpdate(ctx, tx, transactionIds)
+	if err != nil {
+		return exception.Internal("failed getting payment requests", err)
+	}
+	for i := range *paid {
+		request := &(*paid)[i]
+		request.PaidFromWalletId = nil
+		request.SenderTransactionId = nil
+		request.ReceiverTransactionId = nil
+		if errException := s.changeRequestStatus(
+			ctx, tx, request, entity.PaymentRequestStatusPending, "payment was reversed", userId,
+		); errException != nil {
+			return errException
+		}
+	}
+	pending, err := s.paymentRequestRepository.FindPendingBySplitTransactionForUpdate(ctx, tx, original.Id)
+	if err != nil {
+		return exception.Internal("failed getting payment requests", err)
+	}
+	for i := range *pending {
+		if errException := s.changeRequestStatus(
+			ctx, tx, &(*pending)[i], entity.PaymentRequestStatusCancelled, "split expense was reversed", userId,
+		); errException != nil {
+			return errException
+		}
+	}
+	return nil
+}
+
+// clawBack reverses the credits of the rewards the original transaction of booking
+// earned, by the share of it that was just compensated, as Release pays a pending
+// reward by the share left of its transaction.
+func (s *TransactionServiceImpl) clawBack(
+	ctx context.Context, tx *gorm.DB, booking *booking, response *model.ReverseTransactionRes, userId string,
+) *exception.Exception {
+	original := booking.original
+	var compensated money.Money
+	for _, compensation := range response.Compensations {
+		if *compensation.ReversalOfId == original.Id {
+			compensated = compensation.Amount
+		}
+	}
+	rewards, errException := s.rewardService.CreditedTx(ctx, tx, original.Id)
+	if errException != nil {
+		return errException
+	}
+	ratio := big.NewRat(compensated.Normalize().Units, original.Amount.Normalize().Units)
+	for i := range rewards {
+		reward := &rewards[i]
+		if reward.TransactionId == nil {
+			continue
+		}
+		rewardBooking, errException := s.lockBooking(ctx, tx, *reward.TransactionId)
+		if errException != nil {
+			return errException
+		}
+		remaining := rewardBooking.original.RemainingAmount()
+		amount := rewardBooking.original.Amount.Normalize().MulRat(ratio, money.DefaultRounding)
+		if original.Status == entity.TransactionStatusReversed || remaining.LessThan(amount) {
+			amount = remaining
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		if _, errException := s.compensate(ctx, tx, rewardBooking, userId, amount, 0, "Clawback of: "); errException != nil {
+			return errException
+		}
+		if errException := s.rewardService.ClawBackTx(ctx, tx, reward, amount); errException != nil {
+			return errException
+		}
+	}
+	return nil
+}
+
+// changeRequestStatus writes the new status of a locked payment request along with its audit record.
+func (s *TransactionServiceImpl) changeRequestStatus(
+	ctx context.Context, tx *gorm.DB, request *entity.PaymentRequest, status, reason, userId string,
+) *exception.Exception {
+	change := model.NewPaymentRequestStatusChange(*request, status, reason, &userId)
+	request.Status = status
+	if err := s.paymentRequestRepository.UpdateTx(ctx, tx, request); err != nil {
+		return exception.Internal("failed updating payment request", err)
+	}
+	if err := s.requestChangeRepository.CreateTx(ctx, tx, change); err != nil {
+		return exception.Internal("failed recording payment request status change", err)
+	}
+	return nil
+}
+
+// booking is a transaction with every wallet transaction booked by the same journal entry.
+type booking struct {
+	original *entity.Transaction
+	related  []entity.Transaction
+	wallets  map[string]*entity.Wallet
+	entry    *entity.JournalEntry
+}
+
+// lockBooking locks the transactions of an entry and then their wallets, each in id
+// order with the house wallet last, so concurrent reversals of both sides of a
+// transfer cannot deadlock.
+func (s *TransactionServiceImpl) lockBooking(ctx context.Context, tx *gorm.DB, id string) (*booking, *exception.Exception) {
+	entry, errException := s.ledgerService.EntryOfTransaction(ctx, tx, id)
+	if errException != nil {
+		return nil, errException
+	}
+	var transactionIds []string
+	for _, line := range entry.Lines {
+		if line.TransactionId != nil {
+			transactionIds = append(transactionIds, *line.TransactionId)
+		}
+	}
+	related, err := s.transactionRepository.FindByIDsForUpdate(ctx, tx, transactionIds)
+	if err != nil {
+		return nil, exception.Internal("failed getting transaction detail", err)
+	}
+	result := &booking{
+		related: *related,
+		wallets: map[string]*entity.Wallet{},
+		entry:   entry,
+	}
+	var walletIds []string
+	for i := range result.related {
+		if result.related[i].Id == id {
+			result.original = &result.related[i]
+		}
+		walletIds = append(walletIds, result.related[i].WalletId)
+	}
+	if result.original == nil {
+		return nil, exception.NotFound("transaction not found")
+	}
+	if result.original.Type == "reversal" {
+		return nil, exception.PermissionDenied("a reversal cannot be reversed")
+	}
+	if result.original.Type == "fee" {
+		return nil, exception.PermissionDenied("a fee is reversed with the transaction it was charged on")
+	}
+	if result.original.Status == entity.TransactionStatusReversed {
+		return nil, exception.PermissionDenied("transaction is already reversed")
+	}
+	// the house wallet is locked last, like lockHouse does when a fee is charged
+	house := s.feeService.HouseWalletId()
+	var userWalletIds []string
+	for _, walletId := range walletIds {
+		if walletId != house {
+			userWalletIds = append(userWalletIds, walletId)
+		}
+	}
+	wallets, err := s.walletRepository.FindByIDsForUpdate(ctx, tx, userWalletIds)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	for i := range *wallets {
+		result.wallets[(*wallets)[i].Id] = &(*wallets)[i]
+	}
+	if len(userWalletIds) < len(walletIds) {
+		houseWallet, err := s.walletRepository.FindByIDForUpdate(ctx, tx, house)
+		if err != nil {
+			return nil, exception.Internal("failed getting house wallet", err)
+		}
+		if houseWallet != nil {
+			result.wallets[house] = houseWallet
+		}
+	}
+	return result, nil
+}
+
+// lockReversible locks the booking of a transaction a user undoes. Payouts are only given
+// back by PayoutServiceImpl.apply once they fail or are returned, and rewards are taken
+// back with the transaction that earned them.
+func (s *TransactionServiceImpl) lockReversible(ctx context.Context, tx *gorm.DB, id string) (*booking, *exception.Exception) {
+	// the type never changes, it is checked before locking anything as a reward is
+	// locked after the wallets when it is clawed back
+	transaction, err := s.transactionRepository.FindByID(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting transaction detail", err)
+	}
+	if transaction == nil {
+		return nil, exception.NotFound("transaction not found")
+	}
+	switch transaction.Type {
+	case "withdrawal":
+		return nil, exception.PermissionDenied("a payout is given back when it fails or is returned, it cannot be reversed")
+	case "reward":
+		return nil, exception.PermissionDenied("a reward is reversed with the transaction that earned it")
+	}
+	return s.lockBooking(ctx, tx, id)
+}
+
+// compensate books amount of the original transaction back, every other transaction
+// of the booking and every line of its entry is scaled by the same ratio.
+func (s *TransactionServiceImpl) compensate(
+	ctx context.Context, tx *gorm.DB, booking *booking, userId string, amount money.Money, quantity uint, prefix string,
+) (*model.ReverseTransactionRes, *exception.Exception) {
+	original := booking.original
+	if !amount.IsPositive() || !original.Amount.IsPositive() {
+		return nil, exception.PermissionDenied("nothing left to reverse on this transaction")
+	}
+	full := !amount.LessThan(original.RemainingAmount())
+	ratio := big.NewRat(amount.Units, original.Amount.Normalize().Units)
+
+	compensations := map[string]*entity.Transaction{}
+	response := &model.ReverseTransactionRes{}
+	for i := range booking.related {
+		related := &booking.related[i]
+		scaled := related.Amount.Normalize().MulRat(ratio, money.DefaultRounding)
+		if full {
+			scaled = related.RemainingAmount()
+		}
+		compensation := model.ReverseTransactionReq{UserId: userId}.ToReversalEntity(*related, scaled)
+		compensation.Description = prefix + related.Description
+		if related.Id == original.Id {
+			compensation.ProductQuantity = quantity
+		}
+		compensations[related.Id] = compensation
+	}
+
+	entry := entity.NewJournalEntry(prefix + original.Description)
+	for _, line := range booking.entry.Lines {
+		var compensationId *string
+		lineAmount := line.Debit
+		if line.Credit.IsPositive() {
+			lineAmount = line.Credit
+		}
+		lineAmount = lineAmount.Normalize().MulRat(ratio, money.DefaultRounding)
+		if line.TransactionId != nil {
+			compensation := compensations[*line.TransactionId]
+			compensationId = &compensation.Id
+			lineAmount = compensation.Amount
+		}
+		if !lineAmount.IsPositive() {
+			continue
+		}
+		var wallet *entity.Wallet
+		if line.Account != nil && line.Account.WalletId != nil {
+			wallet = booking.wallets[*line.Account.WalletId]
+		}
+		if line.Debit.IsPositive() {
+			if wallet != nil {
+				if errException := checkCredit(wallet); errException != nil {
+					return nil, errException
+				}
+			}
+			entry.Credit(line.AccountId, lineAmount, compensationId)
+			continue
+		}
+		// money that came into a wallet has to still be there to be taken back
+		if wallet != nil {
+			if errException := checkDebit(wallet); errException != nil {
+				return nil, errException
+			}
+			available, errException := availableBalance(ctx, tx, s.holdRepository, wallet)
+			if errException != nil {
+				return nil, errException
+			}
+			if available.LessThan(lineAmount) {
+				return nil, insufficientBalance(wallet.Name + " does not have enough balance to reverse this transaction. Available: " + converter.ToString(available))
+			}
+		}
+		entry.Debit(line.AccountId, lineAmount, compensationId)
+	}
+
+	for i := range booking.related {
+		related := &booking.related[i]
+		compensation := compensations[related.Id]
+		if err := s.transactionRepository.CreateTx(ctx, tx, compensation); err != nil {
+			return nil, exception.Internal("failed creating transaction", err)
+		}
+		refunded, err := related.RefundedAmount.WithCurrency(related.Amount.Normalize().Currency)
+		if err == nil {
+			refunded, err = refunded.Add(compensation.Amount)
+		}
+		if err != nil {
+			return nil, exception.Internal("failed computing refunded amount", err)
+		}
+		related.RefundedAmount = refunded
+		related.Status = entity.TransactionStatusPartiallyRefunded
+		if full {
+			related.Status = entity.TransactionStatusReversed
+		}
+		if related.Id == original.Id {
+			related.RefundedQuantity += quantity
+		}
+		if err := s.transactionRepository.UpdateTx(ctx, tx, related); err != nil {
+			return nil, exception.Internal("failed updating transaction", err)
+		}
+		response.Compensations = append(response.Compensations, *compensation)
+	}
+	if errException := s.ledgerService.Post(ctx, tx, entry); errException != nil {
+		return nil, errException
+	}
+	if quantity > 0 && original.ProductId != nil {
+		if err := s.productRepository.IncrementStockTx(ctx, tx, *original.ProductId, quantity); err != nil {
+			return nil, exception.Internal("failed updating product stock", err)
+		}
+	}
+	response.Original = *original
+	return response, nil
+}
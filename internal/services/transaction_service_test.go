@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"product-wallet/internal/entity"
+	"product-wallet/internal/model"
+	"product-wallet/internal/repository"
+	"product-wallet/pkg/money"
+	"testing"
+	"time"
+)
+
+func TestReverseTransfer(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	requests := NewPaymentRequestService(
+		env.db, repository.NewPaymentRequestSQLRepository(), repository.NewPaymentRequestStatusChangeSQLRepository(),
+		repository.NewUserSQLRepository(), env.walletRepository, env.memberRepository, env.transactionService,
+		env.validate, time.Hour, 24*time.Hour,
+	)
+	requester, requesterWallet := env.user(t, 0)
+	payer, payerWallet := env.user(t, 100000)
+	created, errException := requests.Create(ctx, &model.CreatePaymentRequestReq{
+		UserId: requester.Id, WalletId: requesterWallet.Id, PayerUsername: payer.Username, Amount: money.New(40000, "IDR"),
+	})
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+	paid, errException := requests.Pay(ctx, &model.PayPaymentRequestReq{
+		ID: created.Id, UserId: payer.Id, WalletId: payerWallet.Id,
+	})
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+
+	// the payer cannot pull the money back out of the requester's wallet
+	if _, errException := env.transactionService.Reverse(ctx, &model.ReverseTransactionReq{
+		ID: paid.Transfer.SenderTransaction.Id, UserId: payer.Id,
+	}); errException == nil {
+		t.Fatal("sender reversing a transfer: want permission denied")
+	}
+	if _, errException := env.transactionService.Reverse(ctx, &model.ReverseTransactionReq{
+		ID: paid.Transfer.ReceiverTransaction.Id, UserId: requester.Id,
+	}); errException != nil {
+		t.Fatal(errException.Message)
+	}
+
+	if got := env.wallet(t, payerWallet.Id).Balance.Units; got != 100000 {
+		t.Errorf("payer balance = %d, want 100000", got)
+	}
+	if got := env.wallet(t, requesterWallet.Id).Balance.Units; got != 0 {
+		t.Errorf("requester balance = %d, want 0", got)
+	}
+	detail, errException := requests.Detail(ctx, &model.GetPaymentRequestByIDReq{ID: created.Id, UserId: requester.Id})
+	if errException != nil {
+		t.Fatal(errException.Message)
+	}
+	if detail.Status != entity.PaymentRequestStatusPending || detail.SenderTransactionId != nil {
+		t.Errorf("payment request = %s paid by %v, want pending and unpaid", detail.Status, detail.SenderTransactionId)
+	}
+}
+
+func TestCaptureExpiredHold(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	owner, wallet := env.user(t, 10000)
+	// the hold ran out between being locked as active and being captured
+	expiredAt := time.Now().Add(-time.Minute)
+	hold := &entity.Hold{
+		Id: uuid.NewString(), WalletId: wallet.Id, Amount: money.New(15000, "IDR"),
+		Status: entity.HoldStatusAuthorized, ExpiresAt: &expiredAt,
+	}
+	if err := env.db.Create(hold).Error; err != nil {
+		t.Fatal(err)
+	}
+	tx := env.db.Begin()
+	defer tx.Rollback()
+	if _, errException := env.transactionService.CaptureTx(ctx, tx, hold, owner.Id, hold.Amount); errException == nil {
+		t.Fatal("capturing more than the balance: want insufficient balance")
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 	"product-wallet/internal/model"
 	"product-wallet/pkg/exception"
 )
@@ -19,5 +20,26 @@ type WalletService interface {
 	)
 	Find(ctx context.Context, req *model.GetAllWalletReq) (*model.GetAllWalletRes, *exception.Exception)
 	Detail(ctx context.Context, req *model.GetWalletByIDReq) (*model.GetWalletByIDRes, *exception.Exception)
-	Delete(ctx context.Context, req *model.DeleteWalletReq) (*model.DeleteWalletRes, *exception.Exception)
+	Close(ctx context.Context, req *model.CloseWalletReq) (*model.CloseWalletRes, *exception.Exception)
+	SetDefault(ctx context.Context, req *model.SetDefaultWalletReq) (*model.SetDefaultWalletRes, *exception.Exception)
+
+	// Lifecycle operations, every change of status is recorded with its reason
+	Freeze(ctx context.Context, req *model.ChangeWalletStatusReq) (*model.ChangeWalletStatusRes, *exception.Exception)
+	Suspend(ctx context.Context, req *model.ChangeWalletStatusReq) (*model.ChangeWalletStatusRes, *exception.Exception)
+	Unfreeze(ctx context.Context, req *model.ChangeWalletStatusReq) (*model.ChangeWalletStatusRes, *exception.Exception)
+	FindStatusChanges(ctx context.Context, req *model.GetAllWalletStatusChangeReq) (
+		*model.GetAllWalletStatusChangeRes, *exception.Exception,
+	)
+
+	// SetTier moves a wallet to another tier, admin only
+	SetTier(ctx context.Context, req *model.SetWalletTierReq) (*model.SetWalletTierRes, *exception.Exception)
+
+	// Members share a wallet, owners manage them and every member may leave
+	AddMember(ctx context.Context, req *model.AddWalletMemberReq) (*model.AddWalletMemberRes, *exception.Exception)
+	UpdateMember(ctx context.Context, req *model.UpdateWalletMemberReq) (*model.UpdateWalletMemberRes, *exception.Exception)
+	RemoveMember(ctx context.Context, req *model.RemoveWalletMemberReq) (*model.RemoveWalletMemberRes, *exception.Exception)
+	FindMembers(ctx context.Context, req *model.GetAllWalletMemberReq) (*model.GetAllWalletMemberRes, *exception.Exception)
+
+	// ExportStatement streams the transactions of a wallet to w, nothing is written when it fails early
+	ExportStatement(ctx context.Context, req *model.ExportStatementReq, w io.Writer) *exception.Exception
 }
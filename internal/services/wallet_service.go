@@ -3,37 +3,111 @@ package service
 import (
 	"context"
 	"gorm.io/gorm"
+	"io"
 	"product-wallet/internal/entity"
 	"product-wallet/internal/model"
 	"product-wallet/internal/repository"
 	"product-wallet/pkg/exception"
+	"product-wallet/pkg/money"
+	"product-wallet/pkg/statement"
 	"product-wallet/pkg/utils/converter"
 	"product-wallet/pkg/xvalidator"
+	"strings"
+	"time"
 )
 
 type WalletServiceImpl struct {
-	db               *gorm.DB
-	userRepository   repository.UserRepository
-	walletRepository repository.WalletRepository
-	transactionRepo  repository.TransactionRepository
-	validate         *xvalidator.Validator
+	db                      *gorm.DB
+	userRepository          repository.UserRepository
+	walletRepository        repository.WalletRepository
+	transactionRepo         repository.TransactionRepository
+	holdRepository          repository.HoldRepository
+	statusChangeRepository  repository.WalletStatusChangeRepository
+	memberRepository        repository.WalletMemberRepository
+	standingOrderRepository repository.StandingOrderRepository
+	transactionService      TransactionService
+	validate                *xvalidator.Validator
 }
 
 func NewWalletService(
 	db *gorm.DB, repo repository.WalletRepository,
 	userRepository repository.UserRepository,
 	transactionRepository repository.TransactionRepository,
+	holdRepository repository.HoldRepository,
+	statusChangeRepository repository.WalletStatusChangeRepository,
+	memberRepository repository.WalletMemberRepository,
+	standingOrderRepository repository.StandingOrderRepository,
+	transactionService TransactionService,
 	validate *xvalidator.Validator,
 ) WalletService {
 	return &WalletServiceImpl{
-		db:               db,
-		walletRepository: repo,
-		userRepository:   userRepository,
-		transactionRepo:  transactionRepository,
-		validate:         validate,
+		db:                      db,
+		walletRepository:        repo,
+		userRepository:          userRepository,
+		transactionRepo:         transactionRepository,
+		holdRepository:          holdRepository,
+		statusChangeRepository:  statusChangeRepository,
+		memberRepository:        memberRepository,
+		standingOrderRepository: standingOrderRepository,
+		transactionService:      transactionService,
+		validate:                validate,
 	}
 }
 
+// checkDebit refuses to take money out of a wallet that is not active.
+func checkDebit(wallet *entity.Wallet) *exception.Exception {
+	if wallet.CanDebit() {
+		return nil
+	}
+	return exception.PermissionDenied("wallet " + wallet.Name + " is " + wallet.StatusCode() + ", debits are blocked")
+}
+
+// checkCredit refuses to put money into a wallet that is suspended or closed.
+func checkCredit(wallet *entity.Wallet) *exception.Exception {
+	if wallet.CanCredit() {
+		return nil
+	}
+	return exception.PermissionDenied("wallet " + wallet.Name + " is " + wallet.StatusCode() + ", credits are blocked")
+}
+
+// authorizeMember returns the membership of the user on a wallet, refusing users
+// who are not members or whose role ranks below role.
+func authorizeMember(
+	ctx context.Context, tx *gorm.DB, memberRepository repository.WalletMemberRepository,
+	walletId, userId, role string,
+) (*entity.WalletMember, *exception.Exception) {
+	member, err := memberRepository.FindByWalletAndUser(ctx, tx, walletId, userId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet member", err)
+	}
+	if member == nil {
+		return nil, exception.PermissionDenied("you are not a member of this wallet")
+	}
+	if !member.Has(role) {
+		return nil, exception.PermissionDenied("this requires the " + role + " role on the wallet, you are " + member.Role)
+	}
+	return member, nil
+}
+
+// memberWalletFilter narrows a query to the wallets the user is a member of, it
+// is nil when the user is a member of none.
+func memberWalletFilter(
+	ctx context.Context, tx *gorm.DB, memberRepository repository.WalletMemberRepository, field, userId string,
+) (*model.FilterParam, *exception.Exception) {
+	ids, err := memberRepository.FindWalletIdsByUser(ctx, tx, userId)
+	if err != nil {
+		return nil, exception.Internal("failed getting member wallets", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return &model.FilterParam{
+		Field:    field,
+		Value:    strings.Join(ids, ","),
+		Operator: "in",
+	}, nil
+}
+
 // CreateExample creates a new campaign
 func (s *WalletServiceImpl) Create(
 	ctx context.Context, req *model.CreateWalletReq,
@@ -73,6 +147,15 @@ func (s *WalletServiceImpl) Create(
 	if err := s.walletRepository.CreateTx(ctx, tx, body); err != nil {
 		return nil, exception.Internal("err", err)
 	}
+	if err := s.memberRepository.CreateTx(ctx, tx, req.ToOwnerEntity(body.Id)); err != nil {
+		return nil, exception.Internal("failed adding wallet owner", err)
+	}
+	// the first wallet of a user receives the transfers to their username
+	if userCheck.DefaultWalletId == nil {
+		if err := s.userRepository.UpdateDefaultWalletTx(ctx, tx, userCheck.Id, body.Id); err != nil {
+			return nil, exception.Internal("failed setting default wallet", err)
+		}
+	}
 
 	if err := tx.Commit().Error; err != nil {
 		return nil, exception.Internal("commit transaction", err)
@@ -113,8 +196,22 @@ func (s *WalletServiceImpl) Update(
 	if duplicateCheck != nil && duplicateCheck.User.Id == userCheck.Id && duplicateCheck.Id != req.ID {
 		return nil, exception.PermissionDenied("wallet already exists")
 	}
-	body := req.ToEntity()
-	body.Id = req.ID
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.ID, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	body, err := s.walletRepository.FindByIDForUpdate(ctx, tx, req.ID)
+	if err != nil {
+		return nil, exception.Internal("error finding wallet", err)
+	}
+	if body == nil {
+		return nil, exception.NotFound("wallet not found")
+	}
+	if body.StatusCode() == entity.WalletStatusClosed {
+		return nil, exception.PermissionDenied("wallet is closed")
+	}
+	// the balance is owned by the ledger, only the descriptive fields are editable here
+	body.Name = req.Name
+	body.User = nil
 	if err := s.walletRepository.UpdateTx(ctx, tx, body); err != nil {
 		return nil, exception.Internal("err", err)
 	}
@@ -129,6 +226,9 @@ func (s *WalletServiceImpl) Update(
 func (s *WalletServiceImpl) DetailWalletTransaction(ctx context.Context, req model.GetWalletByTransactionReq) (
 	*model.GetWalletByTransactionRes, *exception.Exception,
 ) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.ID, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
 	wallet, err := s.walletRepository.FindByID(ctx, s.db, req.ID)
 	if err != nil {
 		return nil, exception.Internal("err", err)
@@ -175,7 +275,16 @@ func (s *WalletServiceImpl) DetailWalletTransaction(ctx context.Context, req mod
 func (s *WalletServiceImpl) Find(ctx context.Context, req *model.GetAllWalletReq) (
 	*model.GetAllWalletRes, *exception.Exception,
 ) {
-	result, err := s.walletRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, req.Filter)
+	members, errException := memberWalletFilter(ctx, s.db, s.memberRepository, "id", req.UserId)
+	if errException != nil {
+		return nil, errException
+	}
+	if members == nil {
+		return &model.GetAllWalletRes{
+			PaginationData: model.NewEmptyPaginationData[entity.Wallet](req.Page),
+		}, nil
+	}
+	result, err := s.walletRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, append(req.Filter, members))
 	if err != nil {
 		return nil, exception.Internal("err", err)
 	}
@@ -188,6 +297,9 @@ func (s *WalletServiceImpl) Find(ctx context.Context, req *model.GetAllWalletReq
 func (s *WalletServiceImpl) Detail(ctx context.Context, req *model.GetWalletByIDReq) (
 	*model.GetWalletByIDRes, *exception.Exception,
 ) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.ID, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
 	result, err := s.walletRepository.FindByID(ctx, s.db, req.ID)
 	if err != nil {
 		return nil, exception.Internal("err", err)
@@ -196,24 +308,513 @@ func (s *WalletServiceImpl) Detail(ctx context.Context, req *model.GetWalletByID
 		return nil, exception.PermissionDenied("wallet not found")
 	}
 
+	available, errException := availableBalance(ctx, s.db, s.holdRepository, result)
+	if errException != nil {
+		return nil, errException
+	}
+
 	return &model.GetWalletByIDRes{
-		Wallet: *result,
+		Wallet:           *result,
+		LedgerBalance:    result.Balance.Normalize(),
+		AvailableBalance: available,
+	}, nil
+}
+
+// Close takes a wallet out of use for good, its rows stay for the records. The
+// balance is swept to another wallet of the owner first when SweepTo is given.
+func (s *WalletServiceImpl) Close(ctx context.Context, req *model.CloseWalletReq) (
+	*model.CloseWalletRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	ids := []string{req.ID}
+	if req.SweepTo != "" {
+		if req.SweepTo == req.ID {
+			return nil, exception.InvalidArgument("cannot sweep a wallet into itself")
+		}
+		ids = append(ids, req.SweepTo)
+	}
+	wallets, err := s.walletRepository.FindByIDsForUpdate(ctx, tx, ids)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	var wallet, target *entity.Wallet
+	for i := range *wallets {
+		switch (*wallets)[i].Id {
+		case req.ID:
+			wallet = &(*wallets)[i]
+		case req.SweepTo:
+			target = &(*wallets)[i]
+		}
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet not found")
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, wallet.Id, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	if wallet.StatusCode() != entity.WalletStatusActive {
+		return nil, exception.PermissionDenied("wallet is " + wallet.StatusCode() + " and cannot be closed")
+	}
+	held, err := s.holdRepository.SumActiveTx(ctx, tx, wallet.Id, time.Now())
+	if err != nil {
+		return nil, exception.Internal("failed getting held amount", err)
+	}
+	if held > 0 {
+		return nil, exception.PermissionDenied("wallet has authorized holds, capture or void them before closing it")
+	}
+
+	response := &model.CloseWalletRes{}
+	if !wallet.Balance.IsZero() {
+		if req.SweepTo == "" {
+			return nil, exception.PermissionDenied("wallet balance must be zero to close it, sweep it to another wallet with sweep_to")
+		}
+		if target == nil {
+			return nil, exception.NotFound("sweep wallet not found")
+		}
+		if _, errException := authorizeMember(ctx, tx, s.memberRepository, target.Id, req.UserId, entity.WalletRoleOwner); errException != nil {
+			return nil, exception.PermissionDenied("the balance can only be swept to another wallet you own")
+		}
+		sweep, errException := s.transactionService.SweepTx(ctx, tx, req.UserId, wallet, target)
+		if errException != nil {
+			return nil, errException
+		}
+		response.Sweep = sweep
+		wallet.Balance = money.Zero(wallet.CurrencyCode())
+	}
+	if _, err := s.standingOrderRepository.CancelByWalletTx(ctx, tx, wallet.Id); err != nil {
+		return nil, exception.Internal("failed cancelling standing orders", err)
+	}
+	now := time.Now()
+	wallet.ClosedAt = &now
+	if errException := s.changeStatus(ctx, tx, wallet, entity.WalletStatusClosed, "closed by owner", req.UserId); errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	response.Wallet = *wallet
+	return response, nil
+}
+
+// SetDefault makes a wallet the user opened the one transfers to their username go to.
+func (s *WalletServiceImpl) SetDefault(ctx context.Context, req *model.SetDefaultWalletReq) (
+	*model.SetDefaultWalletRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, req.ID)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet not found")
+	}
+	if wallet.UserId != req.UserId {
+		return nil, exception.PermissionDenied("only a wallet you opened can receive the transfers to your username")
+	}
+	if wallet.StatusCode() == entity.WalletStatusClosed {
+		return nil, exception.PermissionDenied("wallet is closed")
+	}
+	if err := s.userRepository.UpdateDefaultWalletTx(ctx, tx, req.UserId, wallet.Id); err != nil {
+		return nil, exception.Internal("failed setting default wallet", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.SetDefaultWalletRes{
+		Wallet: *wallet,
+	}, nil
+}
+
+func (s *WalletServiceImpl) Freeze(ctx context.Context, req *model.ChangeWalletStatusReq) (
+	*model.ChangeWalletStatusRes, *exception.Exception,
+) {
+	return s.transition(ctx, req, entity.WalletStatusFrozen, entity.WalletStatusActive)
+}
+
+func (s *WalletServiceImpl) Suspend(ctx context.Context, req *model.ChangeWalletStatusReq) (
+	*model.ChangeWalletStatusRes, *exception.Exception,
+) {
+	return s.transition(ctx, req, entity.WalletStatusSuspended, entity.WalletStatusActive, entity.WalletStatusFrozen)
+}
+
+func (s *WalletServiceImpl) Unfreeze(ctx context.Context, req *model.ChangeWalletStatusReq) (
+	*model.ChangeWalletStatusRes, *exception.Exception,
+) {
+	return s.transition(ctx, req, entity.WalletStatusActive, entity.WalletStatusFrozen, entity.WalletStatusSuspended)
+}
+
+// transition moves a wallet to status when it currently is in one of from.
+func (s *WalletServiceImpl) transition(
+	ctx context.Context, req *model.ChangeWalletStatusReq, status string, from ...string,
+) (*model.ChangeWalletStatusRes, *exception.Exception) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	wallet, err := s.walletRepository.FindByIDForUpdate(ctx, tx, req.ID)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet not found")
+	}
+	allowed := false
+	for _, current := range from {
+		allowed = allowed || wallet.StatusCode() == current
+	}
+	if !allowed {
+		return nil, exception.PermissionDenied("wallet is " + wallet.StatusCode() + " and cannot become " + status)
+	}
+	if errException := s.changeStatus(ctx, tx, wallet, status, req.Reason, req.ChangedBy); errException != nil {
+		return nil, errException
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.ChangeWalletStatusRes{
+		Wallet: *wallet,
 	}, nil
 }
 
-func (s *WalletServiceImpl) Delete(ctx context.Context, req *model.DeleteWalletReq) (
-	*model.DeleteWalletRes, *exception.Exception,
+// SetTier moves a wallet to another tier, the fees of its next operations follow it.
+func (s *WalletServiceImpl) SetTier(ctx context.Context, req *model.SetWalletTierReq) (
+	*model.SetWalletTierRes, *exception.Exception,
 ) {
 	tx := s.db.Begin()
 	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	wallet, err := s.walletRepository.FindByIDForUpdate(ctx, tx, req.ID)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet not found")
+	}
+	if wallet.StatusCode() == entity.WalletStatusClosed {
+		return nil, exception.PermissionDenied("wallet is closed")
+	}
+	wallet.Tier = req.Tier
+	if err := s.walletRepository.UpdateTx(ctx, tx, wallet); err != nil {
+		return nil, exception.Internal("failed updating wallet", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.SetWalletTierRes{
+		Wallet: *wallet,
+	}, nil
+}
 
-	if err := s.walletRepository.DeleteByIDTx(ctx, tx, req.ID); err != nil {
+// changeStatus writes the new status of a locked wallet along with its audit record.
+func (s *WalletServiceImpl) changeStatus(
+	ctx context.Context, tx *gorm.DB, wallet *entity.Wallet, status, reason, changedBy string,
+) *exception.Exception {
+	change := model.NewWalletStatusChange(*wallet, status, reason, changedBy)
+	wallet.Status = status
+	if err := s.walletRepository.UpdateTx(ctx, tx, wallet); err != nil {
+		return exception.Internal("failed updating wallet", err)
+	}
+	if err := s.statusChangeRepository.CreateTx(ctx, tx, change); err != nil {
+		return exception.Internal("failed recording wallet status change", err)
+	}
+	return nil
+}
+
+func (s *WalletServiceImpl) FindStatusChanges(ctx context.Context, req *model.GetAllWalletStatusChangeReq) (
+	*model.GetAllWalletStatusChangeRes, *exception.Exception,
+) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "wallet_id",
+		Value:    req.WalletId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "desc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.statusChangeRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
 		return nil, exception.Internal("err", err)
 	}
+
+	return &model.GetAllWalletStatusChangeRes{
+		PaginationData: *result,
+	}, nil
+}
+
+// AddMember shares a wallet with the user going by req.Username.
+func (s *WalletServiceImpl) AddMember(ctx context.Context, req *model.AddWalletMemberReq) (
+	*model.AddWalletMemberRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.InvitedBy, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, tx, req.WalletId)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet detail", err)
+	}
+	if wallet == nil {
+		return nil, exception.NotFound("wallet not found")
+	}
+	if wallet.StatusCode() == entity.WalletStatusClosed {
+		return nil, exception.PermissionDenied("wallet is closed")
+	}
+	user, err := s.userRepository.FindByFilter(ctx, tx, model.FilterParams{
+		{
+			Field:    "username",
+			Value:    req.Username,
+			Operator: "=",
+		},
+	}, model.OrderParam{
+		Order:   "asc",
+		OrderBy: "username",
+	})
+	if err != nil {
+		return nil, exception.Internal("error finding user", err)
+	}
+	if user == nil {
+		return nil, exception.NotFound("user " + req.Username + " not found")
+	}
+	existing, err := s.memberRepository.FindByWalletAndUser(ctx, tx, wallet.Id, user.Id)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet member", err)
+	}
+	if existing != nil {
+		return nil, exception.AlreadyExists(req.Username + " is already a member of this wallet")
+	}
+	allowance, errException := memberAllowance(wallet, req.Allowance)
+	if errException != nil {
+		return nil, errException
+	}
+
+	member := req.ToEntity(user.Id, allowance)
+	if err := s.memberRepository.CreateTx(ctx, tx, member); err != nil {
+		return nil, exception.Internal("failed adding wallet member", err)
+	}
 	if err := tx.Commit().Error; err != nil {
 		return nil, exception.Internal("commit transaction", err)
 	}
-	return &model.DeleteWalletRes{
-		ID: req.ID,
+	return &model.AddWalletMemberRes{
+		WalletMember: *member,
 	}, nil
 }
+
+// memberAllowance puts an allowance in the currency of the wallet, none means no cap.
+func memberAllowance(wallet *entity.Wallet, allowance *money.Money) (money.Money, *exception.Exception) {
+	if allowance == nil {
+		return money.Zero(wallet.CurrencyCode()), nil
+	}
+	converted, err := allowance.WithCurrency(wallet.CurrencyCode())
+	if err != nil {
+		return money.Money{}, exception.InvalidArgument("allowance: " + err.Error())
+	}
+	if converted.IsNegative() {
+		return money.Money{}, exception.InvalidArgument("allowance cannot be negative, use zero to lift it")
+	}
+	return converted, nil
+}
+
+func (s *WalletServiceImpl) UpdateMember(ctx context.Context, req *model.UpdateWalletMemberReq) (
+	*model.UpdateWalletMemberRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+		return nil, errException
+	}
+	member, errException := s.findMemberForUpdate(ctx, tx, req.WalletId, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	if req.Role != "" && req.Role != member.Role {
+		if errException := s.keepAnOwner(ctx, tx, member); errException != nil {
+			return nil, errException
+		}
+		member.Role = req.Role
+	}
+	if req.Allowance != nil {
+		wallet, err := s.walletRepository.FindByID(ctx, tx, req.WalletId)
+		if err != nil {
+			return nil, exception.Internal("failed getting wallet detail", err)
+		}
+		if member.Allowance, errException = memberAllowance(wallet, req.Allowance); errException != nil {
+			return nil, errException
+		}
+	}
+	member.Wallet, member.User = nil, nil
+	if err := s.memberRepository.UpdateTx(ctx, tx, member); err != nil {
+		return nil, exception.Internal("failed updating wallet member", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.UpdateWalletMemberRes{
+		WalletMember: *member,
+	}, nil
+}
+
+// RemoveMember takes a member off a wallet, owners may remove anyone and every
+// member may leave.
+func (s *WalletServiceImpl) RemoveMember(ctx context.Context, req *model.RemoveWalletMemberReq) (
+	*model.RemoveWalletMemberRes, *exception.Exception,
+) {
+	tx := s.db.Begin()
+	defer tx.Rollback()
+	if errs := s.validate.Struct(req); errs != nil {
+		return nil, exception.InvalidArgument(errs)
+	}
+	member, errException := s.findMemberForUpdate(ctx, tx, req.WalletId, req.ID)
+	if errException != nil {
+		return nil, errException
+	}
+	if member.UserId != req.UserId {
+		if _, errException := authorizeMember(ctx, tx, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleOwner); errException != nil {
+			return nil, errException
+		}
+	}
+	if errException := s.keepAnOwner(ctx, tx, member); errException != nil {
+		return nil, errException
+	}
+	if err := s.memberRepository.DeleteByIDTx(ctx, tx, member.Id); err != nil {
+		return nil, exception.Internal("failed removing wallet member", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, exception.Internal("commit transaction", err)
+	}
+	return &model.RemoveWalletMemberRes{
+		ID: member.Id,
+	}, nil
+}
+
+// findMemberForUpdate locks the member of a wallet, members of other wallets are not found.
+func (s *WalletServiceImpl) findMemberForUpdate(
+	ctx context.Context, tx *gorm.DB, walletId, id string,
+) (*entity.WalletMember, *exception.Exception) {
+	member, err := s.memberRepository.FindByIDForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, exception.Internal("failed getting wallet member", err)
+	}
+	if member == nil || member.WalletId != walletId {
+		return nil, exception.NotFound("wallet member not found")
+	}
+	return member, nil
+}
+
+// keepAnOwner refuses to demote or remove member when it is the last owner of its wallet.
+func (s *WalletServiceImpl) keepAnOwner(ctx context.Context, tx *gorm.DB, member *entity.WalletMember) *exception.Exception {
+	if member.Role != entity.WalletRoleOwner {
+		return nil
+	}
+	owners, err := s.memberRepository.CountOwnersTx(ctx, tx, member.WalletId)
+	if err != nil {
+		return exception.Internal("failed counting wallet owners", err)
+	}
+	if owners <= 1 {
+		return exception.PermissionDenied("a wallet must keep at least one owner")
+	}
+	return nil
+}
+
+func (s *WalletServiceImpl) FindMembers(ctx context.Context, req *model.GetAllWalletMemberReq) (
+	*model.GetAllWalletMemberRes, *exception.Exception,
+) {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.WalletId, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return nil, errException
+	}
+	filter := append(req.Filter, &model.FilterParam{
+		Field:    "wallet_id",
+		Value:    req.WalletId,
+		Operator: "=",
+	})
+	if req.Sort.OrderBy == "" {
+		req.Sort = model.OrderParam{
+			Order:   "asc",
+			OrderBy: "created_at",
+		}
+	}
+	result, err := s.memberRepository.FindByPagination(ctx, s.db, req.Page, req.Sort, filter)
+	if err != nil {
+		return nil, exception.Internal("err", err)
+	}
+
+	return &model.GetAllWalletMemberRes{
+		PaginationData: *result,
+	}, nil
+}
+
+func (s *WalletServiceImpl) ExportStatement(
+	ctx context.Context, req *model.ExportStatementReq, w io.Writer,
+) *exception.Exception {
+	if _, errException := authorizeMember(ctx, s.db, s.memberRepository, req.ID, req.UserId, entity.WalletRoleViewer); errException != nil {
+		return errException
+	}
+	wallet, err := s.walletRepository.FindByID(ctx, s.db, req.ID)
+	if err != nil {
+		return exception.Internal("err", err)
+	}
+	if wallet == nil {
+		return exception.NotFound("wallet not found")
+	}
+	currency := wallet.CurrencyCode()
+	opening, err := s.transactionRepo.SumBalanceTx(ctx, s.db, wallet.Id, req.From)
+	if err != nil {
+		return exception.Internal("failed getting opening balance", err)
+	}
+
+	balance := money.New(opening, currency)
+	writer, err := statement.NewWriter(req.Format, w, statement.Account{
+		Id:       wallet.Id,
+		Currency: currency,
+		From:     req.From,
+		To:       req.To,
+	})
+	if err != nil {
+		return exception.Internal("failed writing statement", err)
+	}
+	err = s.transactionRepo.StreamByWallet(ctx, s.db, wallet.Id, req.From, req.To, func(transaction *entity.Transaction) error {
+		amount := transaction.SignedAmount()
+		balance.Units += amount.Units
+		line := statement.Line{
+			Id:          transaction.Id,
+			Type:        transaction.Type,
+			Description: transaction.Description,
+			Amount:      money.New(amount.Units, currency),
+			Balance:     balance,
+		}
+		if transaction.TransactionTime != nil {
+			line.Time = *transaction.TransactionTime
+		}
+		return writer.Write(line)
+	})
+	if err != nil {
+		return exception.Internal("failed writing statement", err)
+	}
+	if err := writer.Close(balance); err != nil {
+		return exception.Internal("failed writing statement", err)
+	}
+	return nil
+}
@@ -1,14 +1,242 @@
 package migration
 
 import (
+	"fmt"
+	"log/slog"
 	"product-wallet/internal/entity"
 	"product-wallet/pkg/database"
+	"product-wallet/pkg/money"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 func AutoMigration(CpmDB *database.Database) {
 	CpmDB.MigrateDB(
 		&entity.User{},
 		&entity.Wallet{},
+		&entity.WalletStatusChange{},
+		&entity.WalletMember{},
+		&entity.Category{},
 		&entity.Transaction{},
+		&entity.LedgerAccount{},
+		&entity.JournalEntry{},
+		&entity.JournalLine{},
+		&entity.ExchangeRate{},
+		&entity.IdempotencyKey{},
+		&entity.Hold{},
+		&entity.StandingOrder{},
+		&entity.StandingOrderRun{},
+		&entity.SpendingLimit{},
+		&entity.Statement{},
+		&entity.StatementLine{},
+		&entity.ReconciliationFinding{},
+		&entity.Budget{},
+		&entity.CategoryRule{},
+		&entity.BillSplit{},
+		&entity.PaymentRequest{},
+		&entity.PaymentRequestStatusChange{},
+		&entity.TopUp{},
+		&entity.PayoutDestination{},
+		&entity.Payout{},
+		&entity.FeeRule{},
+		&entity.Campaign{},
+		&entity.Reward{},
 	)
+	MigrateMoneyColumns(CpmDB)
+	MigrateCurrencies(CpmDB)
+	MigrateTransactionDirections(CpmDB)
+	MigrateWalletMembers(CpmDB)
+	MigrateCategories(CpmDB)
+	MigrateCounterparties(CpmDB)
+}
+
+// legacyMoneyColumns are the float64 columns replaced by money.Money minor units.
+var legacyMoneyColumns = []struct {
+	model  interface{}
+	column string
+	prefix string
+}{
+	{&entity.Wallet{}, "balance", "balance_"},
+	{&entity.Product{}, "price", "price_"},
+	{&entity.Transaction{}, "amount", "amount_"},
+	{&entity.LedgerAccount{}, "balance", "balance_"},
+	{&entity.JournalLine{}, "debit", "debit_"},
+	{&entity.JournalLine{}, "credit", "credit_"},
+}
+
+// MigrateMoneyColumns converts legacy float amounts into minor units of the default
+// currency, then drops the float column. Only rows whose currency was never written
+// are converted, so running it again never overwrites a newer amount.
+func MigrateMoneyColumns(CpmDB *database.Database) {
+	one, err := money.Parse("1", money.DefaultCurrency)
+	if err != nil {
+		slog.Error("failed to migrate money columns", "error", err.Error())
+		return
+	}
+	db := CpmDB.GetDB()
+	for _, legacy := range legacyMoneyColumns {
+		if !db.Migrator().HasColumn(legacy.model, legacy.column) {
+			continue
+		}
+		currency := legacy.prefix + "currency"
+		err := db.Model(legacy.model).
+			Where(legacy.column + " IS NOT NULL").
+			Where(currency + " IS NULL OR " + currency + " = ''").
+			Updates(map[string]interface{}{
+				legacy.prefix + "units": gorm.Expr(fmt.Sprintf("ROUND(%s * ?)", legacy.column), one.Units),
+				currency:                money.DefaultCurrency,
+			}).Error
+		if err != nil {
+			slog.Error("failed to migrate money column", "column", legacy.column, "error", err.Error())
+			continue
+		}
+		CpmDB.DropColumnDB(legacy.model, legacy.column)
+	}
+}
+
+// MigrateCurrencies pins wallets created before multi-currency support to the
+// currency of their balance and splits the legacy system accounts by currency.
+func MigrateCurrencies(CpmDB *database.Database) {
+	db := CpmDB.GetDB()
+	err := db.Model(&entity.Wallet{}).
+		Where("currency IS NULL OR currency = ''").
+		Update("currency", gorm.Expr("balance_currency")).Error
+	if err != nil {
+		slog.Error("failed to migrate wallet currency", "error", err.Error())
+	}
+
+	var accounts []entity.LedgerAccount
+	if err := db.Where("type <> ?", entity.LedgerAccountTypeWallet).Find(&accounts).Error; err != nil {
+		slog.Error("failed to migrate ledger account codes", "error", err.Error())
+		return
+	}
+	for _, account := range accounts {
+		if strings.Count(account.Code, ":") != 1 {
+			continue
+		}
+		code := entity.SystemAccountCode(account.Code, account.Balance.Normalize().Currency)
+		if err := db.Model(&entity.LedgerAccount{}).Where("id = ?", account.Id).
+			Update("code", code).Error; err != nil {
+			slog.Error("failed to migrate ledger account code", "code", account.Code, "error", err.Error())
+		}
+	}
+}
+
+// MigrateTransactionDirections sets the direction of the transactions booked before
+// it was recorded. The two sides of a transfer are told apart by their description,
+// a reversal goes the opposite way of the transaction it compensates.
+func MigrateTransactionDirections(CpmDB *database.Database) {
+	db := CpmDB.GetDB()
+	unset := "direction IS NULL OR direction = ''"
+	updates := []struct {
+		direction string
+		where     string
+		args      []interface{}
+	}{
+		{entity.TransactionDirectionOut, "type = ?", []interface{}{"expense"}},
+		{entity.TransactionDirectionIn, "type = ?", []interface{}{"income"}},
+		{entity.TransactionDirectionOut, "type = ? AND description LIKE ?", []interface{}{"transfer", "Transfer to:%"}},
+		{entity.TransactionDirectionIn, "type = ?", []interface{}{"transfer"}},
+	}
+	for _, update := range updates {
+		if err := db.Model(&entity.Transaction{}).Where(unset).Where(update.where, update.args...).
+			Update("direction", update.direction).Error; err != nil {
+			slog.Error("failed to migrate transaction direction", "error", err.Error())
+			return
+		}
+	}
+
+	var reversals []entity.Transaction
+	if err := db.Where(unset).Where("type = ? AND reversal_of_id IS NOT NULL", "reversal").
+		Find(&reversals).Error; err != nil {
+		slog.Error("failed to migrate reversal direction", "error", err.Error())
+		return
+	}
+	for _, reversal := range reversals {
+		var original entity.Transaction
+		if err := db.Where("id = ?", *reversal.ReversalOfId).First(&original).Error; err != nil {
+			slog.Error("failed to migrate reversal direction", "id", reversal.Id, "error", err.Error())
+			continue
+		}
+		if err := db.Model(&entity.Transaction{}).Where("id = ?", reversal.Id).
+			Update("direction", entity.ReversedDirection(original.Direction)).Error; err != nil {
+			slog.Error("failed to migrate reversal direction", "id", reversal.Id, "error", err.Error())
+		}
+	}
+}
+
+// MigrateWalletMembers makes the user of every wallet opened before wallets were
+// shared its owner, and runs their standing orders on behalf of that user.
+func MigrateWalletMembers(CpmDB *database.Database) {
+	db := CpmDB.GetDB()
+	walletTable := (&entity.Wallet{}).TableName()
+	memberTable := (&entity.WalletMember{}).TableName()
+	orderTable := (&entity.StandingOrder{}).TableName()
+	var wallets []entity.Wallet
+	if err := db.Where("NOT EXISTS (?)", db.Model(&entity.WalletMember{}).Select("1").
+		Where(fmt.Sprintf("%s.wallet_id = %s.id", memberTable, walletTable))).Find(&wallets).Error; err != nil {
+		slog.Error("failed to migrate wallet members", "error", err.Error())
+		return
+	}
+	for _, wallet := range wallets {
+		owner := &entity.WalletMember{
+			Id:       uuid.NewString(),
+			WalletId: wallet.Id,
+			UserId:   wallet.UserId,
+			Role:     entity.WalletRoleOwner,
+		}
+		if err := db.Create(owner).Error; err != nil {
+			slog.Error("failed to migrate wallet owner", "wallet_id", wallet.Id, "error", err.Error())
+		}
+	}
+
+	err := db.Model(&entity.StandingOrder{}).Where("created_by IS NULL").
+		Update("created_by", db.Model(&entity.Wallet{}).Select("user_id").
+			Where(fmt.Sprintf("%s.id = %s.wallet_id", walletTable, orderTable))).Error
+	if err != nil {
+		slog.Error("failed to migrate standing order creators", "error", err.Error())
+	}
+}
+
+// MigrateCategories seeds the system categories missing from the database.
+func MigrateCategories(CpmDB *database.Database) {
+	db := CpmDB.GetDB()
+	for _, system := range entity.SystemCategories {
+		var count int64
+		if err := db.Model(&entity.Category{}).Where("user_id IS NULL AND name = ?", system.Name).
+			Count(&count).Error; err != nil {
+			slog.Error("failed to migrate categories", "error", err.Error())
+			return
+		}
+		if count > 0 {
+			continue
+		}
+		category := system
+		category.Id = uuid.NewString()
+		if err := db.Create(&category).Error; err != nil {
+			slog.Error("failed to migrate category", "name", system.Name, "error", err.Error())
+		}
+	}
+}
+
+// MigrateCounterparties records the other wallet of the transfers, and of their
+// reversals, booked before it was kept on the transaction. It is the wallet of the
+// other transaction the same journal entry booked.
+func MigrateCounterparties(CpmDB *database.Database) {
+	db := CpmDB.GetDB()
+	transactionTable := (&entity.Transaction{}).TableName()
+	lineTable := (&entity.JournalLine{}).TableName()
+	counterparty := db.Table(lineTable + " AS own").
+		Joins(fmt.Sprintf("JOIN %s AS other ON other.entry_id = own.entry_id AND other.transaction_id <> own.transaction_id", lineTable)).
+		Joins(fmt.Sprintf("JOIN %s AS counterparty ON counterparty.id = other.transaction_id", transactionTable)).
+		Where(fmt.Sprintf("own.transaction_id = %s.id", transactionTable)).
+		Select("counterparty.wallet_id").Limit(1)
+	err := db.Model(&entity.Transaction{}).
+		Where("counterparty_wallet_id IS NULL AND type IN ?", []string{"transfer", "reversal"}).
+		Update("counterparty_wallet_id", counterparty).Error
+	if err != nil {
+		slog.Error("failed to migrate transaction counterparties", "error", err.Error())
+	}
 }
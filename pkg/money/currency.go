@@ -0,0 +1,53 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// currencies maps ISO 4217 codes to the number of minor-unit digits.
+var currencies = map[string]int{
+	"IDR": 2,
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"SGD": 2,
+	"MYR": 2,
+	"AUD": 2,
+	"CNY": 2,
+	"JPY": 0,
+	"KRW": 0,
+}
+
+// DefaultCurrency is used for amounts that do not state a currency.
+var DefaultCurrency = "IDR"
+
+// IsKnownCurrency reports whether code is a supported ISO 4217 currency.
+func IsKnownCurrency(code string) bool {
+	_, ok := currencies[strings.ToUpper(code)]
+	return ok
+}
+
+// Exponent returns the number of minor-unit digits of a currency.
+func Exponent(code string) (int, error) {
+	exp, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		return 0, fmt.Errorf("unsupported currency %q", code)
+	}
+	return exp, nil
+}
+
+func normalizeCurrency(code string) string {
+	if code == "" {
+		return DefaultCurrency
+	}
+	return strings.ToUpper(code)
+}
+
+func pow10(exp int) int64 {
+	result := int64(1)
+	for i := 0; i < exp; i++ {
+		result *= 10
+	}
+	return result
+}
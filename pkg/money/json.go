@@ -0,0 +1,99 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Encoding selects how the amount of a Money value is written to JSON.
+type Encoding string
+
+const (
+	EncodingString  Encoding = "string"  // "amount": "1500.25", major units as a decimal string.
+	EncodingInteger Encoding = "integer" // "amount": 150025, minor units as an integer.
+)
+
+// JSONEncoding is the active encoding, it also decides how a bare JSON number is read.
+var JSONEncoding = EncodingString
+
+// ParseEncoding validates a configured JSON encoding.
+func ParseEncoding(encoding string) (Encoding, error) {
+	switch e := Encoding(encoding); e {
+	case EncodingString, EncodingInteger:
+		return e, nil
+	default:
+		return "", fmt.Errorf("unsupported money encoding %q", encoding)
+	}
+}
+
+type jsonMoney struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	m = m.Normalize()
+	var amount any = m.Decimal()
+	if JSONEncoding == EncodingInteger {
+		amount = m.Units
+	}
+	return json.Marshal(struct {
+		Amount   any    `json:"amount"`
+		Currency string `json:"currency"`
+	}{amount, m.Currency})
+}
+
+// UnmarshalJSON accepts {"amount": ..., "currency": "USD"} as well as a bare amount.
+// A string amount is always a decimal in major units; a number amount follows
+// JSONEncoding, so it is minor units under EncodingInteger and major units otherwise.
+// A missing currency is left empty for the service to resolve with WithCurrency.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	raw := jsonMoney{Amount: data}
+	if len(data) > 0 && data[0] == '{' {
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		if raw.Currency != "" && !IsKnownCurrency(raw.Currency) {
+			return fmt.Errorf("unsupported currency %q", raw.Currency)
+		}
+	}
+	parsed, err := parseAmount(raw.Amount, raw.Currency)
+	if err != nil {
+		return err
+	}
+	if raw.Currency == "" {
+		parsed.Currency = ""
+	}
+	*m = parsed
+	return nil
+}
+
+func parseAmount(data json.RawMessage, currency string) (Money, error) {
+	if len(data) == 0 {
+		return Money{}, fmt.Errorf("missing amount")
+	}
+	if data[0] == '"' {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return Money{}, err
+		}
+		return Parse(value, currency)
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return Money{}, fmt.Errorf("invalid amount %s", data)
+	}
+	if JSONEncoding == EncodingInteger {
+		units, err := number.Int64()
+		if err != nil {
+			return Money{}, fmt.Errorf("amount %s must be an integer number of minor units", number)
+		}
+		return New(units, currency), nil
+	}
+	return Parse(number.String(), currency)
+}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// withEncoding runs fn with JSONEncoding set to encoding and restores it after.
+func withEncoding(t *testing.T, encoding Encoding, fn func()) {
+	t.Helper()
+	previous := JSONEncoding
+	JSONEncoding = encoding
+	defer func() { JSONEncoding = previous }()
+	fn()
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		encoding Encoding
+		money    Money
+		want     string
+	}{
+		{EncodingString, Money{150025, "USD"}, `{"amount":"1500.25","currency":"USD"}`},
+		{EncodingString, Money{-5, ""}, `{"amount":"-0.05","currency":"IDR"}`},
+		{EncodingString, Money{1500, "JPY"}, `{"amount":"1500","currency":"JPY"}`},
+		{EncodingInteger, Money{150025, "USD"}, `{"amount":150025,"currency":"USD"}`},
+		{EncodingInteger, Money{0, ""}, `{"amount":0,"currency":"IDR"}`},
+	}
+	for _, tt := range tests {
+		withEncoding(t, tt.encoding, func() {
+			got, err := json.Marshal(tt.money)
+			if err != nil || string(got) != tt.want {
+				t.Errorf("%s Marshal(%#v) = %s, %v, want %s", tt.encoding, tt.money, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding Encoding
+		data     string
+		want     Money
+		wantErr  bool
+	}{
+		{name: "object string", encoding: EncodingString, data: `{"amount":"1500.25","currency":"USD"}`, want: Money{150025, "USD"}},
+		{name: "object number", encoding: EncodingString, data: `{"amount":1500.25,"currency":"USD"}`, want: Money{150025, "USD"}},
+		{name: "bare string", encoding: EncodingString, data: `"12.50"`, want: Money{1250, ""}},
+		{name: "bare number", encoding: EncodingString, data: `12`, want: Money{1200, ""}},
+		{name: "integer minor units", encoding: EncodingInteger, data: `{"amount":150025,"currency":"USD"}`, want: Money{150025, "USD"}},
+		{name: "integer bare number", encoding: EncodingInteger, data: `1250`, want: Money{1250, ""}},
+		{name: "integer still reads strings", encoding: EncodingInteger, data: `"12.50"`, want: Money{1250, ""}},
+		{name: "integer fraction", encoding: EncodingInteger, data: `12.5`, wantErr: true},
+		{name: "too many decimals", encoding: EncodingString, data: `"1.005"`, wantErr: true},
+		{name: "unknown currency", encoding: EncodingString, data: `{"amount":"1","currency":"XYZ"}`, wantErr: true},
+		{name: "missing amount", encoding: EncodingString, data: `{"currency":"USD"}`, wantErr: true},
+		{name: "not a number", encoding: EncodingString, data: `true`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withEncoding(t, tt.encoding, func() {
+				var got Money
+				err := json.Unmarshal([]byte(tt.data), &got)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Unmarshal(%s) error = %v, wantErr %v", tt.data, err, tt.wantErr)
+				}
+				if !tt.wantErr && got != tt.want {
+					t.Errorf("Unmarshal(%s) = %#v, want %#v", tt.data, got, tt.want)
+				}
+			})
+		})
+	}
+}
+
+func TestUnmarshalJSONNull(t *testing.T) {
+	got := Money{100, "USD"}
+	if err := json.Unmarshal([]byte(`null`), &got); err != nil || got != (Money{100, "USD"}) {
+		t.Errorf("Unmarshal(null) = %v, %v, want the value left alone", got, err)
+	}
+}
+
+func TestParseEncoding(t *testing.T) {
+	for _, encoding := range []string{"string", "integer"} {
+		if got, err := ParseEncoding(encoding); err != nil || string(got) != encoding {
+			t.Errorf("ParseEncoding(%q) = %q, %v", encoding, got, err)
+		}
+	}
+	if _, err := ParseEncoding("float"); err == nil {
+		t.Error("ParseEncoding accepted an unknown encoding")
+	}
+}
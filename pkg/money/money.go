@@ -0,0 +1,248 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	ErrOverflow         = errors.New("amount out of range")
+)
+
+// Money is an exact amount stored as integer minor units of a currency.
+// Entities embed it with a column prefix, e.g. `gorm:"embedded;embeddedPrefix:balance_"`
+// becomes the balance_units and balance_currency columns.
+type Money struct {
+	Units    int64  `gorm:"default:0" json:"amount" swaggertype:"string" example:"1500.00"`
+	Currency string `gorm:"size:3" json:"currency" example:"IDR"`
+}
+
+// New creates Money from minor units, an empty currency means DefaultCurrency.
+func New(units int64, currency string) Money {
+	return Money{Units: units, Currency: normalizeCurrency(currency)}
+}
+
+// Zero returns an empty amount in the given currency.
+func Zero(currency string) Money {
+	return New(0, currency)
+}
+
+// Parse reads a decimal amount in major units such as "1500.25" or "-3".
+// More fractional digits than the currency allows is an error, not a rounding.
+func Parse(value, currency string) (Money, error) {
+	currency = normalizeCurrency(currency)
+	exp, err := Exponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimLeft(value, "+-")
+	whole, fraction, _ := strings.Cut(value, ".")
+	if whole == "" && fraction == "" {
+		return Money{}, fmt.Errorf("invalid amount %q", value)
+	}
+	if len(fraction) > exp {
+		return Money{}, fmt.Errorf("amount %q has more than %d decimal places for %s", value, exp, currency)
+	}
+	fraction += strings.Repeat("0", exp-len(fraction))
+	if whole == "" {
+		whole = "0"
+	}
+	units, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q", value)
+	}
+	if negative {
+		units = -units
+	}
+	return Money{Units: units, Currency: currency}, nil
+}
+
+// Normalize fills in DefaultCurrency for amounts that did not state one.
+func (m Money) Normalize() Money {
+	m.Currency = normalizeCurrency(m.Currency)
+	return m
+}
+
+// WithCurrency assigns a currency to an amount that did not state one. Such an
+// amount was read with the DefaultCurrency scale and is rescaled to the target.
+func (m Money) WithCurrency(currency string) (Money, error) {
+	currency = normalizeCurrency(currency)
+	if m.Currency != "" {
+		if m.Normalize().Currency != currency {
+			return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, currency)
+		}
+		return m.Normalize(), nil
+	}
+	from, err := Exponent(DefaultCurrency)
+	if err != nil {
+		return Money{}, err
+	}
+	to, err := Exponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	units := m.Units
+	switch {
+	case to > from:
+		units *= pow10(to - from)
+	case to < from:
+		scale := pow10(from - to)
+		if units%scale != 0 {
+			return Money{}, fmt.Errorf("amount %s has too many decimal places for %s", m.Decimal(), currency)
+		}
+		units /= scale
+	}
+	return Money{Units: units, Currency: currency}, nil
+}
+
+func (m Money) IsZero() bool {
+	return m.Units == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.Units > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Units < 0
+}
+
+func (m Money) SameCurrency(o Money) bool {
+	return m.Normalize().Currency == o.Normalize().Currency
+}
+
+// Cmp compares two amounts of the same currency, returning -1, 0 or +1.
+func (m Money) Cmp(o Money) (int, error) {
+	if !m.SameCurrency(o) {
+		return 0, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	switch {
+	case m.Units < o.Units:
+		return -1, nil
+	case m.Units > o.Units:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// LessThan reports whether m is smaller than o; amounts in different currencies never compare.
+func (m Money) LessThan(o Money) bool {
+	c, err := m.Cmp(o)
+	return err == nil && c < 0
+}
+
+func (m Money) Add(o Money) (Money, error) {
+	if !m.SameCurrency(o) {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	return Money{Units: m.Units + o.Units, Currency: m.Normalize().Currency}, nil
+}
+
+func (m Money) Sub(o Money) (Money, error) {
+	return m.Add(o.Neg())
+}
+
+func (m Money) Neg() Money {
+	m.Units = -m.Units
+	return m
+}
+
+func (m Money) Abs() Money {
+	if m.Units < 0 {
+		return m.Neg()
+	}
+	return m
+}
+
+// Mul multiplies by a whole quantity, which never needs rounding but can leave
+// the range of the minor units.
+func (m Money) Mul(quantity int64) (Money, error) {
+	units := new(big.Int).Mul(big.NewInt(m.Units), big.NewInt(quantity))
+	if !units.IsInt64() {
+		return Money{}, fmt.Errorf("%w: %s times %d", ErrOverflow, m, quantity)
+	}
+	m.Units = units.Int64()
+	return m, nil
+}
+
+// MulRat multiplies by an exact ratio and rounds the result to minor units.
+func (m Money) MulRat(ratio *big.Rat, mode RoundingMode) Money {
+	r := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Units), ratio)
+	m.Units = round(r, mode)
+	return m
+}
+
+// Percent returns the given share of the amount expressed in basis points (1% = 100).
+func (m Money) Percent(basisPoints int64, mode RoundingMode) Money {
+	return m.MulRat(big.NewRat(basisPoints, 10000), mode)
+}
+
+// Allocate splits the amount by weights without losing a minor unit. Each share is
+// rounded down and the remainder is handed out one unit at a time from the first
+// share onwards, so the same input always produces the same split.
+func (m Money) Allocate(weights ...int64) ([]Money, error) {
+	var total int64
+	for _, w := range weights {
+		if w < 0 {
+			return nil, errors.New("allocation weights must not be negative")
+		}
+		total += w
+	}
+	if total == 0 {
+		return nil, errors.New("allocation weights must not all be zero")
+	}
+	shares := make([]Money, len(weights))
+	remainder := m.Abs().Units
+	for i, w := range weights {
+		units := new(big.Int).Div(
+			new(big.Int).Mul(big.NewInt(m.Abs().Units), big.NewInt(w)), big.NewInt(total),
+		).Int64()
+		shares[i] = Money{Units: units, Currency: m.Normalize().Currency}
+		remainder -= units
+	}
+	for i := 0; remainder > 0; i = (i + 1) % len(shares) {
+		if weights[i] == 0 {
+			continue
+		}
+		shares[i].Units++
+		remainder--
+	}
+	if m.IsNegative() {
+		for i := range shares {
+			shares[i] = shares[i].Neg()
+		}
+	}
+	return shares, nil
+}
+
+// Decimal renders the amount in major units, e.g. "1500.25".
+func (m Money) Decimal() string {
+	m = m.Normalize()
+	exp, err := Exponent(m.Currency)
+	if err != nil {
+		exp = 2
+	}
+	sign := ""
+	units := m.Units
+	if units < 0 {
+		sign = "-"
+		units = -units
+	}
+	if exp == 0 {
+		return sign + strconv.FormatInt(units, 10)
+	}
+	scale := pow10(exp)
+	return fmt.Sprintf("%s%d.%0*d", sign, units/scale, exp, units%scale)
+}
+
+// String renders the amount for humans, e.g. "IDR 1500.25".
+func (m Money) String() string {
+	return m.Normalize().Currency + " " + m.Decimal()
+}
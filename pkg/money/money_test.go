@@ -0,0 +1,231 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		currency string
+		want     Money
+		wantErr  bool
+	}{
+		{name: "whole", value: "1500", currency: "IDR", want: Money{150000, "IDR"}},
+		{name: "fraction", value: "1500.25", currency: "USD", want: Money{150025, "USD"}},
+		{name: "short fraction", value: "1.5", currency: "USD", want: Money{150, "USD"}},
+		{name: "no whole part", value: ".5", currency: "USD", want: Money{50, "USD"}},
+		{name: "negative", value: "-3", currency: "USD", want: Money{-300, "USD"}},
+		{name: "explicit plus", value: "+3", currency: "USD", want: Money{300, "USD"}},
+		{name: "surrounding spaces", value: " 2.10 ", currency: "USD", want: Money{210, "USD"}},
+		{name: "default currency", value: "1", currency: "", want: Money{100, DefaultCurrency}},
+		{name: "lower case currency", value: "1", currency: "usd", want: Money{100, "USD"}},
+		{name: "zero exponent", value: "1500", currency: "JPY", want: Money{1500, "JPY"}},
+		{name: "too many decimals", value: "1.005", currency: "USD", wantErr: true},
+		{name: "decimals on zero exponent", value: "1.5", currency: "JPY", wantErr: true},
+		{name: "empty", value: "", currency: "USD", wantErr: true},
+		{name: "dot only", value: ".", currency: "USD", wantErr: true},
+		{name: "letters", value: "12a", currency: "USD", wantErr: true},
+		{name: "unknown currency", value: "1", currency: "XYZ", wantErr: true},
+		{name: "out of range", value: "92233720368547758.08", currency: "USD", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value, tt.currency)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q, %q) error = %v, wantErr %v", tt.value, tt.currency, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Parse(%q, %q) = %v, want %v", tt.value, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{Money{150025, "USD"}, "1500.25"},
+		{Money{5, "USD"}, "0.05"},
+		{Money{-5, "USD"}, "-0.05"},
+		{Money{-150000, "IDR"}, "-1500.00"},
+		{Money{1500, "JPY"}, "1500"},
+		{Money{0, ""}, "0.00"},
+	}
+	for _, tt := range tests {
+		if got := tt.money.Decimal(); got != tt.want {
+			t.Errorf("%#v.Decimal() = %q, want %q", tt.money, got, tt.want)
+		}
+	}
+}
+
+func TestWithCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		currency string
+		want     Money
+		wantErr  bool
+	}{
+		{name: "same currency", money: Money{100, "USD"}, currency: "usd", want: Money{100, "USD"}},
+		{name: "other currency", money: Money{100, "USD"}, currency: "EUR", wantErr: true},
+		{name: "unstated keeps scale", money: Money{150, ""}, currency: "USD", want: Money{150, "USD"}},
+		{name: "unstated scaled down", money: Money{1500, ""}, currency: "JPY", want: Money{15, "JPY"}},
+		{name: "unstated with lost decimals", money: Money{1550, ""}, currency: "JPY", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.money.WithCurrency(tt.currency)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WithCurrency(%q) error = %v, wantErr %v", tt.currency, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("WithCurrency(%q) = %v, want %v", tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddAndCompare(t *testing.T) {
+	if _, err := New(1, "USD").Add(New(1, "EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add across currencies error = %v, want ErrCurrencyMismatch", err)
+	}
+	sum, err := New(100, "").Add(New(50, "IDR"))
+	if err != nil || sum != (Money{150, "IDR"}) {
+		t.Errorf("Add = %v, %v, want IDR 1.50", sum, err)
+	}
+	if !New(1, "USD").LessThan(New(2, "USD")) || New(2, "USD").LessThan(New(1, "USD")) {
+		t.Error("LessThan does not order amounts of the same currency")
+	}
+	if New(1, "USD").LessThan(New(2, "EUR")) {
+		t.Error("LessThan compared amounts of different currencies")
+	}
+}
+
+func TestMul(t *testing.T) {
+	tests := []struct {
+		name     string
+		units    int64
+		quantity int64
+		want     int64
+		wantErr  bool
+	}{
+		{name: "quantity", units: 1500, quantity: 3, want: 4500},
+		{name: "zero", units: 1500, quantity: 0, want: 0},
+		{name: "negative", units: -1500, quantity: 2, want: -3000},
+		{name: "largest", units: math.MaxInt64 / 2, quantity: 2, want: math.MaxInt64 - 1},
+		{name: "overflow", units: math.MaxInt64/2 + 1, quantity: 2, wantErr: true},
+		{name: "negative overflow", units: math.MinInt64, quantity: -1, wantErr: true},
+		{name: "large quantity", units: 100, quantity: math.MaxInt64, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.units, "USD").Mul(tt.quantity)
+			if tt.wantErr {
+				if !errors.Is(err, ErrOverflow) {
+					t.Fatalf("Mul(%d) error = %v, want ErrOverflow", tt.quantity, err)
+				}
+				return
+			}
+			if err != nil || got.Units != tt.want || got.Currency != "USD" {
+				t.Errorf("Mul(%d) = %v, %v, want %d units", tt.quantity, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMulRat(t *testing.T) {
+	tests := []struct {
+		name  string
+		units int64
+		ratio *big.Rat
+		mode  RoundingMode
+		want  int64
+	}{
+		{name: "exact", units: 1000, ratio: big.NewRat(3, 2), mode: RoundHalfEven, want: 1500},
+		{name: "third down", units: 100, ratio: big.NewRat(1, 3), mode: RoundDown, want: 33},
+		{name: "third up", units: 100, ratio: big.NewRat(1, 3), mode: RoundUp, want: 34},
+		{name: "half even tie", units: 5, ratio: big.NewRat(1, 2), mode: RoundHalfEven, want: 2},
+		{name: "half up tie", units: 5, ratio: big.NewRat(1, 2), mode: RoundHalfUp, want: 3},
+		{name: "negative half up tie", units: -5, ratio: big.NewRat(1, 2), mode: RoundHalfUp, want: -3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := New(tt.units, "USD").MulRat(tt.ratio, tt.mode); got.Units != tt.want {
+				t.Errorf("MulRat(%v, %s) = %d, want %d", tt.ratio, tt.mode, got.Units, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		name        string
+		units       int64
+		basisPoints int64
+		mode        RoundingMode
+		want        int64
+	}{
+		{name: "one percent", units: 10000, basisPoints: 100, mode: RoundHalfEven, want: 100},
+		{name: "fraction of a unit", units: 150, basisPoints: 250, mode: RoundHalfEven, want: 4},
+		{name: "tie to even", units: 50, basisPoints: 1000, mode: RoundHalfEven, want: 5},
+		{name: "tie rounded down to even", units: 25, basisPoints: 1000, mode: RoundHalfEven, want: 2},
+		{name: "tie rounded up", units: 25, basisPoints: 1000, mode: RoundHalfUp, want: 3},
+		{name: "truncated", units: 199, basisPoints: 100, mode: RoundDown, want: 1},
+		{name: "whole", units: 199, basisPoints: 10000, mode: RoundDown, want: 199},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := New(tt.units, "USD").Percent(tt.basisPoints, tt.mode); got.Units != tt.want {
+				t.Errorf("Percent(%d, %s) of %d = %d, want %d", tt.basisPoints, tt.mode, tt.units, got.Units, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		units   int64
+		weights []int64
+		want    []int64
+		wantErr bool
+	}{
+		{name: "even", units: 900, weights: []int64{1, 1, 1}, want: []int64{300, 300, 300}},
+		{name: "remainder from the first share", units: 1000, weights: []int64{1, 1, 1}, want: []int64{334, 333, 333}},
+		{name: "remainder of two", units: 1001, weights: []int64{1, 1, 1}, want: []int64{334, 334, 333}},
+		{name: "weighted", units: 100, weights: []int64{70, 30}, want: []int64{70, 30}},
+		{name: "zero weight skipped", units: 5, weights: []int64{0, 1, 1}, want: []int64{0, 3, 2}},
+		{name: "negative amount", units: -1000, weights: []int64{1, 1, 1}, want: []int64{-334, -333, -333}},
+		{name: "more shares than units", units: 2, weights: []int64{1, 1, 1}, want: []int64{1, 1, 0}},
+		{name: "negative weight", units: 100, weights: []int64{1, -1}, wantErr: true},
+		{name: "zero weights", units: 100, weights: []int64{0, 0}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := New(tt.units, "USD").Allocate(tt.weights...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Allocate(%v) error = %v, wantErr %v", tt.weights, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var total int64
+			for i, share := range shares {
+				if share.Units != tt.want[i] || share.Currency != "USD" {
+					t.Errorf("Allocate(%v)[%d] = %v, want %d units", tt.weights, i, share, tt.want[i])
+				}
+				total += share.Units
+			}
+			if total != tt.units {
+				t.Errorf("Allocate(%v) shares add up to %d, want %d", tt.weights, total, tt.units)
+			}
+		})
+	}
+}
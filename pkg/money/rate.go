@@ -0,0 +1,138 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// rateDecimals is the precision a Rate keeps when it is written out.
+const rateDecimals = 12
+
+// Rate is an exact exchange rate: one unit of the base currency buys Rate units
+// of the quote currency. It is stored as a decimal string column.
+type Rate struct {
+	rat *big.Rat
+}
+
+// ParseRate reads a decimal rate such as "15750.25".
+func ParseRate(value string) (Rate, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return Rate{}, fmt.Errorf("invalid rate %q", value)
+	}
+	return Rate{rat: r}, nil
+}
+
+// OneRate is the rate between a currency and itself.
+func OneRate() Rate {
+	return Rate{rat: big.NewRat(1, 1)}
+}
+
+func (r Rate) Rat() *big.Rat {
+	if r.rat == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(r.rat)
+}
+
+func (r Rate) IsPositive() bool {
+	return r.rat != nil && r.rat.Sign() > 0
+}
+
+// Inverse returns the rate of the opposite direction.
+func (r Rate) Inverse() Rate {
+	if !r.IsPositive() {
+		return Rate{}
+	}
+	return Rate{rat: new(big.Rat).Inv(r.rat)}
+}
+
+func (r Rate) String() string {
+	if r.rat == nil {
+		return "0"
+	}
+	value := r.rat.FloatString(rateDecimals)
+	value = strings.TrimRight(value, "0")
+	return strings.TrimSuffix(value, ".")
+}
+
+// Convert exchanges m into the target currency at rate, rounding to its minor units.
+func Convert(m Money, rate Rate, currency string, mode RoundingMode) (Money, error) {
+	m = m.Normalize()
+	currency = normalizeCurrency(currency)
+	if !rate.IsPositive() {
+		return Money{}, fmt.Errorf("rate from %s to %s must be positive", m.Currency, currency)
+	}
+	from, err := Exponent(m.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+	to, err := Exponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	ratio := rate.Rat()
+	if to > from {
+		ratio.Mul(ratio, new(big.Rat).SetInt64(pow10(to-from)))
+	} else if to < from {
+		ratio.Quo(ratio, new(big.Rat).SetInt64(pow10(from-to)))
+	}
+	converted := m.MulRat(ratio, mode)
+	converted.Currency = currency
+	return converted, nil
+}
+
+func (r Rate) Value() (driver.Value, error) {
+	if r.rat == nil {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+func (r *Rate) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*r = Rate{}
+		return nil
+	case string:
+		parsed, err := ParseRate(v)
+		*r = parsed
+		return err
+	case []byte:
+		parsed, err := ParseRate(string(v))
+		*r = parsed
+		return err
+	case float64:
+		*r = Rate{rat: new(big.Rat).SetFloat64(v)}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into rate", src)
+	}
+}
+
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON accepts the rate as a decimal string or a JSON number.
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	var value json.Number
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		value = json.Number(text)
+	} else if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseRate(value.String())
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
@@ -0,0 +1,60 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode decides what happens to fractions of a minor unit.
+type RoundingMode string
+
+const (
+	RoundHalfEven RoundingMode = "half_even" // Banker's rounding, the default.
+	RoundHalfUp   RoundingMode = "half_up"   // Ties away from zero.
+	RoundHalfDown RoundingMode = "half_down" // Ties towards zero.
+	RoundDown     RoundingMode = "down"      // Truncate towards zero.
+	RoundUp       RoundingMode = "up"        // Away from zero.
+)
+
+// DefaultRounding is applied when an operation does not name a rounding mode.
+var DefaultRounding = RoundHalfEven
+
+// ParseRoundingMode validates a configured rounding mode.
+func ParseRoundingMode(mode string) (RoundingMode, error) {
+	switch m := RoundingMode(mode); m {
+	case RoundHalfEven, RoundHalfUp, RoundHalfDown, RoundDown, RoundUp:
+		return m, nil
+	default:
+		return "", fmt.Errorf("unsupported rounding mode %q", mode)
+	}
+}
+
+// round converts an exact rational number of minor units to an integer.
+func round(r *big.Rat, mode RoundingMode) int64 {
+	num := new(big.Int).Set(r.Num())
+	den := r.Denom()
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo.Int64()
+	}
+	sign := int64(num.Sign())
+	twice := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2))
+	cmpHalf := twice.Cmp(den)
+
+	awayFromZero := false
+	switch mode {
+	case RoundDown:
+	case RoundUp:
+		awayFromZero = true
+	case RoundHalfUp:
+		awayFromZero = cmpHalf >= 0
+	case RoundHalfDown:
+		awayFromZero = cmpHalf > 0
+	default:
+		awayFromZero = cmpHalf > 0 || (cmpHalf == 0 && quo.Bit(0) == 1)
+	}
+	if awayFromZero {
+		return quo.Int64() + sign
+	}
+	return quo.Int64()
+}
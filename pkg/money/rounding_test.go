@@ -0,0 +1,86 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	// fractions of a minor unit and what each mode makes of them
+	tests := []struct {
+		value *big.Rat
+		want  map[RoundingMode]int64
+	}{
+		{big.NewRat(25, 10), map[RoundingMode]int64{
+			RoundHalfEven: 2, RoundHalfUp: 3, RoundHalfDown: 2, RoundDown: 2, RoundUp: 3,
+		}},
+		{big.NewRat(35, 10), map[RoundingMode]int64{
+			RoundHalfEven: 4, RoundHalfUp: 4, RoundHalfDown: 3, RoundDown: 3, RoundUp: 4,
+		}},
+		{big.NewRat(-25, 10), map[RoundingMode]int64{
+			RoundHalfEven: -2, RoundHalfUp: -3, RoundHalfDown: -2, RoundDown: -2, RoundUp: -3,
+		}},
+		{big.NewRat(21, 10), map[RoundingMode]int64{
+			RoundHalfEven: 2, RoundHalfUp: 2, RoundHalfDown: 2, RoundDown: 2, RoundUp: 3,
+		}},
+		{big.NewRat(29, 10), map[RoundingMode]int64{
+			RoundHalfEven: 3, RoundHalfUp: 3, RoundHalfDown: 3, RoundDown: 2, RoundUp: 3,
+		}},
+		{big.NewRat(-29, 10), map[RoundingMode]int64{
+			RoundHalfEven: -3, RoundHalfUp: -3, RoundHalfDown: -3, RoundDown: -2, RoundUp: -3,
+		}},
+		{big.NewRat(4, 1), map[RoundingMode]int64{
+			RoundHalfEven: 4, RoundHalfUp: 4, RoundHalfDown: 4, RoundDown: 4, RoundUp: 4,
+		}},
+	}
+	for _, tt := range tests {
+		for mode, want := range tt.want {
+			if got := round(tt.value, mode); got != want {
+				t.Errorf("round(%s, %s) = %d, want %d", tt.value.RatString(), mode, got, want)
+			}
+		}
+	}
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	for _, mode := range []string{"half_even", "half_up", "half_down", "down", "up"} {
+		if got, err := ParseRoundingMode(mode); err != nil || string(got) != mode {
+			t.Errorf("ParseRoundingMode(%q) = %q, %v", mode, got, err)
+		}
+	}
+	if _, err := ParseRoundingMode("ceiling"); err == nil {
+		t.Error("ParseRoundingMode accepted an unknown mode")
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		rate     string
+		currency string
+		want     Money
+		wantErr  bool
+	}{
+		{name: "same scale", money: Money{1000, "USD"}, rate: "0.9", currency: "EUR", want: Money{900, "EUR"}},
+		{name: "rounded", money: Money{1, "USD"}, rate: "15750.25", currency: "IDR", want: Money{15750, "IDR"}},
+		{name: "to zero exponent", money: Money{1000, "USD"}, rate: "150", currency: "JPY", want: Money{1500, "JPY"}},
+		{name: "from zero exponent", money: Money{1500, "JPY"}, rate: "0.0066", currency: "USD", want: Money{990, "USD"}},
+		{name: "zero rate", money: Money{1000, "USD"}, rate: "0", currency: "EUR", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate(tt.rate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Convert(tt.money, rate, tt.currency, RoundHalfEven)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Convert error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Convert = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Daily   = "daily"
+	Weekly  = "weekly"
+	Monthly = "monthly"
+)
+
+// Schedule yields the occurrences of a recurring event.
+type Schedule interface {
+	// Next returns the first occurrence strictly after t.
+	Next(t time.Time) time.Time
+}
+
+// Parse reads a spec, either daily, weekly, monthly or a five field cron expression
+// (minute hour day-of-month month day-of-week). The named frequencies repeat from
+// anchor, a monthly schedule anchored on the 31st runs on the last day of shorter months.
+// Cron expressions are evaluated in the location of anchor and never run before it.
+func Parse(spec string, anchor time.Time) (Schedule, error) {
+	switch strings.ToLower(strings.TrimSpace(spec)) {
+	case Daily:
+		return interval{anchor: anchor, days: 1}, nil
+	case Weekly:
+		return interval{anchor: anchor, days: 7}, nil
+	case Monthly:
+		return monthly{anchor: anchor}, nil
+	}
+	return parseCron(spec, anchor)
+}
+
+// First returns the first occurrence at or after t.
+func First(s Schedule, t time.Time) time.Time {
+	return s.Next(t.Add(-time.Nanosecond))
+}
+
+// Retry reports whether a run failing on its attempt-th try is tried again at retryAt.
+// It is, up to maxRetries tries, as long as retryAt comes before the next run, or no
+// later than end when the schedule has no run left.
+func Retry(attempt, maxRetries int, retryAt time.Time, next, end *time.Time) bool {
+	if attempt > maxRetries {
+		return false
+	}
+	if next != nil {
+		return retryAt.Before(*next)
+	}
+	return end == nil || !retryAt.After(*end)
+}
+
+type interval struct {
+	anchor time.Time
+	days   int
+}
+
+func (s interval) Next(t time.Time) time.Time {
+	if t.Before(s.anchor) {
+		return s.anchor
+	}
+	n := int(t.Sub(s.anchor)/(time.Duration(s.days)*24*time.Hour)) - 1
+	if n < 0 {
+		n = 0
+	}
+	next := s.anchor.AddDate(0, 0, n*s.days)
+	for !next.After(t) {
+		n++
+		next = s.anchor.AddDate(0, 0, n*s.days)
+	}
+	return next
+}
+
+type monthly struct {
+	anchor time.Time
+}
+
+func (s monthly) at(n int) time.Time {
+	a := s.anchor
+	first := time.Date(a.Year(), a.Month()+time.Month(n), 1, a.Hour(), a.Minute(), a.Second(), a.Nanosecond(), a.Location())
+	day := a.Day()
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+func (s monthly) Next(t time.Time) time.Time {
+	if t.Before(s.anchor) {
+		return s.anchor
+	}
+	t = t.In(s.anchor.Location())
+	n := (t.Year()-s.anchor.Year())*12 + int(t.Month()-s.anchor.Month()) - 1
+	if n < 0 {
+		n = 0
+	}
+	next := s.at(n)
+	for !next.After(t) {
+		n++
+		next = s.at(n)
+	}
+	return next
+}
+
+type cron struct {
+	anchor                        time.Time
+	minute, hour, dom, month, dow uint64
+	anyDom, anyDow                bool
+}
+
+var cronFields = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+func parseCron(spec string, anchor time.Time) (Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("unsupported schedule %q, expected daily, weekly, monthly or a five field cron expression", spec)
+	}
+	bits := make([]uint64, len(fields))
+	for i, field := range fields {
+		b, err := parseCronField(field, cronFields[i].min, cronFields[i].max)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s in schedule %q: %w", cronFields[i].name, spec, err)
+		}
+		bits[i] = b
+	}
+	return cron{
+		anchor: anchor,
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		anyDom: fields[2] == "*",
+		anyDow: fields[4] == "*",
+	}, nil
+}
+
+// parseCronField reads a comma separated list of *, n, a-b, optionally stepped with /s.
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("bad step %q", part)
+			}
+			rng, step = part[:i], s
+		}
+		lo, hi := min, max
+		if rng != "*" {
+			bounds := strings.SplitN(rng, "-", 2)
+			var err error
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("bad value %q", part)
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, fmt.Errorf("bad value %q", part)
+				}
+			} else if step > 1 {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("%q out of range %d-%d", part, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func (s cron) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.anyDom || s.anyDow {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func (s cron) Next(t time.Time) time.Time {
+	if t.Before(s.anchor) {
+		t = s.anchor.Add(-time.Nanosecond)
+	}
+	t = t.In(s.anchor.Location()).Truncate(time.Minute).Add(time.Minute)
+	// an expression matching nothing, like the 31st of February, gives up after a few years
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
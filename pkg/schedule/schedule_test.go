@@ -0,0 +1,125 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	tests := []struct {
+		name   string
+		spec   string
+		anchor time.Time
+		after  time.Time
+		want   time.Time
+	}{
+		{name: "daily before anchor", spec: "daily", anchor: date(2024, 1, 1, 9, 0), after: date(2023, 12, 1, 0, 0), want: date(2024, 1, 1, 9, 0)},
+		{name: "daily strictly after", spec: "daily", anchor: date(2024, 1, 1, 9, 0), after: date(2024, 1, 1, 9, 0), want: date(2024, 1, 2, 9, 0)},
+		{name: "daily long after", spec: "daily", anchor: date(2024, 1, 1, 9, 0), after: date(2024, 4, 10, 10, 0), want: date(2024, 4, 11, 9, 0)},
+		{name: "daily keeps the wall clock over DST", spec: "daily",
+			anchor: time.Date(2024, 3, 9, 9, 0, 0, 0, newYork), after: time.Date(2024, 3, 9, 9, 0, 0, 0, newYork),
+			want: time.Date(2024, 3, 10, 9, 0, 0, 0, newYork)},
+		{name: "weekly", spec: "weekly", anchor: date(2024, 1, 1, 9, 0), after: date(2024, 1, 3, 0, 0), want: date(2024, 1, 8, 9, 0)},
+		{name: "weekly over the year end", spec: "weekly", anchor: date(2023, 12, 28, 9, 0), after: date(2023, 12, 28, 9, 0), want: date(2024, 1, 4, 9, 0)},
+		{name: "monthly 31st into leap February", spec: "monthly", anchor: date(2024, 1, 31, 10, 0), after: date(2024, 1, 31, 10, 0), want: date(2024, 2, 29, 10, 0)},
+		{name: "monthly 31st into February", spec: "monthly", anchor: date(2023, 1, 31, 10, 0), after: date(2023, 1, 31, 10, 0), want: date(2023, 2, 28, 10, 0)},
+		{name: "monthly 31st back after February", spec: "monthly", anchor: date(2024, 1, 31, 10, 0), after: date(2024, 2, 29, 10, 0), want: date(2024, 3, 31, 10, 0)},
+		{name: "monthly 31st into a 30 day month", spec: "monthly", anchor: date(2024, 1, 31, 10, 0), after: date(2024, 4, 1, 0, 0), want: date(2024, 4, 30, 10, 0)},
+		{name: "monthly 29th of February in a common year", spec: "monthly", anchor: date(2024, 2, 29, 10, 0), after: date(2025, 2, 1, 0, 0), want: date(2025, 2, 28, 10, 0)},
+		{name: "monthly 29th of February in the next leap year", spec: "monthly", anchor: date(2024, 2, 29, 10, 0), after: date(2028, 2, 1, 0, 0), want: date(2028, 2, 29, 10, 0)},
+		{name: "monthly over the year end", spec: "monthly", anchor: date(2023, 12, 15, 10, 0), after: date(2023, 12, 15, 10, 0), want: date(2024, 1, 15, 10, 0)},
+		{name: "monthly late claim skips missed runs", spec: "monthly", anchor: date(2024, 1, 31, 10, 0), after: date(2024, 5, 2, 0, 0), want: date(2024, 5, 31, 10, 0)},
+		{name: "named spec is case insensitive", spec: " Monthly ", anchor: date(2024, 1, 15, 10, 0), after: date(2024, 1, 15, 10, 0), want: date(2024, 2, 15, 10, 0)},
+		{name: "cron first of the month", spec: "0 9 1 * *", anchor: date(2024, 1, 15, 0, 0), after: date(2024, 1, 15, 0, 0), want: date(2024, 2, 1, 9, 0)},
+		{name: "cron weekdays", spec: "30 8 * * 1-5", anchor: date(2024, 1, 1, 0, 0), after: date(2024, 1, 5, 9, 0), want: date(2024, 1, 8, 8, 30)},
+		{name: "cron day of month or day of week", spec: "0 0 13 * 5", anchor: date(2024, 1, 1, 0, 0), after: date(2024, 1, 1, 0, 0), want: date(2024, 1, 5, 0, 0)},
+		{name: "cron steps", spec: "*/15 * * * *", anchor: date(2024, 1, 1, 0, 0), after: date(2024, 1, 1, 10, 16), want: date(2024, 1, 1, 10, 30)},
+		{name: "cron 29th of February waits for a leap year", spec: "0 0 29 2 *", anchor: date(2024, 3, 1, 0, 0), after: date(2024, 3, 1, 0, 0), want: date(2028, 2, 29, 0, 0)},
+		{name: "cron 31st skips shorter months", spec: "0 12 31 * *", anchor: date(2024, 4, 1, 0, 0), after: date(2024, 4, 1, 0, 0), want: date(2024, 5, 31, 12, 0)},
+		{name: "cron never before anchor", spec: "0 * * * *", anchor: date(2024, 6, 1, 12, 0), after: date(2024, 1, 1, 0, 0), want: date(2024, 6, 1, 12, 0)},
+		{name: "cron matching nothing", spec: "0 0 31 2 *", anchor: date(2024, 1, 1, 0, 0), after: date(2024, 1, 1, 0, 0), want: time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := Parse(tt.spec, tt.anchor)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.spec, err)
+			}
+			if got := sched.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFirst(t *testing.T) {
+	anchor := date(2024, 1, 31, 10, 0)
+	sched, err := Parse(Monthly, anchor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := First(sched, anchor); !got.Equal(anchor) {
+		t.Errorf("First(anchor) = %s, want the anchor itself", got)
+	}
+	if got := First(sched, date(2024, 2, 29, 10, 0)); !got.Equal(date(2024, 2, 29, 10, 0)) {
+		t.Errorf("First on an occurrence = %s, want that occurrence", got)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"every day",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 7",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		if _, err := Parse(spec, date(2024, 1, 1, 0, 0)); err == nil {
+			t.Errorf("Parse(%q) accepted an invalid schedule", spec)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	retryAt := date(2024, 1, 1, 10, 0)
+	later, earlier := retryAt.Add(time.Hour), retryAt.Add(-time.Hour)
+	tests := []struct {
+		name       string
+		attempt    int
+		maxRetries int
+		next, end  *time.Time
+		want       bool
+	}{
+		{name: "before the next run", attempt: 1, maxRetries: 2, next: &later, want: true},
+		{name: "last retry allowed", attempt: 2, maxRetries: 2, next: &later, want: true},
+		{name: "retries exhausted", attempt: 3, maxRetries: 2, next: &later, want: false},
+		{name: "no retries configured", attempt: 1, maxRetries: 0, next: &later, want: false},
+		{name: "would pass the next run", attempt: 1, maxRetries: 2, next: &earlier, want: false},
+		{name: "on the next run", attempt: 1, maxRetries: 2, next: &retryAt, want: false},
+		{name: "last run without an end", attempt: 1, maxRetries: 2, want: true},
+		{name: "last run before the end", attempt: 1, maxRetries: 2, end: &later, want: true},
+		{name: "last run on the end", attempt: 1, maxRetries: 2, end: &retryAt, want: true},
+		{name: "last run past the end", attempt: 1, maxRetries: 2, end: &earlier, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Retry(tt.attempt, tt.maxRetries, retryAt, tt.next, tt.end); got != tt.want {
+				t.Errorf("Retry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,7 +16,7 @@ type Signature struct {
 type Signaturer interface {
 	HashBscryptPassword(password string) (string, error)
 	CheckBscryptPasswordHash(password, hash string) bool
-	GenerateJWT(userid, username string) (string, error)
+	GenerateJWT(userid, username, role string) (string, error)
 	JWTCheck(token string) (*JwtAuthenticationRes, *exception.Exception)
 	//SignHMAC512(httpMethod, bodyJson, token string) (string, error)
 	//VerifyHMAC512(httpMethod, bodyJson, token, hash string) (bool, *exception.Exception)
@@ -42,15 +42,17 @@ type JWTClaims struct {
 	jwt.RegisteredClaims
 	Username string `json:"username"`
 	UserId   string `json:"user_id"`
+	Role     string `json:"role"`
 }
 
 type JwtAuthenticationRes struct {
 	UserId   string `json:"user_id"`
 	Username string `json:"username"`
+	Role     string `json:"role"`
 	Token    string `json:"token"`
 }
 
-func (s *Signature) GenerateJWT(userid, username string) (string, error) {
+func (s *Signature) GenerateJWT(userid, username, role string) (string, error) {
 	claims := JWTClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    "product-wallet",
@@ -58,6 +60,7 @@ func (s *Signature) GenerateJWT(userid, username string) (string, error) {
 		},
 		Username: username,
 		UserId:   userid,
+		Role:     role,
 	}
 	token := jwt.NewWithClaims(
 		jwt.SigningMethodHS256,
@@ -84,11 +87,14 @@ func (s *Signature) JWTCheck(token string) (*JwtAuthenticationRes, *exception.Ex
 		return nil, exception.Unauthenticated("Invalid token, " + err.Error())
 	}
 
-	var userid, username string
+	var userid, username, role string
 	claims, ok := jwtToken.Claims.(jwt.MapClaims)
 	if ok || jwtToken.Valid {
 		username = fmt.Sprintf("%v", claims["username"])
 		userid = fmt.Sprintf("%v", claims["user_id"])
+		if claimRole, ok := claims["role"].(string); ok {
+			role = claimRole
+		}
 	} else {
 		return nil, exception.Unauthenticated("Invalid token")
 	}
@@ -96,6 +102,7 @@ func (s *Signature) JWTCheck(token string) (*JwtAuthenticationRes, *exception.Ex
 	return &JwtAuthenticationRes{
 		UserId:   userid,
 		Username: username,
+		Role:     role,
 		Token:    token,
 	}, nil
 }
@@ -0,0 +1,49 @@
+package statement
+
+import (
+	"encoding/csv"
+	"io"
+	"product-wallet/pkg/money"
+	"strings"
+	"time"
+)
+
+var csvHeader = []string{"date", "id", "type", "description", "amount", "currency", "balance"}
+
+// csvText keeps a spreadsheet from reading a text cell as a formula, descriptions
+// carry counterparty names and notes the account holder does not control.
+func csvText(cell string) string {
+	if cell != "" && strings.ContainsAny(cell[:1], "=+-@\t\r") {
+		return "'" + cell
+	}
+	return cell
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	cw := &csvWriter{w: csv.NewWriter(w)}
+	if err := cw.w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (cw *csvWriter) Write(line Line) error {
+	return cw.w.Write([]string{
+		line.Time.Format(time.RFC3339),
+		csvText(line.Id),
+		csvText(line.Type),
+		csvText(line.Description),
+		line.Amount.Decimal(),
+		line.Amount.Normalize().Currency,
+		line.Balance.Decimal(),
+	})
+}
+
+func (cw *csvWriter) Close(money.Money) error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
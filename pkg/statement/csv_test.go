@@ -0,0 +1,109 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"product-wallet/pkg/money"
+	"testing"
+	"time"
+)
+
+func TestCSVWriter(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		amount      int64
+		want        []string
+	}{
+		{
+			name:        "plain",
+			description: "Coffee beans",
+			amount:      -15000,
+			want:        []string{"2026-10-01T09:30:00Z", "tx-1", "expense", "Coffee beans", "-150.00", "IDR", "850.00"},
+		},
+		{
+			name:        "formula",
+			description: `=HYPERLINK("http://example.com","click")`,
+			amount:      -15000,
+			want:        []string{"2026-10-01T09:30:00Z", "tx-1", "expense", `'=HYPERLINK("http://example.com","click")`, "-150.00", "IDR", "850.00"},
+		},
+		{
+			name:        "plus",
+			description: "+62 812 transfer",
+			amount:      -15000,
+			want:        []string{"2026-10-01T09:30:00Z", "tx-1", "expense", "'+62 812 transfer", "-150.00", "IDR", "850.00"},
+		},
+		{
+			name:        "minus",
+			description: "-2+3",
+			amount:      -15000,
+			want:        []string{"2026-10-01T09:30:00Z", "tx-1", "expense", "'-2+3", "-150.00", "IDR", "850.00"},
+		},
+		{
+			name:        "at",
+			description: "@SUM(A1:A2)",
+			amount:      -15000,
+			want:        []string{"2026-10-01T09:30:00Z", "tx-1", "expense", "'@SUM(A1:A2)", "-150.00", "IDR", "850.00"},
+		},
+		{
+			name:        "tab",
+			description: "\t=1+1",
+			amount:      -15000,
+			want:        []string{"2026-10-01T09:30:00Z", "tx-1", "expense", "'\t=1+1", "-150.00", "IDR", "850.00"},
+		},
+		{
+			name:        "trigger inside the text",
+			description: "Dinner with a=b",
+			amount:      -15000,
+			want:        []string{"2026-10-01T09:30:00Z", "tx-1", "expense", "Dinner with a=b", "-150.00", "IDR", "850.00"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer, err := NewWriter(CSV, &buf, Account{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			line := Line{
+				Id:          "tx-1",
+				Time:        time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC),
+				Type:        "expense",
+				Description: tt.description,
+				Amount:      money.New(tt.amount, "IDR"),
+				Balance:     money.New(85000, "IDR"),
+			}
+			if err := writer.Write(line); err != nil {
+				t.Fatal(err)
+			}
+			if err := writer.Close(money.New(85000, "IDR")); err != nil {
+				t.Fatal(err)
+			}
+			records, err := csv.NewReader(&buf).ReadAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(records) != 2 {
+				t.Fatalf("got %d records, want the header and one line", len(records))
+			}
+			if got := records[0]; !equal(got, csvHeader) {
+				t.Errorf("header = %q, want %q", got, csvHeader)
+			}
+			if got := records[1]; !equal(got, tt.want) {
+				t.Errorf("line = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,86 @@
+package statement
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"product-wallet/pkg/money"
+	"strings"
+	"time"
+)
+
+// ofxHeader opens an OFX 1.0.2 (SGML) bank statement, the version most tools import.
+const ofxHeader = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+SECURITY:NONE
+ENCODING:UTF-8
+CHARSET:NONE
+COMPRESSION:NONE
+OLDFILEUID:NONE
+NEWFILEUID:NONE
+
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>%s<LANGUAGE>ENG</SONRS></SIGNONMSGSRSV1>
+<BANKMSGSRSV1><STMTTRNRS><TRNUID>0<STATUS><CODE>0<SEVERITY>INFO</STATUS>
+<STMTRS><CURDEF>%s
+<BANKACCTFROM><BANKID>WALLET<ACCTID>%s<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST><DTSTART>%s<DTEND>%s
+`
+
+const ofxFooter = `</BANKTRANLIST>
+<LEDGERBAL><BALAMT>%s<DTASOF>%s</LEDGERBAL>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>
+`
+
+var ofxEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\n", " ", "\r", " ")
+
+// ofxName escapes s into at most max characters of a NAME, cutting between
+// escaped characters so no entity is left half written.
+func ofxName(s string, max int) string {
+	var name strings.Builder
+	length := 0
+	for _, r := range s {
+		escaped := ofxEscaper.Replace(string(r))
+		if length+len([]rune(escaped)) > max {
+			break
+		}
+		name.WriteString(escaped)
+		length += len([]rune(escaped))
+	}
+	return name.String()
+}
+
+func ofxTime(t time.Time) string {
+	return t.UTC().Format("20060102150405") + "[0:GMT]"
+}
+
+type ofxWriter struct {
+	w       *bufio.Writer
+	account Account
+}
+
+func newOFXWriter(w io.Writer, account Account) (*ofxWriter, error) {
+	ow := &ofxWriter{w: bufio.NewWriter(w), account: account}
+	_, err := fmt.Fprintf(ow.w, ofxHeader, ofxTime(time.Now()), account.Currency,
+		ofxEscaper.Replace(account.Id), ofxTime(account.From), ofxTime(account.To))
+	return ow, err
+}
+
+func (ow *ofxWriter) Write(line Line) error {
+	trnType := "CREDIT"
+	if line.Amount.IsNegative() {
+		trnType = "DEBIT"
+	}
+	_, err := fmt.Fprintf(ow.w, "<STMTTRN><TRNTYPE>%s<DTPOSTED>%s<TRNAMT>%s<FITID>%s<NAME>%s<MEMO>%s</STMTTRN>\n",
+		trnType, ofxTime(line.Time), line.Amount.Decimal(), line.Id,
+		ofxName(line.Description, 32), ofxEscaper.Replace(line.Description))
+	return err
+}
+
+func (ow *ofxWriter) Close(closing money.Money) error {
+	if _, err := fmt.Fprintf(ow.w, ofxFooter, closing.Decimal(), ofxTime(ow.account.To)); err != nil {
+		return err
+	}
+	return ow.w.Flush()
+}
@@ -0,0 +1,88 @@
+package statement
+
+import (
+	"bytes"
+	"product-wallet/pkg/money"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOFXName(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		max  int
+		want string
+	}{
+		{name: "short", in: "Coffee", max: 32, want: "Coffee"},
+		{name: "cut", in: "abcdefghij", max: 4, want: "abcd"},
+		{name: "escaped", in: "Tom & Jerry <3", max: 32, want: "Tom &amp; Jerry &lt;3"},
+		{name: "entity counts its escaped length", in: "ab&cd", max: 7, want: "ab&amp;"},
+		{name: "entity is not split", in: "abc&d", max: 6, want: "abc"},
+		{name: "newlines", in: "line\r\nbreak", max: 32, want: "line  break"},
+		{name: "multibyte", in: "kopi ☕☕☕", max: 6, want: "kopi ☕"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ofxName(tt.in, tt.max); got != tt.want {
+				t.Errorf("ofxName(%q, %d) = %q, want %q", tt.in, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOFXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	account := Account{
+		Id:       "wallet-1",
+		Currency: "IDR",
+		From:     time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+	}
+	writer, err := NewWriter(OFX, &buf, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := []Line{
+		{
+			Id:          "tx-1",
+			Time:        time.Date(2026, 10, 2, 8, 0, 0, 0, time.UTC),
+			Description: "Salary",
+			Amount:      money.New(1000000, "IDR"),
+			Balance:     money.New(1000000, "IDR"),
+		},
+		{
+			Id:          "tx-2",
+			Time:        time.Date(2026, 10, 3, 12, 15, 0, 0, time.UTC),
+			Description: "Fish & Chips <Harbour Road> & Sons Ltd",
+			Amount:      money.New(-25000, "IDR"),
+			Balance:     money.New(975000, "IDR"),
+		},
+	}
+	for _, line := range lines {
+		if err := writer.Write(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(money.New(975000, "IDR")); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"OFXHEADER:100\n",
+		"<CURDEF>IDR\n",
+		"<ACCTID>wallet-1",
+		"<DTSTART>20261001000000[0:GMT]<DTEND>20261101000000[0:GMT]",
+		"<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20261002080000[0:GMT]<TRNAMT>10000.00<FITID>tx-1<NAME>Salary<MEMO>Salary</STMTTRN>\n",
+		"<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20261003121500[0:GMT]<TRNAMT>-250.00<FITID>tx-2" +
+			"<NAME>Fish &amp; Chips &lt;Harbour Roa" +
+			"<MEMO>Fish &amp; Chips &lt;Harbour Road&gt; &amp; Sons Ltd</STMTTRN>\n",
+		"<LEDGERBAL><BALAMT>9750.00<DTASOF>20261101000000[0:GMT]</LEDGERBAL>",
+		"</OFX>\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("statement is missing %q:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package statement
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"product-wallet/pkg/money"
+	"strings"
+)
+
+var qifEscaper = strings.NewReplacer("\n", " ", "\r", " ")
+
+// qifWriter writes a Quicken Interchange Format bank register. QIF has no field
+// for balances, importers work them out from the amounts.
+type qifWriter struct {
+	w *bufio.Writer
+}
+
+func newQIFWriter(w io.Writer) (*qifWriter, error) {
+	qw := &qifWriter{w: bufio.NewWriter(w)}
+	_, err := qw.w.WriteString("!Type:Bank\n")
+	return qw, err
+}
+
+func (qw *qifWriter) Write(line Line) error {
+	_, err := fmt.Fprintf(qw.w, "D%s\nT%s\nN%s\nP%s\nM%s\n^\n",
+		line.Time.Format("01/02/2006"), line.Amount.Decimal(), line.Id,
+		qifEscaper.Replace(line.Description), line.Type)
+	return err
+}
+
+func (qw *qifWriter) Close(money.Money) error {
+	return qw.w.Flush()
+}
@@ -0,0 +1,99 @@
+package statement
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"product-wallet/pkg/money"
+	"strings"
+	"time"
+)
+
+// Format is a file format accounting tools import statements from.
+type Format string
+
+const (
+	CSV Format = "csv"
+	OFX Format = "ofx"
+	QIF Format = "qif"
+)
+
+var contentTypes = map[Format][]string{
+	CSV: {"text/csv"},
+	OFX: {"application/x-ofx", "application/ofx"},
+	QIF: {"application/qif", "application/x-qif"},
+}
+
+// ParseFormat validates a format given by name.
+func ParseFormat(format string) (Format, error) {
+	switch f := Format(strings.ToLower(format)); f {
+	case CSV, OFX, QIF:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported statement format %q, expected csv, ofx or qif", format)
+	}
+}
+
+// FormatFromAccept picks the first format named by an Accept header, false when
+// the header names none of them.
+func FormatFromAccept(accept string) (Format, bool) {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		for format, types := range contentTypes {
+			for _, t := range types {
+				if mediaType == t {
+					return format, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// ContentType is the media type a statement in format is served as.
+func (f Format) ContentType() string {
+	return contentTypes[f][0]
+}
+
+// Account describes the wallet a statement is for and the period it covers.
+type Account struct {
+	Id       string
+	Currency string
+	From     time.Time
+	To       time.Time
+}
+
+// Line is one transaction of a statement.
+type Line struct {
+	Id          string
+	Time        time.Time
+	Type        string
+	Description string
+	Amount      money.Money // negative when money left the wallet
+	Balance     money.Money // running balance after the transaction
+}
+
+// Writer writes a statement line by line, nothing but the current line is held
+// in memory so statements of any length can be streamed.
+type Writer interface {
+	Write(line Line) error
+	// Close writes what follows the lines, closing is the balance at the end of the period.
+	Close(closing money.Money) error
+}
+
+// NewWriter starts a statement of account in format on w.
+func NewWriter(format Format, w io.Writer, account Account) (Writer, error) {
+	switch format {
+	case CSV:
+		return newCSVWriter(w)
+	case OFX:
+		return newOFXWriter(w, account)
+	case QIF:
+		return newQIFWriter(w)
+	default:
+		return nil, fmt.Errorf("unsupported statement format %q", format)
+	}
+}
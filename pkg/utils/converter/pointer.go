@@ -20,6 +20,8 @@ func ToString(data interface{}) string {
 		return strconv.FormatBool(v)
 	case time.Time:
 		return v.Format("2006-01-02 15:04:05")
+	case fmt.Stringer:
+		return v.String()
 	default:
 		val := reflect.ValueOf(data)
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/go-playground/validator/v10"
 	"log/slog"
+	"product-wallet/pkg/money"
 	"reflect"
 	"regexp"
 	"time"
@@ -45,6 +46,27 @@ func NewValidator() (*Validator, error) {
 
 	})
 
+	validate.RegisterValidation("currency", func(fl validator.FieldLevel) bool {
+		return money.IsKnownCurrency(fl.Field().String())
+	})
+
+	// money.Money is validated as its minor units, so tags such as required and gt=0 apply to the amount
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		if value, ok := field.Interface().(money.Money); ok {
+			return value.Units
+		}
+		return nil
+	}, money.Money{})
+
+	// money.Rate is validated as an approximate float, enough for tags such as gt=0
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		if value, ok := field.Interface().(money.Rate); ok {
+			rate, _ := value.Rat().Float64()
+			return rate
+		}
+		return nil
+	}, money.Rate{})
+
 	slog.Info("validator initialized")
 	return &Validator{validate: validate}, nil
 }
@@ -103,6 +125,8 @@ func (v *Validator) formatValidationError(err error) map[string]string {
 			errors[err.Field()] = fmt.Sprintf("%s invalid phone number", err.Field())
 		case "password":
 			errors[err.Field()] = fmt.Sprintf("%s is not a valid password, at least 8 characters, 1 uppercase, 1 lowercase, 1 number, and 1 special character", err.Field())
+		case "currency":
+			errors[err.Field()] = fmt.Sprintf("%s is not a supported currency", err.Field())
 		case "dateLocal":
 			errors[err.Field()] = fmt.Sprintf("%s is not a valid date, use YYYY-MM-DD", err.Field())
 		default: